@@ -0,0 +1,2675 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package migrationv1
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QueryMigrationStatusRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_store_migration_v1_query_proto_init()
+	md_QueryMigrationStatusRequest = File_cosmos_store_migration_v1_query_proto.Messages().ByName("QueryMigrationStatusRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMigrationStatusRequest)(nil)
+
+type fastReflection_QueryMigrationStatusRequest QueryMigrationStatusRequest
+
+func (x *QueryMigrationStatusRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMigrationStatusRequest)(x)
+}
+
+func (x *QueryMigrationStatusRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMigrationStatusRequest_messageType fastReflection_QueryMigrationStatusRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMigrationStatusRequest_messageType{}
+
+type fastReflection_QueryMigrationStatusRequest_messageType struct{}
+
+func (x fastReflection_QueryMigrationStatusRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMigrationStatusRequest)(nil)
+}
+func (x fastReflection_QueryMigrationStatusRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMigrationStatusRequest)
+}
+func (x fastReflection_QueryMigrationStatusRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMigrationStatusRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMigrationStatusRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMigrationStatusRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMigrationStatusRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMigrationStatusRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMigrationStatusRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryMigrationStatusRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMigrationStatusRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryMigrationStatusRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMigrationStatusRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMigrationStatusRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMigrationStatusRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMigrationStatusRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMigrationStatusRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.migration.v1.QueryMigrationStatusRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMigrationStatusRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMigrationStatusRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMigrationStatusRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMigrationStatusRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMigrationStatusRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMigrationStatusRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMigrationStatusRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMigrationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryMigrationStatusResponse_12_list)(nil)
+
+type _QueryMigrationStatusResponse_12_list struct {
+	list *[]*StoreMigrationStatus
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreMigrationStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreMigrationStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) AppendMutable() protoreflect.Value {
+	v := new(StoreMigrationStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) NewElement() protoreflect.Value {
+	v := new(StoreMigrationStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryMigrationStatusResponse_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryMigrationStatusResponse                  protoreflect.MessageDescriptor
+	fd_QueryMigrationStatusResponse_migrating        protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_phase            protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_height           protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_migrated_version protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_stores_total     protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_stores_done      protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_keys_total       protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_keys_done        protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_percent          protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_eta              protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_bytes_written    protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_stores           protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_started_at       protoreflect.FieldDescriptor
+	fd_QueryMigrationStatusResponse_updated_at       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_migration_v1_query_proto_init()
+	md_QueryMigrationStatusResponse = File_cosmos_store_migration_v1_query_proto.Messages().ByName("QueryMigrationStatusResponse")
+	fd_QueryMigrationStatusResponse_migrating = md_QueryMigrationStatusResponse.Fields().ByName("migrating")
+	fd_QueryMigrationStatusResponse_phase = md_QueryMigrationStatusResponse.Fields().ByName("phase")
+	fd_QueryMigrationStatusResponse_height = md_QueryMigrationStatusResponse.Fields().ByName("height")
+	fd_QueryMigrationStatusResponse_migrated_version = md_QueryMigrationStatusResponse.Fields().ByName("migrated_version")
+	fd_QueryMigrationStatusResponse_stores_total = md_QueryMigrationStatusResponse.Fields().ByName("stores_total")
+	fd_QueryMigrationStatusResponse_stores_done = md_QueryMigrationStatusResponse.Fields().ByName("stores_done")
+	fd_QueryMigrationStatusResponse_keys_total = md_QueryMigrationStatusResponse.Fields().ByName("keys_total")
+	fd_QueryMigrationStatusResponse_keys_done = md_QueryMigrationStatusResponse.Fields().ByName("keys_done")
+	fd_QueryMigrationStatusResponse_percent = md_QueryMigrationStatusResponse.Fields().ByName("percent")
+	fd_QueryMigrationStatusResponse_eta = md_QueryMigrationStatusResponse.Fields().ByName("eta")
+	fd_QueryMigrationStatusResponse_bytes_written = md_QueryMigrationStatusResponse.Fields().ByName("bytes_written")
+	fd_QueryMigrationStatusResponse_stores = md_QueryMigrationStatusResponse.Fields().ByName("stores")
+	fd_QueryMigrationStatusResponse_started_at = md_QueryMigrationStatusResponse.Fields().ByName("started_at")
+	fd_QueryMigrationStatusResponse_updated_at = md_QueryMigrationStatusResponse.Fields().ByName("updated_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryMigrationStatusResponse)(nil)
+
+type fastReflection_QueryMigrationStatusResponse QueryMigrationStatusResponse
+
+func (x *QueryMigrationStatusResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryMigrationStatusResponse)(x)
+}
+
+func (x *QueryMigrationStatusResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryMigrationStatusResponse_messageType fastReflection_QueryMigrationStatusResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryMigrationStatusResponse_messageType{}
+
+type fastReflection_QueryMigrationStatusResponse_messageType struct{}
+
+func (x fastReflection_QueryMigrationStatusResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryMigrationStatusResponse)(nil)
+}
+func (x fastReflection_QueryMigrationStatusResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryMigrationStatusResponse)
+}
+func (x fastReflection_QueryMigrationStatusResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMigrationStatusResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryMigrationStatusResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryMigrationStatusResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryMigrationStatusResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryMigrationStatusResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryMigrationStatusResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryMigrationStatusResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryMigrationStatusResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryMigrationStatusResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryMigrationStatusResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Migrating != false {
+		value := protoreflect.ValueOfBool(x.Migrating)
+		if !f(fd_QueryMigrationStatusResponse_migrating, value) {
+			return
+		}
+	}
+	if x.Phase != "" {
+		value := protoreflect.ValueOfString(x.Phase)
+		if !f(fd_QueryMigrationStatusResponse_phase, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_QueryMigrationStatusResponse_height, value) {
+			return
+		}
+	}
+	if x.MigratedVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MigratedVersion)
+		if !f(fd_QueryMigrationStatusResponse_migrated_version, value) {
+			return
+		}
+	}
+	if x.StoresTotal != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StoresTotal)
+		if !f(fd_QueryMigrationStatusResponse_stores_total, value) {
+			return
+		}
+	}
+	if x.StoresDone != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StoresDone)
+		if !f(fd_QueryMigrationStatusResponse_stores_done, value) {
+			return
+		}
+	}
+	if x.KeysTotal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeysTotal)
+		if !f(fd_QueryMigrationStatusResponse_keys_total, value) {
+			return
+		}
+	}
+	if x.KeysDone != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeysDone)
+		if !f(fd_QueryMigrationStatusResponse_keys_done, value) {
+			return
+		}
+	}
+	if x.Percent != float64(0) || math.Signbit(x.Percent) {
+		value := protoreflect.ValueOfFloat64(x.Percent)
+		if !f(fd_QueryMigrationStatusResponse_percent, value) {
+			return
+		}
+	}
+	if x.Eta != nil {
+		value := protoreflect.ValueOfMessage(x.Eta.ProtoReflect())
+		if !f(fd_QueryMigrationStatusResponse_eta, value) {
+			return
+		}
+	}
+	if x.BytesWritten != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BytesWritten)
+		if !f(fd_QueryMigrationStatusResponse_bytes_written, value) {
+			return
+		}
+	}
+	if len(x.Stores) != 0 {
+		value := protoreflect.ValueOfList(&_QueryMigrationStatusResponse_12_list{list: &x.Stores})
+		if !f(fd_QueryMigrationStatusResponse_stores, value) {
+			return
+		}
+	}
+	if x.StartedAt != nil {
+		value := protoreflect.ValueOfMessage(x.StartedAt.ProtoReflect())
+		if !f(fd_QueryMigrationStatusResponse_started_at, value) {
+			return
+		}
+	}
+	if x.UpdatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedAt.ProtoReflect())
+		if !f(fd_QueryMigrationStatusResponse_updated_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryMigrationStatusResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		return x.Migrating != false
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		return x.Phase != ""
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		return x.Height != uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		return x.MigratedVersion != uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		return x.StoresTotal != int64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		return x.StoresDone != int64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		return x.KeysTotal != uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		return x.KeysDone != uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		return x.Percent != float64(0) || math.Signbit(x.Percent)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		return x.Eta != nil
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		return x.BytesWritten != uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		return len(x.Stores) != 0
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		return x.StartedAt != nil
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		return x.UpdatedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		x.Migrating = false
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		x.Phase = ""
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		x.Height = uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		x.MigratedVersion = uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		x.StoresTotal = int64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		x.StoresDone = int64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		x.KeysTotal = uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		x.KeysDone = uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		x.Percent = float64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		x.Eta = nil
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		x.BytesWritten = uint64(0)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		x.Stores = nil
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		x.StartedAt = nil
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		x.UpdatedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryMigrationStatusResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		value := x.Migrating
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		value := x.Phase
+		return protoreflect.ValueOfString(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		value := x.MigratedVersion
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		value := x.StoresTotal
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		value := x.StoresDone
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		value := x.KeysTotal
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		value := x.KeysDone
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		value := x.Percent
+		return protoreflect.ValueOfFloat64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		value := x.Eta
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		value := x.BytesWritten
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		if len(x.Stores) == 0 {
+			return protoreflect.ValueOfList(&_QueryMigrationStatusResponse_12_list{})
+		}
+		listValue := &_QueryMigrationStatusResponse_12_list{list: &x.Stores}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		value := x.StartedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		value := x.UpdatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		x.Migrating = value.Bool()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		x.Phase = value.Interface().(string)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		x.Height = value.Uint()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		x.MigratedVersion = value.Uint()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		x.StoresTotal = value.Int()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		x.StoresDone = value.Int()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		x.KeysTotal = value.Uint()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		x.KeysDone = value.Uint()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		x.Percent = value.Float()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		x.Eta = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		x.BytesWritten = value.Uint()
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		lv := value.List()
+		clv := lv.(*_QueryMigrationStatusResponse_12_list)
+		x.Stores = *clv.list
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		x.StartedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		x.UpdatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		if x.Eta == nil {
+			x.Eta = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Eta.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		if x.Stores == nil {
+			x.Stores = []*StoreMigrationStatus{}
+		}
+		value := &_QueryMigrationStatusResponse_12_list{list: &x.Stores}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		if x.StartedAt == nil {
+			x.StartedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartedAt.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		if x.UpdatedAt == nil {
+			x.UpdatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedAt.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		panic(fmt.Errorf("field migrating of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		panic(fmt.Errorf("field phase of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		panic(fmt.Errorf("field height of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		panic(fmt.Errorf("field migrated_version of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		panic(fmt.Errorf("field stores_total of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		panic(fmt.Errorf("field stores_done of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		panic(fmt.Errorf("field keys_total of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		panic(fmt.Errorf("field keys_done of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		panic(fmt.Errorf("field percent of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		panic(fmt.Errorf("field bytes_written of message cosmos.store.migration.v1.QueryMigrationStatusResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryMigrationStatusResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrating":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.phase":
+		return protoreflect.ValueOfString("")
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.migrated_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_total":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores_done":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.keys_done":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.percent":
+		return protoreflect.ValueOfFloat64(float64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.eta":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.bytes_written":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.stores":
+		list := []*StoreMigrationStatus{}
+		return protoreflect.ValueOfList(&_QueryMigrationStatusResponse_12_list{list: &list})
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.QueryMigrationStatusResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.QueryMigrationStatusResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryMigrationStatusResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.migration.v1.QueryMigrationStatusResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryMigrationStatusResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryMigrationStatusResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryMigrationStatusResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryMigrationStatusResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryMigrationStatusResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Migrating {
+			n += 2
+		}
+		l = len(x.Phase)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.MigratedVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.MigratedVersion))
+		}
+		if x.StoresTotal != 0 {
+			n += 1 + runtime.Sov(uint64(x.StoresTotal))
+		}
+		if x.StoresDone != 0 {
+			n += 1 + runtime.Sov(uint64(x.StoresDone))
+		}
+		if x.KeysTotal != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeysTotal))
+		}
+		if x.KeysDone != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeysDone))
+		}
+		if x.Percent != 0 || math.Signbit(x.Percent) {
+			n += 9
+		}
+		if x.Eta != nil {
+			l = options.Size(x.Eta)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BytesWritten != 0 {
+			n += 1 + runtime.Sov(uint64(x.BytesWritten))
+		}
+		if len(x.Stores) > 0 {
+			for _, e := range x.Stores {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartedAt != nil {
+			l = options.Size(x.StartedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedAt != nil {
+			l = options.Size(x.UpdatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMigrationStatusResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedAt != nil {
+			encoded, err := options.Marshal(x.UpdatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.StartedAt != nil {
+			encoded, err := options.Marshal(x.StartedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Stores) > 0 {
+			for iNdEx := len(x.Stores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.BytesWritten != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BytesWritten))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Eta != nil {
+			encoded, err := options.Marshal(x.Eta)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Percent != 0 || math.Signbit(x.Percent) {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.Percent))))
+			i--
+			dAtA[i] = 0x49
+		}
+		if x.KeysDone != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeysDone))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.KeysTotal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeysTotal))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StoresDone != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StoresDone))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StoresTotal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StoresTotal))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MigratedVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MigratedVersion))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Phase) > 0 {
+			i -= len(x.Phase)
+			copy(dAtA[i:], x.Phase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Phase)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Migrating {
+			i--
+			if x.Migrating {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryMigrationStatusResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMigrationStatusResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryMigrationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Migrating", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Migrating = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Phase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MigratedVersion", wireType)
+				}
+				x.MigratedVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MigratedVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoresTotal", wireType)
+				}
+				x.StoresTotal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StoresTotal |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoresDone", wireType)
+				}
+				x.StoresDone = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StoresDone |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeysTotal", wireType)
+				}
+				x.KeysTotal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeysTotal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeysDone", wireType)
+				}
+				x.KeysDone = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeysDone |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 1 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+				}
+				var v uint64
+				if (iNdEx + 8) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				x.Percent = float64(math.Float64frombits(v))
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eta", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Eta == nil {
+					x.Eta = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Eta); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+				}
+				x.BytesWritten = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BytesWritten |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stores = append(x.Stores, &StoreMigrationStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stores[len(x.Stores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartedAt == nil {
+					x.StartedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedAt == nil {
+					x.UpdatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StoreMigrationStatus               protoreflect.MessageDescriptor
+	fd_StoreMigrationStatus_name          protoreflect.FieldDescriptor
+	fd_StoreMigrationStatus_done          protoreflect.FieldDescriptor
+	fd_StoreMigrationStatus_keys_total    protoreflect.FieldDescriptor
+	fd_StoreMigrationStatus_keys_written  protoreflect.FieldDescriptor
+	fd_StoreMigrationStatus_bytes_written protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_migration_v1_query_proto_init()
+	md_StoreMigrationStatus = File_cosmos_store_migration_v1_query_proto.Messages().ByName("StoreMigrationStatus")
+	fd_StoreMigrationStatus_name = md_StoreMigrationStatus.Fields().ByName("name")
+	fd_StoreMigrationStatus_done = md_StoreMigrationStatus.Fields().ByName("done")
+	fd_StoreMigrationStatus_keys_total = md_StoreMigrationStatus.Fields().ByName("keys_total")
+	fd_StoreMigrationStatus_keys_written = md_StoreMigrationStatus.Fields().ByName("keys_written")
+	fd_StoreMigrationStatus_bytes_written = md_StoreMigrationStatus.Fields().ByName("bytes_written")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreMigrationStatus)(nil)
+
+type fastReflection_StoreMigrationStatus StoreMigrationStatus
+
+func (x *StoreMigrationStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreMigrationStatus)(x)
+}
+
+func (x *StoreMigrationStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreMigrationStatus_messageType fastReflection_StoreMigrationStatus_messageType
+var _ protoreflect.MessageType = fastReflection_StoreMigrationStatus_messageType{}
+
+type fastReflection_StoreMigrationStatus_messageType struct{}
+
+func (x fastReflection_StoreMigrationStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreMigrationStatus)(nil)
+}
+func (x fastReflection_StoreMigrationStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreMigrationStatus)
+}
+func (x fastReflection_StoreMigrationStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreMigrationStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreMigrationStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreMigrationStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreMigrationStatus) Type() protoreflect.MessageType {
+	return _fastReflection_StoreMigrationStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreMigrationStatus) New() protoreflect.Message {
+	return new(fastReflection_StoreMigrationStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreMigrationStatus) Interface() protoreflect.ProtoMessage {
+	return (*StoreMigrationStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreMigrationStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_StoreMigrationStatus_name, value) {
+			return
+		}
+	}
+	if x.Done != false {
+		value := protoreflect.ValueOfBool(x.Done)
+		if !f(fd_StoreMigrationStatus_done, value) {
+			return
+		}
+	}
+	if x.KeysTotal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeysTotal)
+		if !f(fd_StoreMigrationStatus_keys_total, value) {
+			return
+		}
+	}
+	if x.KeysWritten != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.KeysWritten)
+		if !f(fd_StoreMigrationStatus_keys_written, value) {
+			return
+		}
+	}
+	if x.BytesWritten != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BytesWritten)
+		if !f(fd_StoreMigrationStatus_bytes_written, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreMigrationStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		return x.Name != ""
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		return x.Done != false
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		return x.KeysTotal != uint64(0)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		return x.KeysWritten != uint64(0)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		return x.BytesWritten != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMigrationStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		x.Name = ""
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		x.Done = false
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		x.KeysTotal = uint64(0)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		x.KeysWritten = uint64(0)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		x.BytesWritten = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreMigrationStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		value := x.Done
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		value := x.KeysTotal
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		value := x.KeysWritten
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		value := x.BytesWritten
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMigrationStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		x.Done = value.Bool()
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		x.KeysTotal = value.Uint()
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		x.KeysWritten = value.Uint()
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		x.BytesWritten = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMigrationStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		panic(fmt.Errorf("field name of message cosmos.store.migration.v1.StoreMigrationStatus is not mutable"))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		panic(fmt.Errorf("field done of message cosmos.store.migration.v1.StoreMigrationStatus is not mutable"))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		panic(fmt.Errorf("field keys_total of message cosmos.store.migration.v1.StoreMigrationStatus is not mutable"))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		panic(fmt.Errorf("field keys_written of message cosmos.store.migration.v1.StoreMigrationStatus is not mutable"))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		panic(fmt.Errorf("field bytes_written of message cosmos.store.migration.v1.StoreMigrationStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreMigrationStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.migration.v1.StoreMigrationStatus.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.store.migration.v1.StoreMigrationStatus.done":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.keys_written":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.migration.v1.StoreMigrationStatus.bytes_written":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.migration.v1.StoreMigrationStatus"))
+		}
+		panic(fmt.Errorf("message cosmos.store.migration.v1.StoreMigrationStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreMigrationStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.migration.v1.StoreMigrationStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreMigrationStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreMigrationStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreMigrationStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreMigrationStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreMigrationStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Done {
+			n += 2
+		}
+		if x.KeysTotal != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeysTotal))
+		}
+		if x.KeysWritten != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeysWritten))
+		}
+		if x.BytesWritten != 0 {
+			n += 1 + runtime.Sov(uint64(x.BytesWritten))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreMigrationStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BytesWritten != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BytesWritten))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.KeysWritten != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeysWritten))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.KeysTotal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeysTotal))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Done {
+			i--
+			if x.Done {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreMigrationStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreMigrationStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreMigrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Done = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeysTotal", wireType)
+				}
+				x.KeysTotal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeysTotal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+				}
+				x.KeysWritten = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeysWritten |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+				}
+				x.BytesWritten = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BytesWritten |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/store/migration/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryMigrationStatusRequest is the request type for the Query/MigrationStatus RPC method.
+type QueryMigrationStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryMigrationStatusRequest) Reset() {
+	*x = QueryMigrationStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMigrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMigrationStatusRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryMigrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*QueryMigrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_migration_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryMigrationStatusResponse is the response type for the Query/MigrationStatus RPC method.
+type QueryMigrationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// migrating is false if the store isn't migrated from store/v1, the other
+	// fields are then unset.
+	Migrating bool `protobuf:"varint,1,opt,name=migrating,proto3" json:"migrating,omitempty"`
+	// phase is the phase of the migration: migrating, syncing or done.
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// height is the height at which the state is migrated.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// migrated_version is the latest version migrated, including the changesets
+	// caught up, 0 until the state at the migration height is migrated.
+	MigratedVersion uint64 `protobuf:"varint,4,opt,name=migrated_version,json=migratedVersion,proto3" json:"migrated_version,omitempty"`
+	// stores_total is the number of stores to migrate, 0 if unknown.
+	StoresTotal int64 `protobuf:"varint,5,opt,name=stores_total,json=storesTotal,proto3" json:"stores_total,omitempty"`
+	StoresDone  int64 `protobuf:"varint,6,opt,name=stores_done,json=storesDone,proto3" json:"stores_done,omitempty"`
+	// keys_total is the number of keys to migrate, 0 if unknown.
+	KeysTotal uint64 `protobuf:"varint,7,opt,name=keys_total,json=keysTotal,proto3" json:"keys_total,omitempty"`
+	KeysDone  uint64 `protobuf:"varint,8,opt,name=keys_done,json=keysDone,proto3" json:"keys_done,omitempty"`
+	// percent is the percentage of the keys migrated at the migration height, or
+	// of the stores if the number of keys is unknown.
+	Percent float64 `protobuf:"fixed64,9,opt,name=percent,proto3" json:"percent,omitempty"`
+	// eta is the estimated remaining time of the migration at the migration height.
+	Eta          *durationpb.Duration    `protobuf:"bytes,10,opt,name=eta,proto3" json:"eta,omitempty"`
+	BytesWritten uint64                  `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Stores       []*StoreMigrationStatus `protobuf:"bytes,12,rep,name=stores,proto3" json:"stores,omitempty"`
+	StartedAt    *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *QueryMigrationStatusResponse) Reset() {
+	*x = QueryMigrationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMigrationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMigrationStatusResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryMigrationStatusResponse.ProtoReflect.Descriptor instead.
+func (*QueryMigrationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_migration_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryMigrationStatusResponse) GetMigrating() bool {
+	if x != nil {
+		return x.Migrating
+	}
+	return false
+}
+
+func (x *QueryMigrationStatusResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *QueryMigrationStatusResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetMigratedVersion() uint64 {
+	if x != nil {
+		return x.MigratedVersion
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetStoresTotal() int64 {
+	if x != nil {
+		return x.StoresTotal
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetStoresDone() int64 {
+	if x != nil {
+		return x.StoresDone
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetKeysTotal() uint64 {
+	if x != nil {
+		return x.KeysTotal
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetKeysDone() uint64 {
+	if x != nil {
+		return x.KeysDone
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetEta() *durationpb.Duration {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *QueryMigrationStatusResponse) GetBytesWritten() uint64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *QueryMigrationStatusResponse) GetStores() []*StoreMigrationStatus {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *QueryMigrationStatusResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *QueryMigrationStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// StoreMigrationStatus is the migration progress of a store.
+type StoreMigrationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// done is true once the store is fully migrated at the migration height.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// keys_total is the number of keys of the store at the migration height, 0 if
+	// unknown.
+	KeysTotal uint64 `protobuf:"varint,3,opt,name=keys_total,json=keysTotal,proto3" json:"keys_total,omitempty"`
+	// keys_written and bytes_written are the number of keys and bytes (keys and
+	// values) written to the state storage.
+	KeysWritten  uint64 `protobuf:"varint,4,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	BytesWritten uint64 `protobuf:"varint,5,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+}
+
+func (x *StoreMigrationStatus) Reset() {
+	*x = StoreMigrationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_migration_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreMigrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreMigrationStatus) ProtoMessage() {}
+
+// Deprecated: Use StoreMigrationStatus.ProtoReflect.Descriptor instead.
+func (*StoreMigrationStatus) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_migration_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoreMigrationStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreMigrationStatus) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *StoreMigrationStatus) GetKeysTotal() uint64 {
+	if x != nil {
+		return x.KeysTotal
+	}
+	return 0
+}
+
+func (x *StoreMigrationStatus) GetKeysWritten() uint64 {
+	if x != nil {
+		return x.KeysWritten
+	}
+	return 0
+}
+
+func (x *StoreMigrationStatus) GetBytesWritten() uint64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+var File_cosmos_store_migration_v1_query_proto protoreflect.FileDescriptor
+
+var file_cosmos_store_migration_v1_query_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x04, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x03, 0x65, 0x74, 0x61, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa5, 0x01,
+	0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x32, 0xb7, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0xad, 0x01, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0xea, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x4d, 0xaa, 0x02, 0x19,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x5c, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_store_migration_v1_query_proto_rawDescOnce sync.Once
+	file_cosmos_store_migration_v1_query_proto_rawDescData = file_cosmos_store_migration_v1_query_proto_rawDesc
+)
+
+func file_cosmos_store_migration_v1_query_proto_rawDescGZIP() []byte {
+	file_cosmos_store_migration_v1_query_proto_rawDescOnce.Do(func() {
+		file_cosmos_store_migration_v1_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_store_migration_v1_query_proto_rawDescData)
+	})
+	return file_cosmos_store_migration_v1_query_proto_rawDescData
+}
+
+var file_cosmos_store_migration_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_store_migration_v1_query_proto_goTypes = []interface{}{
+	(*QueryMigrationStatusRequest)(nil),  // 0: cosmos.store.migration.v1.QueryMigrationStatusRequest
+	(*QueryMigrationStatusResponse)(nil), // 1: cosmos.store.migration.v1.QueryMigrationStatusResponse
+	(*StoreMigrationStatus)(nil),         // 2: cosmos.store.migration.v1.StoreMigrationStatus
+	(*durationpb.Duration)(nil),          // 3: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 4: google.protobuf.Timestamp
+}
+var file_cosmos_store_migration_v1_query_proto_depIdxs = []int32{
+	3, // 0: cosmos.store.migration.v1.QueryMigrationStatusResponse.eta:type_name -> google.protobuf.Duration
+	2, // 1: cosmos.store.migration.v1.QueryMigrationStatusResponse.stores:type_name -> cosmos.store.migration.v1.StoreMigrationStatus
+	4, // 2: cosmos.store.migration.v1.QueryMigrationStatusResponse.started_at:type_name -> google.protobuf.Timestamp
+	4, // 3: cosmos.store.migration.v1.QueryMigrationStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 4: cosmos.store.migration.v1.Query.MigrationStatus:input_type -> cosmos.store.migration.v1.QueryMigrationStatusRequest
+	1, // 5: cosmos.store.migration.v1.Query.MigrationStatus:output_type -> cosmos.store.migration.v1.QueryMigrationStatusResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_store_migration_v1_query_proto_init() }
+func file_cosmos_store_migration_v1_query_proto_init() {
+	if File_cosmos_store_migration_v1_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_store_migration_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMigrationStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_migration_v1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMigrationStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_migration_v1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreMigrationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_store_migration_v1_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cosmos_store_migration_v1_query_proto_goTypes,
+		DependencyIndexes: file_cosmos_store_migration_v1_query_proto_depIdxs,
+		MessageInfos:      file_cosmos_store_migration_v1_query_proto_msgTypes,
+	}.Build()
+	File_cosmos_store_migration_v1_query_proto = out.File
+	file_cosmos_store_migration_v1_query_proto_rawDesc = nil
+	file_cosmos_store_migration_v1_query_proto_goTypes = nil
+	file_cosmos_store_migration_v1_query_proto_depIdxs = nil
+}
@@ -21,6 +21,7 @@ import (
 	"cosmossdk.io/core/log"
 	errorsmod "cosmossdk.io/errors"
 	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
@@ -178,6 +179,13 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// appDataListener is the appdata.Listener set with SetAppDataListener, if any
+	appDataListener *appdata.Listener
+
+	// appDataDone stops the goroutine of the appdata.Listener registered with
+	// RegisterAppDataListener, if any
+	appDataDone chan struct{}
+
 	chainID string
 
 	cdc codec.Codec
@@ -1143,6 +1151,12 @@ func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
 func (app *BaseApp) Close() error {
 	var errs []error
 
+	// Stop the appdata.Listener goroutine
+	if app.appDataDone != nil {
+		close(app.appDataDone)
+		app.appDataDone = nil
+	}
+
 	// Close app.db (opened by cosmos-sdk/server/start.go call to openDB)
 	if app.db != nil {
 		app.logger.Info("Closing application.db")
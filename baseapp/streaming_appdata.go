@@ -0,0 +1,265 @@
+package baseapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	storetypes "cosmossdk.io/store/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	StreamingAppDataTomlKey           = "appdata"
+	StreamingAppDataEnableTomlKey     = "enable"
+	StreamingAppDataKeysTomlKey       = "keys"
+	StreamingAppDataBufferSizeTomlKey = "buffer-size"
+)
+
+// AppDataListener returns the appdata.Listener set with SetAppDataListener, nil if
+// none is set. The app registers it with RegisterAppDataListener, once its modules
+// are known, so that it can be wrapped with appdata.DecodingMiddleware.
+func (app *BaseApp) AppDataListener() *appdata.Listener {
+	return app.appDataListener
+}
+
+// AppDataListenerEnabled returns true if the appdata.Listener is enabled in the
+// streaming.appdata section of the app options.
+func AppDataListenerEnabled(appOpts servertypes.AppOptions) bool {
+	enableKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingAppDataTomlKey, StreamingAppDataEnableTomlKey)
+	return cast.ToBool(appOpts.Get(enableKey))
+}
+
+// RegisterAppDataListener registers a listener receiving the blocks, transactions,
+// events and state changes of the kv store keys configured in the streaming.appdata
+// section of the app options, if it is enabled. When the buffer size is greater than
+// zero, the listener processes the data in its own goroutine, until the commit of
+// the block, which is stopped when the app is closed.
+//
+// The state changes are passed as key-value pairs, with the store key names as
+// module names: the listener can be wrapped with appdata.DecodingMiddleware to
+// receive the object updates of the modules.
+func (app *BaseApp) RegisterAppDataListener(
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	listener appdata.Listener,
+) {
+	if !AppDataListenerEnabled(appOpts) {
+		return
+	}
+
+	bufferSizeKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingAppDataTomlKey, StreamingAppDataBufferSizeTomlKey)
+	if bufferSize := cast.ToInt(appOpts.Get(bufferSizeKey)); bufferSize > 0 {
+		app.appDataDone = make(chan struct{})
+		listener = appdata.AsyncListener(listener, appdata.AsyncListenerOptions{
+			BufferSize: bufferSize,
+			DoneChan:   app.appDataDone,
+		})
+	}
+
+	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingAppDataTomlKey, StreamingAppDataKeysTomlKey)
+	exposedKeys := exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(keysKey)), keys)
+	app.cms.AddListeners(exposedKeys)
+
+	exposed := make(map[string]struct{}, len(exposedKeys))
+	for _, key := range exposedKeys {
+		exposed[key.Name()] = struct{}{}
+	}
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, &appDataABCIListener{
+		listener: listener,
+		keys:     exposed,
+	})
+}
+
+// AppDataSyncSource returns the source of the state of the given kv store keys, by
+// store key name, at the versions kept by the multistore, so that an appdata.Listener
+// attached to a running node can catch up with its state with appdata.CatchUpListener.
+func (app *BaseApp) AppDataSyncSource(keys map[string]*storetypes.KVStoreKey) appdata.SyncSource {
+	return appDataSyncSource{cms: app.cms, keys: keys}
+}
+
+type appDataSyncSource struct {
+	cms  storetypes.CommitMultiStore
+	keys map[string]*storetypes.KVStoreKey
+}
+
+func (s appDataSyncSource) IterateAllKVPairs(version uint64, moduleName string, fn func(key, value []byte) error) error {
+	key, ok := s.keys[moduleName]
+	if !ok {
+		// the module has no state
+		return nil
+	}
+
+	ms, err := s.cms.CacheMultiStoreWithVersion(int64(version))
+	if err != nil {
+		return err
+	}
+	itr := ms.GetKVStore(key).Iterator(nil, nil)
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := fn(itr.Key(), itr.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appDataABCIListener passes the data of the ABCIListener hooks to an appdata.Listener.
+type appDataABCIListener struct {
+	listener appdata.Listener
+	// keys are the names of the store keys whose state changes are passed to the listener.
+	keys map[string]struct{}
+}
+
+var _ storetypes.ABCIListener = &appDataABCIListener{}
+
+// appDataBlockHeader is the JSON representation of the block header passed to the listener.
+type appDataBlockHeader struct {
+	Height          int64     `json:"height"`
+	Time            time.Time `json:"time"`
+	Hash            []byte    `json:"hash"`
+	ProposerAddress []byte    `json:"proposer_address"`
+}
+
+func (l *appDataABCIListener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	if l.listener.StartBlock != nil {
+		header := appDataBlockHeader{
+			Height:          req.Height,
+			Time:            req.Time,
+			Hash:            req.Hash,
+			ProposerAddress: req.ProposerAddress,
+		}
+		err := l.listener.StartBlock(appdata.StartBlockData{
+			Height:     uint64(req.Height),
+			HeaderJSON: func() (json.RawMessage, error) { return json.Marshal(header) },
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if l.listener.OnTx != nil {
+		for i, txBytes := range req.Txs {
+			txBytes := txBytes
+			var txResult *abci.ExecTxResult
+			if i < len(res.TxResults) {
+				txResult = res.TxResults[i]
+			}
+			err := l.listener.OnTx(appdata.TxData{
+				TxIndex: int32(i),
+				Bytes:   func() ([]byte, error) { return txBytes, nil },
+				JSON:    func() (json.RawMessage, error) { return json.Marshal(txResult) },
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if l.listener.OnEvent == nil {
+		return nil
+	}
+
+	// the block events are emitted by the begin blockers, unless marked as emitted
+	// by the end blockers
+	var beginBlockIndex, endBlockIndex uint32
+	for _, event := range res.Events {
+		data := appdata.EventData{TxIndex: -1, EventIndex: beginBlockIndex, Type: event.Type}
+		if eventAttribute(event, "mode") == "EndBlock" {
+			data.TxIndex, data.EventIndex = -2, endBlockIndex
+			endBlockIndex++
+		} else {
+			beginBlockIndex++
+		}
+		data.Data = eventJSON(event)
+		if err := l.listener.OnEvent(data); err != nil {
+			return err
+		}
+	}
+
+	for i, txResult := range res.TxResults {
+		// the events are indexed in the message which emitted them, the events
+		// without a message index, such as the ante handler ones, in the first one
+		eventIndexes := map[uint32]uint32{}
+		for _, event := range txResult.Events {
+			var msgIndex uint32
+			if idx, err := strconv.ParseUint(eventAttribute(event, "msg_index"), 10, 32); err == nil {
+				msgIndex = uint32(idx)
+			}
+			err := l.listener.OnEvent(appdata.EventData{
+				TxIndex:    int32(i),
+				MsgIndex:   msgIndex,
+				EventIndex: eventIndexes[msgIndex],
+				Type:       event.Type,
+				Data:       eventJSON(event),
+			})
+			if err != nil {
+				return err
+			}
+			eventIndexes[msgIndex]++
+		}
+	}
+
+	return nil
+}
+
+func (l *appDataABCIListener) ListenCommit(_ context.Context, _ abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	if l.listener.OnKVPair != nil {
+		updates := make([]appdata.ModuleKVPairUpdate, 0, len(changeSet))
+		for _, pair := range changeSet {
+			// the change set contains the changes of the stores of all the listeners
+			if _, ok := l.keys[pair.StoreKey]; !ok {
+				continue
+			}
+			updates = append(updates, appdata.ModuleKVPairUpdate{
+				ModuleName: pair.StoreKey,
+				Update: schema.KVPairUpdate{
+					Key:    pair.Key,
+					Value:  pair.Value,
+					Delete: pair.Delete,
+				},
+			})
+		}
+		if len(updates) > 0 {
+			if err := l.listener.OnKVPair(appdata.KVPairData{Updates: updates}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if l.listener.Commit != nil {
+		return l.listener.Commit(appdata.CommitData{})
+	}
+	return nil
+}
+
+// eventAttribute returns the value of the attribute of the event with the given key,
+// or an empty string if there is none.
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// eventJSON returns the JSON object of the attributes of the event.
+func eventJSON(event abci.Event) appdata.ToJSON {
+	return func() (json.RawMessage, error) {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		return json.Marshal(attrs)
+	}
+}
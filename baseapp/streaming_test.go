@@ -9,10 +9,13 @@ import (
 	tmproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -146,3 +149,152 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestABCI_AppDataListener(t *testing.T) {
+	var (
+		heights  []uint64
+		txs      []int32
+		events   []appdata.EventData
+		kvPairs  []appdata.ModuleKVPairUpdate
+		commits  int
+		listener = appdata.Listener{
+			StartBlock: func(data appdata.StartBlockData) error {
+				heights = append(heights, data.Height)
+				return nil
+			},
+			OnTx: func(data appdata.TxData) error {
+				txs = append(txs, data.TxIndex)
+				return nil
+			},
+			OnEvent: func(data appdata.EventData) error {
+				events = append(events, data)
+				return nil
+			},
+			OnKVPair: func(data appdata.KVPairData) error {
+				kvPairs = append(kvPairs, data.Updates...)
+				return nil
+			},
+			Commit: func(appdata.CommitData) error {
+				commits++
+				return nil
+			},
+		}
+	)
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.appdata.enable": true,
+		"streaming.appdata.keys":   []string{distKey1.Name()},
+	}
+	listenerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.RegisterAppDataListener(appOpts, map[string]*storetypes.KVStoreKey{
+			capKey1.Name():  capKey1,
+			distKey1.Name(): distKey1,
+		}, listener)
+	}
+	// the state changes of the keys of the other listeners aren't passed
+	addListenerOpt := func(bapp *baseapp.BaseApp) { bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{capKey1}) }
+	suite := NewBaseAppSuite(t, anteOpt, distOpt, listenerOpt, addListenerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	var blockTxs [][]byte
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+		require.NoError(t, err)
+		blockTxs = append(blockTxs, txBytes)
+	}
+
+	// create final block context state
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte("dist-key"), []byte("dist-value"))
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: blockTxs})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 1}, heights)
+	require.Equal(t, []int32{0, 1}, txs)
+	require.Equal(t, 1, commits)
+
+	// the events of each transaction are indexed in their message, the ante
+	// handler event coming first
+	var txEvents []appdata.EventData
+	for _, event := range events {
+		if event.TxIndex == 1 {
+			txEvents = append(txEvents, event)
+		}
+	}
+	require.Len(t, txEvents, 3)
+	require.Equal(t, uint32(0), txEvents[0].EventIndex)
+	require.Equal(t, uint32(0), txEvents[1].MsgIndex)
+	require.Equal(t, uint32(2), txEvents[2].EventIndex)
+	data, err := txEvents[2].Data()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"msg_index":"0"`)
+
+	// only the state changes of the exposed keys are passed
+	require.Equal(t, []appdata.ModuleKVPairUpdate{{
+		ModuleName: distKey1.Name(),
+		Update:     schema.KVPairUpdate{Key: []byte("dist-key"), Value: []byte("dist-value")},
+	}}, kvPairs)
+}
+
+func TestABCI_AppDataListenerDisabled(t *testing.T) {
+	listenerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.RegisterAppDataListener(simtestutil.AppOptionsMap{}, map[string]*storetypes.KVStoreKey{}, appdata.Listener{
+			Commit: func(appdata.CommitData) error {
+				t.Fatal("unexpected commit")
+				return nil
+			},
+		})
+	}
+	suite := NewBaseAppSuite(t, listenerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+}
+
+func TestABCI_AppDataSyncSource(t *testing.T) {
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	suite := NewBaseAppSuite(t, distOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		getFinalizeBlockStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte(fmt.Sprintf("key%d", height)), []byte("value"))
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	source := suite.baseApp.AppDataSyncSource(map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1})
+	iterate := func(version uint64, moduleName string) []string {
+		var keys []string
+		err := source.IterateAllKVPairs(version, moduleName, func(key, _ []byte) error {
+			keys = append(keys, string(key))
+			return nil
+		})
+		require.NoError(t, err)
+		return keys
+	}
+	require.Equal(t, []string{"key1"}, iterate(1, distKey1.Name()))
+	require.Equal(t, []string{"key1", "key2"}, iterate(2, distKey1.Name()))
+	require.Empty(t, iterate(2, "unknown"))
+}
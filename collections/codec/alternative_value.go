@@ -45,3 +45,7 @@ func (a AltValueCodec[V]) DecodeJSON(b []byte) (V, error) { return a.canonicalVa
 func (a AltValueCodec[V]) Stringify(value V) string { return a.canonicalValueCodec.Stringify(value) }
 
 func (a AltValueCodec[V]) ValueType() string { return a.canonicalValueCodec.ValueType() }
+
+func (a AltValueCodec[V]) SchemaCodec() (SchemaCodec[V], error) {
+	return ValueSchemaCodec(a.canonicalValueCodec)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewBoolKey[T ~bool]() KeyCodec[T] { return boolKey[T]{} }
@@ -64,3 +66,7 @@ func (b boolKey[T]) DecodeNonTerminal(buffer []byte) (int, T, error) {
 func (b boolKey[T]) SizeNonTerminal(key T) int {
 	return b.Size(key)
 }
+
+func (boolKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.BoolKind, func(v T) bool { return bool(v) }, func(b bool) T { return T(b) }), nil
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+
+	"cosmossdk.io/schema"
 )
 
 // MaxBytesKeyNonTerminalSize defines the maximum length of a bytes key encoded
@@ -77,3 +79,7 @@ func (bytesKey[T]) DecodeNonTerminal(buffer []byte) (int, T, error) {
 func (bytesKey[T]) SizeNonTerminal(key T) int {
 	return len(key) + 1
 }
+
+func (bytesKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.BytesKind, func(v T) []byte { return v }, func(b []byte) T { return b }), nil
+}
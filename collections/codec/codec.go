@@ -167,3 +167,7 @@ func (k keyToValueCodec[K]) Stringify(value K) string {
 func (k keyToValueCodec[K]) ValueType() string {
 	return k.kc.KeyType()
 }
+
+func (k keyToValueCodec[K]) SchemaCodec() (SchemaCodec[K], error) {
+	return KeySchemaCodec(k.kc)
+}
@@ -0,0 +1,101 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/schema"
+)
+
+// HasSchemaCodec is implemented by the codecs which describe their values as
+// schema fields, which makes the collections using them logically decodable, see
+// cosmossdk.io/schema. It isn't required by KeyCodec and ValueCodec, the codecs
+// which don't implement it fall back to the default schema codec, see
+// KeySchemaCodec and ValueSchemaCodec.
+type HasSchemaCodec[T any] interface {
+	// SchemaCodec returns the schema codec of the codec.
+	SchemaCodec() (SchemaCodec[T], error)
+}
+
+// SchemaCodec converts the values of a codec to and from the values of schema fields.
+type SchemaCodec[T any] struct {
+	// Fields are the schema fields the values are converted to. The names of the
+	// fields can be left empty, in which case they are named after their position
+	// in the key or value of the collection. No field means that the codec has no
+	// logical value, such as the key of an Item or the value of a KeySet.
+	Fields []schema.Field
+
+	// ToSchemaType converts a value to the value of the fields, which must be valid
+	// for schema.ValidateObjectKey or schema.ValidateObjectValue: a single value for
+	// a single field or a slice of values otherwise. If nil, the value is used as is.
+	ToSchemaType func(T) (any, error)
+
+	// FromSchemaType converts the value of the fields to a value.
+	// If nil, the value of the fields is used as is.
+	FromSchemaType func(any) (T, error)
+}
+
+// KeySchemaCodec returns the schema codec of the given KeyCodec, a default one if
+// it doesn't implement HasSchemaCodec.
+func KeySchemaCodec[K any](cdc KeyCodec[K]) (SchemaCodec[K], error) {
+	if cdc, ok := cdc.(HasSchemaCodec[K]); ok {
+		return cdc.SchemaCodec()
+	}
+	return fallbackSchemaCodec(cdc.EncodeJSON, cdc.DecodeJSON), nil
+}
+
+// ValueSchemaCodec returns the schema codec of the given ValueCodec, a default one
+// if it doesn't implement HasSchemaCodec.
+func ValueSchemaCodec[V any](cdc ValueCodec[V]) (SchemaCodec[V], error) {
+	if cdc, ok := cdc.(HasSchemaCodec[V]); ok {
+		return cdc.SchemaCodec()
+	}
+	return fallbackSchemaCodec(cdc.EncodeJSON, cdc.DecodeJSON), nil
+}
+
+// fallbackSchemaCodec returns a single field schema codec, of the kind of T if T
+// is a basic go type, see schema.KindForGoValue, or of JSON kind otherwise, the
+// values being converted with the JSON encoding of the codec.
+func fallbackSchemaCodec[T any](encodeJSON func(T) ([]byte, error), decodeJSON func([]byte) (T, error)) SchemaCodec[T] {
+	var zero T
+	if kind := schema.KindForGoValue(zero); kind != schema.InvalidKind {
+		return SchemaCodec[T]{Fields: []schema.Field{{Kind: kind}}}
+	}
+
+	return SchemaCodec[T]{
+		Fields: []schema.Field{{Kind: schema.JSONKind}},
+		ToSchemaType: func(value T) (any, error) {
+			bz, err := encodeJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			return json.RawMessage(bz), nil
+		},
+		FromSchemaType: func(value any) (T, error) {
+			bz, ok := value.(json.RawMessage)
+			if !ok {
+				return zero, fmt.Errorf("%w: expected json.RawMessage, got %T", ErrEncoding, value)
+			}
+			return decodeJSON(bz)
+		},
+	}
+}
+
+// kindSchemaCodec returns a single field schema codec of the given kind, the values
+// being converted to and from the go type S of the kind.
+func kindSchemaCodec[T, S any](kind schema.Kind, to func(T) S, from func(S) T) SchemaCodec[T] {
+	return SchemaCodec[T]{
+		Fields: []schema.Field{{Kind: kind}},
+		ToSchemaType: func(value T) (any, error) {
+			return to(value), nil
+		},
+		FromSchemaType: func(value any) (T, error) {
+			s, ok := value.(S)
+			if !ok {
+				var zero T
+				return zero, fmt.Errorf("%w: expected %T, got %T", ErrEncoding, *new(S), value)
+			}
+			return from(s), nil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package codec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+)
+
+func testSchemaCodec[T any](t *testing.T, cdc SchemaCodec[T], kind schema.Kind, value T) {
+	t.Helper()
+
+	require.Len(t, cdc.Fields, 1)
+	require.Equal(t, kind, cdc.Fields[0].Kind)
+
+	schemaValue, err := cdc.ToSchemaType(value)
+	require.NoError(t, err)
+	require.NoError(t, kind.ValidateValue(schemaValue))
+	decoded, err := cdc.FromSchemaType(schemaValue)
+	require.NoError(t, err)
+	require.Equal(t, value, decoded)
+
+	_, err = cdc.FromSchemaType(struct{}{})
+	require.ErrorIs(t, err, ErrEncoding)
+}
+
+func TestSchemaCodec(t *testing.T) {
+	type address []byte
+	type denom string
+
+	bytesCodec, err := KeySchemaCodec(NewBytesKey[address]())
+	require.NoError(t, err)
+	testSchemaCodec(t, bytesCodec, schema.BytesKind, address{0x1, 0x2})
+
+	stringCodec, err := ValueSchemaCodec(KeyToValueCodec(NewStringKeyCodec[denom]()))
+	require.NoError(t, err)
+	testSchemaCodec(t, stringCodec, schema.StringKind, denom("atom"))
+
+	boolCodec, err := KeySchemaCodec(NewBoolKey[bool]())
+	require.NoError(t, err)
+	testSchemaCodec(t, boolCodec, schema.BoolKind, true)
+
+	intCodec, err := KeySchemaCodec(NewInt32Key[int32]())
+	require.NoError(t, err)
+	testSchemaCodec(t, intCodec, schema.Int32Kind, int32(-3))
+
+	uintCodec, err := ValueSchemaCodec(NewAltValueCodec(KeyToValueCodec(NewUint16Key[uint16]()), nil))
+	require.NoError(t, err)
+	testSchemaCodec(t, uintCodec, schema.Uint16Kind, uint16(3))
+
+	// the codecs which don't implement HasSchemaCodec use their JSON encoding
+	type coin struct {
+		Denom  string `json:"denom"`
+		Amount int    `json:"amount"`
+	}
+	jsonCodec, err := ValueSchemaCodec[coin](jsonValueCodec[coin]{})
+	require.NoError(t, err)
+	testSchemaCodec(t, jsonCodec, schema.JSONKind, coin{Denom: "atom", Amount: 10})
+	value, err := jsonCodec.ToSchemaType(coin{Denom: "atom", Amount: 10})
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`{"denom":"atom","amount":10}`), value)
+}
+
+// jsonValueCodec is a ValueCodec encoding the values as JSON, which doesn't
+// implement HasSchemaCodec.
+type jsonValueCodec[T any] struct{}
+
+func (jsonValueCodec[T]) Encode(value T) ([]byte, error) { return json.Marshal(value) }
+
+func (jsonValueCodec[T]) Decode(b []byte) (T, error) {
+	var value T
+	err := json.Unmarshal(b, &value)
+	return value, err
+}
+
+func (c jsonValueCodec[T]) EncodeJSON(value T) ([]byte, error) { return c.Encode(value) }
+
+func (c jsonValueCodec[T]) DecodeJSON(b []byte) (T, error) { return c.Decode(b) }
+
+func (jsonValueCodec[T]) Stringify(value T) string { return "" }
+
+func (jsonValueCodec[T]) ValueType() string { return "json" }
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewInt64Key[T ~int64]() KeyCodec[T] { return int64Key[T]{} }
@@ -121,3 +123,11 @@ func (i int32Key[T]) DecodeNonTerminal(buffer []byte) (int, T, error) {
 func (i int32Key[T]) SizeNonTerminal(_ T) int {
 	return 4
 }
+
+func (int64Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.Int64Kind, func(v T) int64 { return int64(v) }, func(i int64) T { return T(i) }), nil
+}
+
+func (int32Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.Int32Kind, func(v T) int32 { return int32(v) }, func(i int32) T { return T(i) }), nil
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"cosmossdk.io/schema"
 )
 
 func NewStringKeyCodec[T ~string]() KeyCodec[T] { return stringKey[T]{} }
@@ -66,3 +68,7 @@ func (stringKey[T]) Stringify(key T) string {
 func (stringKey[T]) KeyType() string {
 	return "string"
 }
+
+func (stringKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.StringKind, func(v T) string { return string(v) }, func(s string) T { return T(s) }), nil
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewUint64Key[T ~uint64]() KeyCodec[T] { return uint64Key[T]{} }
@@ -148,3 +150,15 @@ func uintDecodeJSON(b []byte, bitSize int) (uint64, error) {
 	}
 	return strconv.ParseUint(str, 10, bitSize)
 }
+
+func (uint64Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.Uint64Kind, func(v T) uint64 { return uint64(v) }, func(i uint64) T { return T(i) }), nil
+}
+
+func (uint32Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.Uint32Kind, func(v T) uint32 { return uint32(v) }, func(i uint32) T { return T(i) }), nil
+}
+
+func (uint16Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return kindSchemaCodec(schema.Uint16Kind, func(v T) uint16 { return uint16(v) }, func(i uint16) T { return T(i) }), nil
+}
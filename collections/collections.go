@@ -90,6 +90,14 @@ type Collection interface {
 	ValueCodec() codec.UntypedValueCodec
 
 	genesisHandler
+
+	// schemaCodec returns the codec decoding the KV pairs of the collection into
+	// object updates of the module schema.
+	schemaCodec() (collectionSchemaCodec, error)
+
+	// isSecondaryIndex reports whether the collection is the secondary index of
+	// an IndexedMap.
+	isSecondaryIndex() bool
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
@@ -30,6 +30,13 @@ type Index[PrimaryKey, Value any] interface {
 	Unreference(ctx context.Context, pk PrimaryKey, lazyOldValue func() (Value, error)) error
 }
 
+// ClearableIndex is an Index which can remove all its references, as required by
+// IndexedMap.RebuildIndexes.
+type ClearableIndex interface {
+	// Clear removes all the references of the index.
+	Clear(ctx context.Context) error
+}
+
 // IndexedMap works like a Map but creates references between fields of Value and its PrimaryKey.
 // These relationships are expressed and maintained using the Indexes type.
 // Internally IndexedMap can be seen as a partitioned collection, one partition
@@ -174,6 +181,59 @@ func (m *IndexedMap[PrimaryKey, Value, Idx]) ValueCodec() codec.ValueCodec[Value
 	return m.m.ValueCodec()
 }
 
+// rebuildIndexesBatchSize is the number of objects referenced by RebuildIndexes
+// between two iterations of the map.
+const rebuildIndexesBatchSize = 1000
+
+// RebuildIndexes removes all the references of the indexes and references all the
+// objects of the map again. It is meant to be used in migrations, after adding an
+// index or changing the reference keys of an index, or after the objects were written
+// without maintaining the indexes, such as with the genesis import of the module
+// schema. All the indexes must implement ClearableIndex.
+// NOTE: as Map.Clear, this must be used with care with large indexes, as the
+// deletions are cached in memory until they are committed.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) RebuildIndexes(ctx context.Context) error {
+	for i, index := range m.computedIndexes {
+		clearable, ok := index.(ClearableIndex)
+		if !ok {
+			return fmt.Errorf("index %d of type %T does not implement ClearableIndex", i, index)
+		}
+		if err := clearable.Clear(ctx); err != nil {
+			return err
+		}
+	}
+
+	// the objects are referenced in batches, outside of the iteration of the map
+	var ranger Ranger[PrimaryKey]
+	for {
+		var kvs []KeyValue[PrimaryKey, Value]
+		err := m.m.Walk(ctx, ranger, func(key PrimaryKey, value Value) (bool, error) {
+			kvs = append(kvs, KeyValue[PrimaryKey, Value]{Key: key, Value: value})
+			return len(kvs) == rebuildIndexesBatchSize, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, kv := range kvs {
+			for _, index := range m.computedIndexes {
+				err := index.Reference(ctx, kv.Key, kv.Value, func() (Value, error) {
+					var zero Value
+					return zero, ErrNotFound
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(kvs) < rebuildIndexesBatchSize {
+			return nil
+		}
+		ranger = new(Range[PrimaryKey]).StartExclusive(kvs[len(kvs)-1].Key)
+	}
+}
+
 func (m *IndexedMap[PrimaryKey, Value, Idx]) ref(ctx context.Context, pk PrimaryKey, value Value) error {
 	for _, index := range m.computedIndexes {
 		err := index.Reference(ctx, pk, value, cachedGet[PrimaryKey, Value](ctx, m, pk))
@@ -131,3 +131,38 @@ func TestIndexedMapInfer(t *testing.T) {
 	_, err := collections.NewIndexedMapSafe(schema, collections.NewPrefix(0), "im", collections.StringKey, colltest.MockValueCodec[company](), newInferIndex(schema))
 	require.NoError(t, err)
 }
+
+func TestIndexedMapRebuildIndexes(t *testing.T) {
+	ctx := coretesting.Context()
+	sk := coretesting.KVStoreService(ctx, "test")
+
+	im := newTestIndexedMap(collections.NewSchemaBuilder(sk))
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Set(ctx, "2", company{City: "milan", Vat: 1}))
+
+	// the companies are written without maintaining the indexes, such as in a
+	// migration or a genesis import
+	companies := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(0), "companies", collections.StringKey, colltest.MockValueCodec[company]())
+	require.NoError(t, companies.Set(ctx, "2", company{City: "rome", Vat: 2}))
+	require.NoError(t, companies.Set(ctx, "3", company{City: "rome", Vat: 3}))
+
+	require.NoError(t, im.RebuildIndexes(ctx))
+
+	iter, err := im.Indexes.City.MatchExact(ctx, "milan")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, pks)
+
+	iter, err = im.Indexes.City.MatchExact(ctx, "rome")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3"}, pks)
+
+	_, err = im.Indexes.Vat.MatchExact(ctx, 1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	pk, err := im.Indexes.Vat.MatchExact(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "3", pk)
+}
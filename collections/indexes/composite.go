@@ -0,0 +1,52 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Composite is a Multi index whose reference key is a tuple of two keys derived
+// from the value, such as the time and the amount of an order. Longer tuples can
+// be indexed by using a collections.Pair as second key. Besides the full reference
+// keys, the objects can be matched by the first key of their reference key, and
+// ranged over by reference key or first key with RefRange.
+type Composite[K1, K2, PrimaryKey, Value any] struct {
+	*Multi[collections.Pair[K1, K2], PrimaryKey, Value]
+}
+
+// NewComposite instantiates a new Composite index given a schema, a Prefix, the
+// humanized name for the index, the key codecs of the two keys of the reference
+// key and the primary key key codec. The getRefKeyFunc is a function that given
+// the primary key and value returns the two keys of the reference key.
+func NewComposite[K1, K2, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	keyCodec1 codec.KeyCodec[K1],
+	keyCodec2 codec.KeyCodec[K2],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (K1, K2, error),
+	options ...func(*multiOptions),
+) *Composite[K1, K2, PrimaryKey, Value] {
+	return &Composite[K1, K2, PrimaryKey, Value]{
+		Multi: NewMulti(
+			schema, prefix, name, collections.PairKeyCodec(keyCodec1, keyCodec2), pkCodec,
+			func(pk PrimaryKey, value Value) (collections.Pair[K1, K2], error) {
+				k1, k2, err := getRefKeyFunc(pk, value)
+				if err != nil {
+					return collections.Pair[K1, K2]{}, err
+				}
+				return collections.Join(k1, k2), nil
+			},
+			options...,
+		),
+	}
+}
+
+// MatchPrefix returns a MultiIterator containing all the primary keys whose reference
+// key starts with the provided first key, in the order of the second key.
+func (c *Composite[K1, K2, PrimaryKey, Value]) MatchPrefix(ctx context.Context, k1 K1) (MultiIterator[collections.Pair[K1, K2], PrimaryKey], error) {
+	return c.Iterate(ctx, new(RefRange[collections.Pair[K1, K2], PrimaryKey]).Prefix(collections.PairPrefix[K1, K2](k1)))
+}
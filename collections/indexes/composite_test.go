@@ -0,0 +1,126 @@
+package indexes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type order struct {
+	Time   uint64
+	Amount uint64
+}
+
+type orderIndexes struct {
+	TimeAmount *Composite[uint64, uint64, string, order]
+}
+
+func (i orderIndexes) IndexesList() []collections.Index[string, order] {
+	return []collections.Index[string, order]{i.TimeAmount}
+}
+
+func newOrders(t *testing.T) (context.Context, *collections.IndexedMap[string, order, orderIndexes], *Composite[uint64, uint64, string, order]) {
+	t.Helper()
+
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	orders := collections.NewIndexedMap(sb, collections.NewPrefix(0), "orders", collections.StringKey, colltest.MockValueCodec[order](), orderIndexes{
+		TimeAmount: NewComposite(sb, collections.NewPrefix(1), "orders_by_time_amount", collections.Uint64Key, collections.Uint64Key, collections.StringKey,
+			func(_ string, value order) (uint64, uint64, error) {
+				return value.Time, value.Amount, nil
+			}),
+	})
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	for id, o := range map[string]order{
+		"a": {Time: 1, Amount: 30},
+		"b": {Time: 1, Amount: 10},
+		"c": {Time: 2, Amount: 20},
+		"d": {Time: 3, Amount: 5},
+		"e": {Time: 3, Amount: 50},
+	} {
+		require.NoError(t, orders.Set(ctx, id, o))
+	}
+	return ctx, orders, orders.Indexes.TimeAmount
+}
+
+func TestCompositeIndex(t *testing.T) {
+	ctx, _, idx := newOrders(t)
+
+	iter, err := idx.MatchExact(ctx, collections.Join[uint64, uint64](1, 30))
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, pks)
+
+	// the orders of a time are sorted by amount
+	iter, err = idx.MatchPrefix(ctx, 1)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "a"}, pks)
+
+	// ranges of first keys
+	iter, err = idx.Iterate(ctx, new(RefRange[collections.Pair[uint64, uint64], string]).
+		StartExclusive(collections.PairPrefix[uint64, uint64](1)).
+		EndInclusive(collections.PairPrefix[uint64, uint64](3)))
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d", "e"}, pks)
+
+	// ranges of full reference keys
+	iter, err = idx.Iterate(ctx, new(RefRange[collections.Pair[uint64, uint64], string]).
+		StartInclusive(collections.Join[uint64, uint64](1, 30)).
+		EndExclusive(collections.Join[uint64, uint64](3, 50)).
+		Descending())
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "c", "a"}, pks)
+}
+
+func TestPaginate(t *testing.T) {
+	ctx, orders, idx := newOrders(t)
+
+	paginate := func(ranger *RefRange[collections.Pair[uint64, uint64], string], limit int) (pages [][]string) {
+		var pageKey *collections.Pair[collections.Pair[uint64, uint64], string]
+		for {
+			kvs, next, err := Paginate(ctx, orders, idx.Multi, ranger, pageKey, limit)
+			require.NoError(t, err)
+			var page []string
+			for _, kv := range kvs {
+				page = append(page, kv.Key)
+			}
+			pages = append(pages, page)
+			if next == nil {
+				return pages
+			}
+			pageKey = next
+		}
+	}
+
+	require.Equal(t, [][]string{{"b", "a"}, {"c", "d"}, {"e"}}, paginate(nil, 2))
+	require.Equal(t, [][]string{{"b", "a", "c", "d", "e"}}, paginate(nil, 5))
+
+	timeRange := new(RefRange[collections.Pair[uint64, uint64], string]).
+		StartInclusive(collections.PairPrefix[uint64, uint64](2)).
+		EndInclusive(collections.PairPrefix[uint64, uint64](3))
+	require.Equal(t, [][]string{{"c", "d"}, {"e"}}, paginate(timeRange, 2))
+
+	timeRange.Descending()
+	require.Equal(t, [][]string{{"e", "d"}, {"c"}}, paginate(timeRange, 2))
+
+	kvs, next, err := Paginate(ctx, orders, idx.Multi, nil, nil, 1)
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[string, order]{{Key: "b", Value: order{Time: 1, Amount: 10}}}, kvs)
+	require.Equal(t, collections.Join(collections.Join[uint64, uint64](1, 30), "a"), *next)
+
+	_, _, err = Paginate(ctx, orders, idx.Multi, nil, nil, 0)
+	require.ErrorIs(t, err, errInvalidLimit)
+}
@@ -51,13 +51,13 @@ func NewMulti[ReferenceKey, PrimaryKey, Value any](
 	if o.uncheckedValue {
 		return &Multi[ReferenceKey, PrimaryKey, Value]{
 			getRefKey: getRefKeyFunc,
-			refKeys:   collections.NewKeySet(schema, prefix, name, collections.PairKeyCodec(refCodec, pkCodec), collections.WithKeySetUncheckedValue()),
+			refKeys:   collections.NewKeySet(schema, prefix, name, collections.PairKeyCodec(refCodec, pkCodec), collections.WithKeySetUncheckedValue(), collections.WithKeySetSecondaryIndex()),
 		}
 	}
 
 	return &Multi[ReferenceKey, PrimaryKey, Value]{
 		getRefKey: getRefKeyFunc,
-		refKeys:   collections.NewKeySet(schema, prefix, name, collections.PairKeyCodec(refCodec, pkCodec)),
+		refKeys:   collections.NewKeySet(schema, prefix, name, collections.PairKeyCodec(refCodec, pkCodec), collections.WithKeySetSecondaryIndex()),
 	}
 }
 
@@ -101,6 +101,11 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context
 	return m.refKeys.Remove(ctx, collections.Join(refKey, pk))
 }
 
+// Clear removes all the references of the index, see collections.IndexedMap.RebuildIndexes.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) Clear(ctx context.Context) error {
+	return m.refKeys.Clear(ctx, nil)
+}
+
 func (m *Multi[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
 	iter, err := m.refKeys.Iterate(ctx, ranger)
 	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
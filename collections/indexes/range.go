@@ -0,0 +1,119 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+)
+
+// RefRange is a Ranger over the keys of a Multi or Composite index, bounded by
+// reference keys. When the reference key is a collections.Pair, such as the
+// reference key of a Composite index, the bounds can also be the prefixes of the
+// reference keys built with collections.PairPrefix, to range over the first key.
+type RefRange[ReferenceKey, PrimaryKey any] struct {
+	start *collections.RangeKey[collections.Pair[ReferenceKey, PrimaryKey]]
+	end   *collections.RangeKey[collections.Pair[ReferenceKey, PrimaryKey]]
+	order collections.Order
+}
+
+// Prefix makes the range contain only the keys with the provided reference key.
+func (r *RefRange[ReferenceKey, PrimaryKey]) Prefix(ref ReferenceKey) *RefRange[ReferenceKey, PrimaryKey] {
+	return r.StartInclusive(ref).EndInclusive(ref)
+}
+
+// StartInclusive makes the range contain only the keys whose reference key is
+// bigger or equal to the provided one.
+func (r *RefRange[ReferenceKey, PrimaryKey]) StartInclusive(ref ReferenceKey) *RefRange[ReferenceKey, PrimaryKey] {
+	r.start = collections.RangeKeyExact(collections.PairPrefix[ReferenceKey, PrimaryKey](ref))
+	return r
+}
+
+// StartExclusive makes the range contain only the keys whose reference key is
+// bigger than the provided one.
+func (r *RefRange[ReferenceKey, PrimaryKey]) StartExclusive(ref ReferenceKey) *RefRange[ReferenceKey, PrimaryKey] {
+	r.start = collections.RangeKeyPrefixEnd(collections.PairPrefix[ReferenceKey, PrimaryKey](ref))
+	return r
+}
+
+// EndInclusive makes the range contain only the keys whose reference key is
+// smaller or equal to the provided one.
+func (r *RefRange[ReferenceKey, PrimaryKey]) EndInclusive(ref ReferenceKey) *RefRange[ReferenceKey, PrimaryKey] {
+	r.end = collections.RangeKeyPrefixEnd(collections.PairPrefix[ReferenceKey, PrimaryKey](ref))
+	return r
+}
+
+// EndExclusive makes the range contain only the keys whose reference key is
+// smaller than the provided one.
+func (r *RefRange[ReferenceKey, PrimaryKey]) EndExclusive(ref ReferenceKey) *RefRange[ReferenceKey, PrimaryKey] {
+	r.end = collections.RangeKeyExact(collections.PairPrefix[ReferenceKey, PrimaryKey](ref))
+	return r
+}
+
+// Descending makes the range yield the keys from the biggest to the smallest.
+func (r *RefRange[ReferenceKey, PrimaryKey]) Descending() *RefRange[ReferenceKey, PrimaryKey] {
+	r.order = collections.OrderDescending
+	return r
+}
+
+// RangeValues implements collections.Ranger.
+func (r *RefRange[ReferenceKey, PrimaryKey]) RangeValues() (start, end *collections.RangeKey[collections.Pair[ReferenceKey, PrimaryKey]], order collections.Order, err error) {
+	return r.start, r.end, r.order, nil
+}
+
+// errInvalidLimit is returned by Paginate when the limit isn't positive.
+var errInvalidLimit = errors.New("page limit must be positive")
+
+// Paginate returns up to limit objects of the indexed map referenced by the keys of
+// the Multi or Composite index in the range, in the order of the range, a nil range
+// meaning all the keys of the index. The page starts at the provided page key, the
+// first key of the range if nil, and Paginate also returns the page key of the next
+// page, nil if there are no more objects.
+func Paginate[ReferenceKey, PrimaryKey, Value, Idx any](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PrimaryKey, Value, Idx],
+	index *Multi[ReferenceKey, PrimaryKey, Value],
+	ranger *RefRange[ReferenceKey, PrimaryKey],
+	pageKey *collections.Pair[ReferenceKey, PrimaryKey],
+	limit int,
+) (kvs []collections.KeyValue[PrimaryKey, Value], nextPageKey *collections.Pair[ReferenceKey, PrimaryKey], err error) {
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("%w: %d", errInvalidLimit, limit)
+	}
+
+	r := new(RefRange[ReferenceKey, PrimaryKey])
+	if ranger != nil {
+		*r = *ranger
+	}
+	if pageKey != nil {
+		if r.order == collections.OrderDescending {
+			r.end = collections.RangeKeyNext(*pageKey)
+		} else {
+			r.start = collections.RangeKeyExact(*pageKey)
+		}
+	}
+
+	iter, err := index.Iterate(ctx, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		fullKey, err := iter.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(kvs) == limit {
+			return kvs, &fullKey, nil
+		}
+
+		value, err := indexedMap.Get(ctx, fullKey.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		kvs = append(kvs, collections.KeyValue[PrimaryKey, Value]{Key: fullKey.K2(), Value: value})
+	}
+	return kvs, nil, nil
+}
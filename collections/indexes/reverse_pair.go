@@ -55,12 +55,12 @@ func NewReversePair[Value, K1, K2 any](
 	}
 	if o.uncheckedValue {
 		return &ReversePair[K1, K2, Value]{
-			refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1()), collections.WithKeySetUncheckedValue()),
+			refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1()), collections.WithKeySetUncheckedValue(), collections.WithKeySetSecondaryIndex()),
 		}
 	}
 
 	mi := &ReversePair[K1, K2, Value]{
-		refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1())),
+		refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1()), collections.WithKeySetSecondaryIndex()),
 	}
 
 	return mi
@@ -90,6 +90,11 @@ func (i *ReversePair[K1, K2, Value]) Unreference(ctx context.Context, pk collect
 	return i.refKeys.Remove(ctx, collections.Join(pk.K2(), pk.K1()))
 }
 
+// Clear removes all the references of the index, see collections.IndexedMap.RebuildIndexes.
+func (i *ReversePair[K1, K2, Value]) Clear(ctx context.Context) error {
+	return i.refKeys.Clear(ctx, nil)
+}
+
 func (i *ReversePair[K1, K2, Value]) Walk(
 	ctx context.Context,
 	ranger collections.Ranger[collections.Pair[K2, K1]],
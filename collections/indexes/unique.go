@@ -27,7 +27,7 @@ func NewUnique[ReferenceKey, PrimaryKey, Value any](
 ) *Unique[ReferenceKey, PrimaryKey, Value] {
 	return &Unique[ReferenceKey, PrimaryKey, Value]{
 		getRefKey: getRefKeyFunc,
-		refKeys:   collections.NewMap(schema, prefix, name, refCodec, codec.KeyToValueCodec(pkCodec)),
+		refKeys:   collections.NewMap(schema, prefix, name, refCodec, codec.KeyToValueCodec(pkCodec), collections.WithMapSecondaryIndex()),
 	}
 }
 
@@ -78,6 +78,11 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Contex
 	return i.refKeys.Remove(ctx, refKey)
 }
 
+// Clear removes all the references of the index, see collections.IndexedMap.RebuildIndexes.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) Clear(ctx context.Context) error {
+	return i.refKeys.Clear(ctx, nil)
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return i.refKeys.Get(ctx, ref)
 }
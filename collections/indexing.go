@@ -0,0 +1,374 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/genesis"
+)
+
+var _ schema.HasModuleCodec = Schema{}
+
+// ModuleCodec implements the schema.HasModuleCodec interface. The module schema
+// has an object type per collection, named after the collection, whose key and
+// value fields are described by the schema codecs of the key and value codecs of
+// the collection, see codec.HasSchemaCodec. The secondary indexes of the indexed
+// maps are left out as they are derived from the indexed objects.
+// The KVDecoder decodes the writes to the collections into object updates, and the
+// KVEncoder encodes the object updates back into the writes to the collections.
+func (s Schema) ModuleCodec() (schema.ModuleCodec, error) {
+	cdc := moduleKVCodec{byName: map[string]collectionSchemaCodec{}}
+	objectTypes := make([]schema.ObjectType, 0, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		coll := s.collectionsByName[name]
+		if coll.isSecondaryIndex() {
+			continue
+		}
+
+		collCdc, err := coll.schemaCodec()
+		if err != nil {
+			return schema.ModuleCodec{}, fmt.Errorf("failed to get the schema codec of collection %s: %w", name, err)
+		}
+		objectTypes = append(objectTypes, collCdc.objectType)
+		cdc.collections = append(cdc.collections, collCdc)
+		cdc.byName[name] = collCdc
+	}
+
+	moduleSchema := schema.ModuleSchema{ObjectTypes: objectTypes}
+	if err := moduleSchema.Validate(); err != nil {
+		return schema.ModuleCodec{}, err
+	}
+
+	sort.Slice(cdc.collections, func(i, j int) bool {
+		return bytes.Compare(cdc.collections[i].prefix, cdc.collections[j].prefix) < 0
+	})
+
+	return schema.ModuleCodec{
+		Schema:    moduleSchema,
+		KVDecoder: cdc.decodeKV,
+		KVEncoder: cdc.encodeKV,
+	}, nil
+}
+
+// moduleKVCodec decodes and encodes the KV pairs of the collections of a schema.
+type moduleKVCodec struct {
+	// collections are sorted by prefix.
+	collections []collectionSchemaCodec
+	byName      map[string]collectionSchemaCodec
+}
+
+func (d moduleKVCodec) encodeKV(update schema.ObjectUpdate) ([]schema.KVPairUpdate, error) {
+	coll, ok := d.byName[update.TypeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown collection %s", update.TypeName)
+	}
+
+	return coll.encodeKV(update)
+}
+
+func (d moduleKVCodec) decodeKV(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+	// as the prefixes of a schema don't overlap, the collection of the key is the
+	// one with the greatest prefix lower than or equal to the key
+	i := sort.Search(len(d.collections), func(i int) bool {
+		return bytes.Compare(d.collections[i].prefix, update.Key) > 0
+	}) - 1
+	if i < 0 || !bytes.HasPrefix(update.Key, d.collections[i].prefix) {
+		return nil, nil
+	}
+
+	return d.collections[i].decodeKV(update)
+}
+
+// collectionSchemaCodec decodes the KV pairs of a collection into object updates.
+type collectionSchemaCodec struct {
+	prefix      []byte
+	objectType  schema.ObjectType
+	decodeKey   func([]byte) (any, error)
+	decodeValue func([]byte) (any, error)
+	// encodeKey encodes the key with the prefix of the collection.
+	encodeKey   func(any) ([]byte, error)
+	encodeValue func(any) ([]byte, error)
+}
+
+func (c collectionSchemaCodec) decodeKV(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+	name := c.objectType.Name
+	key, err := c.decodeKey(update.Key[len(c.prefix):])
+	if err != nil {
+		return []schema.ObjectUpdate{{TypeName: name}}, fmt.Errorf("failed to decode the key of collection %s: %w", name, err)
+	}
+
+	if update.Delete {
+		return []schema.ObjectUpdate{{TypeName: name, Key: key, Delete: true}}, nil
+	}
+
+	value, err := c.decodeValue(update.Value)
+	if err != nil {
+		return []schema.ObjectUpdate{{TypeName: name, Key: key}}, fmt.Errorf("failed to decode the value of collection %s: %w", name, err)
+	}
+
+	return []schema.ObjectUpdate{{TypeName: name, Key: key, Value: value}}, nil
+}
+
+func (c collectionSchemaCodec) encodeKV(update schema.ObjectUpdate) ([]schema.KVPairUpdate, error) {
+	name := c.objectType.Name
+	if update.Delete {
+		return nil, fmt.Errorf("%w: cannot encode the deletion of an object of collection %s", ErrEncoding, name)
+	}
+	if _, ok := update.Value.(schema.ValueUpdates); ok {
+		return nil, fmt.Errorf("%w: cannot encode the partial value of an object of collection %s", ErrEncoding, name)
+	}
+
+	key, err := c.encodeKey(update.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the key of collection %s: %w", name, err)
+	}
+	value, err := c.encodeValue(update.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the value of collection %s: %w", name, err)
+	}
+
+	return []schema.KVPairUpdate{{Key: key, Value: value}}, nil
+}
+
+func (c collectionImpl[K, V]) schemaCodec() (collectionSchemaCodec, error) {
+	keyCodec, err := codec.KeySchemaCodec(c.m.kc)
+	if err != nil {
+		return collectionSchemaCodec{}, fmt.Errorf("key codec: %w", err)
+	}
+	valueCodec, err := codec.ValueSchemaCodec(c.m.vc)
+	if err != nil {
+		return collectionSchemaCodec{}, fmt.Errorf("value codec: %w", err)
+	}
+
+	return collectionSchemaCodec{
+		prefix: c.GetPrefix(),
+		objectType: schema.ObjectType{
+			Name:        c.GetName(),
+			KeyFields:   namedFields(keyCodec.Fields, "key"),
+			ValueFields: namedFields(valueCodec.Fields, "value"),
+		},
+		decodeKey: func(bz []byte) (any, error) {
+			read, key, err := c.m.kc.Decode(bz)
+			if err != nil {
+				return nil, err
+			}
+			if read != len(bz) {
+				return nil, fmt.Errorf("%w: was supposed to fully consume the key '%x', consumed %d out of %d", ErrEncoding, bz, read, len(bz))
+			}
+			return toSchemaType(keyCodec, key)
+		},
+		decodeValue: func(bz []byte) (any, error) {
+			value, err := c.m.vc.Decode(bz)
+			if err != nil {
+				return nil, err
+			}
+			return toSchemaType(valueCodec, value)
+		},
+		encodeKey: func(value any) ([]byte, error) {
+			key, err := fromSchemaType(keyCodec, value)
+			if err != nil {
+				return nil, err
+			}
+			return EncodeKeyWithPrefix(c.GetPrefix(), c.m.kc, key)
+		},
+		encodeValue: func(value any) ([]byte, error) {
+			v, err := fromSchemaType(valueCodec, value)
+			if err != nil {
+				return nil, err
+			}
+			return c.m.vc.Encode(v)
+		},
+	}, nil
+}
+
+func (c collectionImpl[K, V]) isSecondaryIndex() bool { return c.m.isSecondaryIndex }
+
+// toSchemaType converts the value with the schema codec, nil if the codec has no field.
+func toSchemaType[T any](cdc codec.SchemaCodec[T], value T) (any, error) {
+	switch {
+	case len(cdc.Fields) == 0:
+		return nil, nil
+	case cdc.ToSchemaType == nil:
+		return value, nil
+	default:
+		return cdc.ToSchemaType(value)
+	}
+}
+
+// fromSchemaType converts the value of the fields with the schema codec, the zero
+// value if the codec has no field.
+func fromSchemaType[T any](cdc codec.SchemaCodec[T], value any) (T, error) {
+	var zero T
+	switch {
+	case len(cdc.Fields) == 0:
+		return zero, nil
+	case cdc.FromSchemaType == nil:
+		v, ok := value.(T)
+		if !ok {
+			return zero, fmt.Errorf("%w: expected %T, got %T", ErrEncoding, zero, value)
+		}
+		return v, nil
+	default:
+		return cdc.FromSchemaType(value)
+	}
+}
+
+// namedFields returns a copy of the fields where the fields without a name are
+// named after the given name, suffixed by their position if there are several.
+func namedFields(fields []schema.Field, name string) []schema.Field {
+	named := make([]schema.Field, len(fields))
+	for i, field := range fields {
+		if field.Name == "" {
+			field.Name = name
+			if len(fields) > 1 {
+				field.Name = fmt.Sprintf("%s%d", name, i+1)
+			}
+		}
+		named[i] = field
+	}
+	return named
+}
+
+// keyPartSchemaCodec is the untyped schema codec of a part of a multipart key.
+type keyPartSchemaCodec struct {
+	fields         []schema.Field
+	toSchemaType   func(any) (any, error)
+	fromSchemaType func(any) (any, error)
+}
+
+func newKeyPartSchemaCodec[K any](keyCodec codec.KeyCodec[K]) (keyPartSchemaCodec, error) {
+	cdc, err := codec.KeySchemaCodec(keyCodec)
+	if err != nil {
+		return keyPartSchemaCodec{}, err
+	}
+
+	return keyPartSchemaCodec{
+		fields: cdc.Fields,
+		toSchemaType: func(value any) (any, error) {
+			return toSchemaType(cdc, keyPart[K](value))
+		},
+		fromSchemaType: func(value any) (any, error) {
+			if cdc.FromSchemaType != nil {
+				return cdc.FromSchemaType(value)
+			}
+			key, ok := value.(K)
+			if !ok {
+				return nil, fmt.Errorf("%w: expected %T, got %T", ErrEncoding, *new(K), value)
+			}
+			return key, nil
+		},
+	}, nil
+}
+
+// multipartSchemaCodec returns the schema codec of a multipart key, whose fields
+// are the fields of its parts, in order. split and join convert the key to and
+// from its parts.
+func multipartSchemaCodec[T any](parts []keyPartSchemaCodec, split func(T) []any, join func([]any) T) codec.SchemaCodec[T] {
+	var fields []schema.Field
+	for _, part := range parts {
+		fields = append(fields, part.fields...)
+	}
+
+	return codec.SchemaCodec[T]{
+		Fields: fields,
+		ToSchemaType: func(key T) (any, error) {
+			values := make([]any, 0, len(fields))
+			for i, k := range split(key) {
+				part := parts[i]
+				value, err := part.toSchemaType(k)
+				if err != nil {
+					return nil, err
+				}
+				switch len(part.fields) {
+				case 0:
+				case 1:
+					values = append(values, value)
+				default:
+					partValues, ok := value.([]any)
+					if !ok {
+						return nil, fmt.Errorf("%w: expected a slice of values for key part %d, got %T", ErrEncoding, i, value)
+					}
+					values = append(values, partValues...)
+				}
+			}
+			if len(values) == 1 {
+				return values[0], nil
+			}
+			return values, nil
+		},
+		FromSchemaType: func(value any) (T, error) {
+			var key T
+			values, ok := value.([]any)
+			if len(fields) == 1 {
+				values, ok = []any{value}, true
+			}
+			if !ok || len(values) != len(fields) {
+				return key, fmt.Errorf("%w: expected a slice of %d values, got %T", ErrEncoding, len(fields), value)
+			}
+
+			keyParts := make([]any, len(parts))
+			for i, part := range parts {
+				var partValue any
+				switch n := len(part.fields); n {
+				case 0:
+				case 1:
+					partValue = values[0]
+				default:
+					partValue = values[:n]
+				}
+				values = values[len(part.fields):]
+
+				keyPart, err := part.fromSchemaType(partValue)
+				if err != nil {
+					return key, err
+				}
+				keyParts[i] = keyPart
+			}
+			return join(keyParts), nil
+		},
+	}
+}
+
+// keyPart returns the key part as K, the zero value if nil.
+func keyPart[K any](value any) K {
+	key, _ := value.(K)
+	return key
+}
+
+// GenesisHandler returns the genesis handler importing and exporting the collections
+// of the schema as the rows of their object types, see cosmossdk.io/schema/genesis.
+// As they are left out of the module schema, the secondary indexes of the indexed
+// maps aren't imported and must be rebuilt after InitGenesis, with
+// IndexedMap.RebuildIndexes.
+func (s Schema) GenesisHandler() (genesis.Handler, error) {
+	return genesis.NewHandler(s, genesisStore{storeAccessor: s.storeAccessor})
+}
+
+// genesisStore implements genesis.Store with the store of the schema.
+type genesisStore struct {
+	storeAccessor func(context.Context) store.KVStore
+}
+
+func (s genesisStore) IterateAllKVPairs(ctx context.Context, fn func(key, value []byte) error) error {
+	it, err := s.storeAccessor(ctx).Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s genesisStore) Set(ctx context.Context, key, value []byte) error {
+	return s.storeAccessor(ctx).Set(key, value)
+}
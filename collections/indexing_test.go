@@ -0,0 +1,185 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/testing"
+	"cosmossdk.io/schema"
+)
+
+func TestModuleCodec(t *testing.T) {
+	ctx := coretesting.Context()
+	sk := coretesting.KVStoreService(ctx, "test")
+
+	sb := collections.NewSchemaBuilder(sk)
+	companies := newTestIndexedMap(sb)
+	balances := collections.NewMap(sb, collections.NewPrefix(3), "balances", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Int64Value)
+	params := collections.NewItem(sb, collections.NewPrefix(4), "params", collections.BoolValue)
+	allowed := collections.NewKeySet(sb, collections.NewPrefix(5), "allowed", collections.BytesKey)
+	sequence := collections.NewSequence(sb, collections.NewPrefix(6), "sequence")
+	sch, err := sb.Build()
+	require.NoError(t, err)
+
+	cdc, err := sch.ModuleCodec()
+	require.NoError(t, err)
+	require.NoError(t, cdc.Schema.Validate())
+
+	// the secondary indexes of the companies are left out
+	require.Equal(t, []schema.ObjectType{
+		{
+			Name:        "allowed",
+			KeyFields:   []schema.Field{{Name: "key", Kind: schema.BytesKind}},
+			ValueFields: []schema.Field{},
+		},
+		{
+			Name:        "balances",
+			KeyFields:   []schema.Field{{Name: "key1", Kind: schema.StringKind}, {Name: "key2", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.Int64Kind}},
+		},
+		{
+			Name:        "companies",
+			KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.JSONKind}},
+		},
+		{
+			Name:        "params",
+			KeyFields:   []schema.Field{},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.BoolKind}},
+		},
+		{
+			Name:        "sequence",
+			KeyFields:   []schema.Field{},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.Uint64Kind}},
+		},
+	}, cdc.Schema.ObjectTypes)
+
+	require.NoError(t, companies.Set(ctx, "acme", company{City: "milan", Vat: 1}))
+	require.NoError(t, balances.Set(ctx, collections.Join("alice", "atom"), 10))
+	require.NoError(t, params.Set(ctx, true))
+	require.NoError(t, allowed.Set(ctx, []byte{0x1}))
+	_, err = sequence.Next(ctx)
+	require.NoError(t, err)
+
+	companyJSON, err := colltest.MockValueCodec[company]().EncodeJSON(company{City: "milan", Vat: 1})
+	require.NoError(t, err)
+
+	// the writes to the store are decoded into object updates
+	var updates []schema.ObjectUpdate
+	it, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		pair := schema.KVPairUpdate{Key: it.Key(), Value: it.Value()}
+		decoded, err := cdc.KVDecoder(pair)
+		require.NoError(t, err)
+		for _, update := range decoded {
+			require.NoError(t, cdc.Schema.ValidateObjectUpdate(update))
+
+			// the object updates are encoded back into the writes to the store
+			encoded, err := cdc.KVEncoder(update)
+			require.NoError(t, err)
+			require.Equal(t, []schema.KVPairUpdate{pair}, encoded)
+		}
+		updates = append(updates, decoded...)
+	}
+	require.Equal(t, []schema.ObjectUpdate{
+		{TypeName: "companies", Key: "acme", Value: json.RawMessage(companyJSON)},
+		{TypeName: "balances", Key: []any{"alice", "atom"}, Value: int64(10)},
+		{TypeName: "params", Value: true},
+		{TypeName: "allowed", Key: []byte{0x1}},
+		{TypeName: "sequence", Value: uint64(1)},
+	}, updates)
+
+	// deletions only decode the key
+	decoded, err := cdc.KVDecoder(schema.KVPairUpdate{Key: []byte{0x5, 0x2}, Delete: true})
+	require.NoError(t, err)
+	require.Equal(t, []schema.ObjectUpdate{{TypeName: "allowed", Key: []byte{0x2}, Delete: true}}, decoded)
+
+	// the keys outside the collections are ignored
+	decoded, err = cdc.KVDecoder(schema.KVPairUpdate{Key: []byte{0x7}, Value: []byte{0x1}})
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	// invalid values are reported
+	_, err = cdc.KVDecoder(schema.KVPairUpdate{Key: []byte{0x4}, Value: []byte{0x2}})
+	require.ErrorContains(t, err, "failed to decode the value of collection params")
+
+	// as are invalid objects
+	_, err = cdc.KVEncoder(schema.ObjectUpdate{TypeName: "params", Value: "true"})
+	require.ErrorContains(t, err, "failed to encode the value of collection params")
+	_, err = cdc.KVEncoder(schema.ObjectUpdate{TypeName: "unknown"})
+	require.ErrorContains(t, err, "unknown collection unknown")
+}
+
+func TestGenesisHandler(t *testing.T) {
+	ctx := coretesting.Context()
+	sk := coretesting.KVStoreService(ctx, "test")
+
+	sb := collections.NewSchemaBuilder(sk)
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Int64Value)
+	params := collections.NewItem(sb, collections.NewPrefix(2), "params", collections.BoolValue)
+	sch, err := sb.Build()
+	require.NoError(t, err)
+	h, err := sch.GenesisHandler()
+	require.NoError(t, err)
+
+	rows := map[string]string{
+		"balances": `{"key1":"alice","key2":"atom","value":"10"}
+{"key1":"bob","key2":"atom","value":"-3"}
+`,
+		"params": `{"value":true}
+`,
+	}
+	source := func(field string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(rows[field])), nil
+	}
+	require.NoError(t, h.ValidateGenesis(source))
+	require.NoError(t, h.InitGenesis(ctx, source))
+
+	amount, err := balances.Get(ctx, collections.Join("bob", "atom"))
+	require.NoError(t, err)
+	require.Equal(t, int64(-3), amount)
+	enabled, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	exported := map[string]*strings.Builder{}
+	require.NoError(t, h.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		exported[field] = &strings.Builder{}
+		return nopWriteCloser{exported[field]}, nil
+	}))
+	require.Len(t, exported, 2)
+	for field, data := range rows {
+		require.Equal(t, data, exported[field].String())
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestMultipartSchemaCodec(t *testing.T) {
+	cdc, err := codec.KeySchemaCodec(collections.TripleKeyCodec(collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, collections.BoolKey), collections.BytesKey))
+	require.NoError(t, err)
+	require.Len(t, cdc.Fields, 4)
+
+	key := collections.Join3("alice", collections.Join(uint64(1), true), []byte{0x1})
+	value, err := cdc.ToSchemaType(key)
+	require.NoError(t, err)
+	require.Equal(t, []any{"alice", uint64(1), true, []byte{0x1}}, value)
+
+	decoded, err := cdc.FromSchemaType(value)
+	require.NoError(t, err)
+	require.Equal(t, key, decoded)
+
+	_, err = cdc.FromSchemaType([]any{"alice", uint64(1)})
+	require.ErrorIs(t, err, collections.ErrEncoding)
+}
@@ -62,6 +62,10 @@ func (noKey) DecodeJSON(b []byte) (noKey, error) {
 	}
 	return noKey{}, nil
 }
+
+// SchemaCodec returns a schema codec without fields as the item has a single object.
+func (noKey) SchemaCodec() (codec.SchemaCodec[noKey], error) { return codec.SchemaCodec[noKey]{}, nil }
+
 func (k noKey) EncodeNonTerminal(_ []byte, _ noKey) (int, error) { panic("must not be called") }
 func (k noKey) DecodeNonTerminal(_ []byte) (int, noKey, error)   { panic("must not be called") }
 func (k noKey) SizeNonTerminal(_ noKey) int                      { panic("must not be called") }
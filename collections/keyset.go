@@ -21,7 +21,18 @@ func WithKeySetUncheckedValue() func(opt *keySetOptions) {
 	}
 }
 
-type keySetOptions struct{ uncheckedValue bool }
+// WithKeySetSecondaryIndex marks the KeySet as the secondary index of an
+// IndexedMap, which leaves it out of the module schema, see Schema.ModuleCodec.
+func WithKeySetSecondaryIndex() func(opt *keySetOptions) {
+	return func(opt *keySetOptions) {
+		opt.isSecondaryIndex = true
+	}
+}
+
+type keySetOptions struct {
+	uncheckedValue   bool
+	isSecondaryIndex bool
+}
 
 // KeySet builds on top of a Map and represents a collection retaining only a set
 // of keys and no value. It can be used, for example, in an allow list.
@@ -44,7 +55,11 @@ func NewKeySet[K any](
 	if o.uncheckedValue {
 		vc = codec.NewAltValueCodec(vc, func(_ []byte) (NoValue, error) { return NoValue{}, nil })
 	}
-	return (KeySet[K])(NewMap(schema, prefix, name, keyCodec, vc))
+	var mapOptions []func(opt *mapOptions)
+	if o.isSecondaryIndex {
+		mapOptions = append(mapOptions, WithMapSecondaryIndex())
+	}
+	return (KeySet[K])(NewMap(schema, prefix, name, keyCodec, vc, mapOptions...))
 }
 
 // Set adds the key to the KeySet. Errors on encoding problems.
@@ -137,6 +152,12 @@ func (NoValue) Stringify(_ NoValue) string {
 	return noValueValueType
 }
 
+// SchemaCodec returns a schema codec without fields as the objects of a KeySet
+// only have keys.
+func (NoValue) SchemaCodec() (codec.SchemaCodec[NoValue], error) {
+	return codec.SchemaCodec[NoValue]{}, nil
+}
+
 func (n NoValue) ValueType() string {
 	return noValueValueType
 }
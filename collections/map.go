@@ -20,8 +20,21 @@ type Map[K, V any] struct {
 	sa     func(context.Context) store.KVStore
 	prefix []byte
 	name   string
+
+	// isSecondaryIndex is true if the map is the secondary index of an IndexedMap.
+	isSecondaryIndex bool
+}
+
+// WithMapSecondaryIndex marks the Map as the secondary index of an IndexedMap,
+// which leaves it out of the module schema, see Schema.ModuleCodec.
+func WithMapSecondaryIndex() func(opt *mapOptions) {
+	return func(opt *mapOptions) {
+		opt.isSecondaryIndex = true
+	}
 }
 
+type mapOptions struct{ isSecondaryIndex bool }
+
 // NewMap returns a Map given a StoreKey, a Prefix, human-readable name and the relative value and key encoders.
 // Name and prefix must be unique within the schema and name must match the format specified by NameRegex, or
 // else this method will panic.
@@ -31,13 +44,19 @@ func NewMap[K, V any](
 	name string,
 	keyCodec codec.KeyCodec[K],
 	valueCodec codec.ValueCodec[V],
+	options ...func(opt *mapOptions),
 ) Map[K, V] {
+	o := new(mapOptions)
+	for _, opt := range options {
+		opt(o)
+	}
 	m := Map[K, V]{
-		kc:     keyCodec,
-		vc:     valueCodec,
-		sa:     schemaBuilder.schema.storeAccessor,
-		prefix: prefix.Bytes(),
-		name:   name,
+		kc:               keyCodec,
+		vc:               valueCodec,
+		sa:               schemaBuilder.schema.storeAccessor,
+		prefix:           prefix.Bytes(),
+		name:             name,
+		isSecondaryIndex: o.isSecondaryIndex,
 	}
 	schemaBuilder.addCollection(collectionImpl[K, V]{m})
 	return m
@@ -151,9 +170,11 @@ func (m Map[K, V]) Walk(ctx context.Context, ranger Ranger[K], walkFunc func(key
 
 // Clear clears the collection contained within the provided key range.
 // A nil ranger equals to clearing the whole collection.
-// NOTE: this API needs to be used with care, considering that as of today
-// cosmos-sdk stores the deletion records to be committed in a memory cache,
-// clearing a lot of data might make the node go OOM.
+// If the store implements store.RangeDeleter, the range is removed at once and
+// recorded as a single range removal. Otherwise the keys are deleted one by one.
+// NOTE: in the latter case this API needs to be used with care, considering that
+// as of today cosmos-sdk stores the deletion records to be committed in a memory
+// cache, clearing a lot of data might make the node go OOM.
 func (m Map[K, V]) Clear(ctx context.Context, ranger Ranger[K]) error {
 	startBytes, endBytes, _, err := parseRangeInstruction(m.prefix, m.kc, ranger)
 	if err != nil {
@@ -164,10 +185,15 @@ func (m Map[K, V]) Clear(ctx context.Context, ranger Ranger[K]) error {
 
 const clearBatchSize = 10000
 
-// deleteDomain deletes the domain of an iterator, the key difference
-// is that it uses batches to clear the store meaning that it will read
-// the keys within the domain close the iterator and then delete them.
+// deleteDomain deletes the domain of an iterator. It uses a single range
+// removal if the store supports it, otherwise it uses batches to clear the store
+// meaning that it will read the keys within the domain close the iterator and
+// then delete them.
 func deleteDomain(s store.KVStore, start, end []byte) error {
+	if rd, ok := s.(store.RangeDeleter); ok {
+		return rd.DeleteRange(start, end)
+	}
+
 	for {
 		iter, err := s.Iterator(start, end)
 		if err != nil {
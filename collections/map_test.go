@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
 )
 
 func TestMap(t *testing.T) {
@@ -74,6 +76,46 @@ func TestMap_Clear(t *testing.T) {
 	})
 }
 
+type rangeDeleterStore struct {
+	store.KVStore
+	ranges [][2][]byte
+}
+
+func (s *rangeDeleterStore) DeleteRange(start, end []byte) error {
+	s.ranges = append(s.ranges, [2][]byte{start, end})
+	return deleteDomain(s.KVStore, start, end)
+}
+
+type kvStoreServiceFunc func(ctx context.Context) store.KVStore
+
+func (f kvStoreServiceFunc) OpenKVStore(ctx context.Context) store.KVStore { return f(ctx) }
+
+func TestMap_ClearRangeDeleter(t *testing.T) {
+	sk, ctx := deps()
+	rd := &rangeDeleterStore{KVStore: sk.OpenKVStore(ctx)}
+	m := NewMap(NewSchemaBuilder(kvStoreServiceFunc(func(context.Context) store.KVStore { return rd })), NewPrefix(1), "test", Uint64Key, Uint64Value)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, m.Set(ctx, i, i))
+	}
+
+	// the keys of the range are removed at once
+	require.NoError(t, m.Clear(ctx, new(Range[uint64]).StartInclusive(2).EndExclusive(5)))
+	start, err := EncodeKeyWithPrefix(NewPrefix(1).Bytes(), Uint64Key, 2)
+	require.NoError(t, err)
+	end, err := EncodeKeyWithPrefix(NewPrefix(1).Bytes(), Uint64Key, 5)
+	require.NoError(t, err)
+	require.Equal(t, [][2][]byte{{start, end}}, rd.ranges)
+
+	iter, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 5, 6, 7, 8, 9}, keys)
+
+	require.NoError(t, m.Clear(ctx, nil))
+	require.Equal(t, [2][]byte{{1}, {2}}, rd.ranges[1])
+}
+
 func TestMap_IterateRaw(t *testing.T) {
 	sk, ctx := deps()
 	// safety check to ensure prefix boundaries are not crossed
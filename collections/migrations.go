@@ -0,0 +1,336 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/gas"
+)
+
+// ErrMigrationStarted is returned when starting a ValueMigration which was already started.
+var ErrMigrationStarted = errors.New("collections: migration already started")
+
+// The progress of a ValueMigration is stored in a Map[[]byte, []byte], with:
+//   - the status of the migration under the status key, which is either
+//     migrationInProgress followed by the cursor, or migrationDone,
+//   - the keys of the map written with the new value codec ahead of the cursor,
+//     prefixed with migrationAheadPrefix.
+//
+// The cursor is a byte telling if there is one, followed by the raw key of the last
+// migrated entry: the entries up to the cursor are encoded with the new value codec.
+var (
+	migrationStatusKey   = []byte{0}
+	migrationAheadPrefix = []byte{1}
+)
+
+const (
+	migrationInProgress byte = 1
+	migrationDone       byte = 2
+)
+
+// ValueMigration migrates in place the values of a Map encoded with an old value
+// codec to the value codec of the Map, with a migration function converting the
+// old values to the new values.
+//
+// Once started, typically by the in-place store migration of the module, see
+// MigrationHandler, the entries are migrated eagerly in key order by Step and
+// StepWithGas, which can be called across blocks, the progress of the migration
+// being stored. Until the migration is done, the values must be accessed through
+// the Get, Set and Remove methods of the migration, which lazily migrate the values
+// which are read and mark the values written ahead of the migration as migrated.
+type ValueMigration[K, OldValue, Value any] struct {
+	m             Map[K, Value]
+	oldValueCodec codec.ValueCodec[OldValue]
+	migrate       func(ctx context.Context, key K, oldValue OldValue) (Value, error)
+	progress      Map[[]byte, []byte]
+}
+
+// NewValueMigration returns a ValueMigration of the values of the map from the old
+// value codec, given the Prefix and human-readable name of the collection storing
+// its progress. The migrate function converts the old value of a key to its new value.
+func NewValueMigration[K, OldValue, Value any](
+	schemaBuilder *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	m Map[K, Value],
+	oldValueCodec codec.ValueCodec[OldValue],
+	migrate func(ctx context.Context, key K, oldValue OldValue) (Value, error),
+) *ValueMigration[K, OldValue, Value] {
+	return &ValueMigration[K, OldValue, Value]{
+		m:             m,
+		oldValueCodec: oldValueCodec,
+		migrate:       migrate,
+		progress:      NewMap(schemaBuilder, prefix, name, BytesKey, BytesValue),
+	}
+}
+
+// migrationStatus is the status of a ValueMigration.
+type migrationStatus struct {
+	started, done bool
+	// cursor is the raw key of the last migrated entry, nil if there is none.
+	cursor []byte
+}
+
+func (vm *ValueMigration[K, OldValue, Value]) status(ctx context.Context) (migrationStatus, error) {
+	bz, err := vm.progress.Get(ctx, migrationStatusKey)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return migrationStatus{}, nil
+	case err != nil:
+		return migrationStatus{}, err
+	case len(bz) == 1 && bz[0] == migrationDone:
+		return migrationStatus{started: true, done: true}, nil
+	case len(bz) == 2 && bz[0] == migrationInProgress && bz[1] == 0:
+		return migrationStatus{started: true}, nil
+	case len(bz) >= 2 && bz[0] == migrationInProgress && bz[1] == 1:
+		return migrationStatus{started: true, cursor: bz[2:]}, nil
+	default:
+		return migrationStatus{}, fmt.Errorf("%w: invalid migration status %x", ErrEncoding, bz)
+	}
+}
+
+func (vm *ValueMigration[K, OldValue, Value]) setCursor(ctx context.Context, cursor []byte) error {
+	return vm.progress.Set(ctx, migrationStatusKey, append([]byte{migrationInProgress, 1}, cursor...))
+}
+
+// migrated reports if the entry with the raw key is encoded with the new value codec,
+// which is the case of all the entries unless the migration is in progress.
+func (vm *ValueMigration[K, OldValue, Value]) migrated(ctx context.Context, status migrationStatus, rawKey []byte) (bool, error) {
+	if !status.started || status.done || (status.cursor != nil && bytes.Compare(rawKey, status.cursor) <= 0) {
+		return true, nil
+	}
+	return vm.progress.Has(ctx, migrationAheadKey(rawKey))
+}
+
+// migrationAheadKey returns the key marking the entry with the raw key as written
+// ahead of the migration.
+func migrationAheadKey(rawKey []byte) []byte {
+	return append(append([]byte{}, migrationAheadPrefix...), rawKey...)
+}
+
+// Start starts the migration, which must not have been started before.
+func (vm *ValueMigration[K, OldValue, Value]) Start(ctx context.Context) error {
+	status, err := vm.status(ctx)
+	if err != nil {
+		return err
+	}
+	if status.started {
+		return ErrMigrationStarted
+	}
+	return vm.progress.Set(ctx, migrationStatusKey, []byte{migrationInProgress, 0})
+}
+
+// InProgress reports if the migration is started and not done yet. Otherwise, the
+// map can be used directly, as it is when the migration was never started, such as
+// for a new chain whose genesis is encoded with the new value codec.
+func (vm *ValueMigration[K, OldValue, Value]) InProgress(ctx context.Context) (bool, error) {
+	status, err := vm.status(ctx)
+	return status.started && !status.done, err
+}
+
+// Step migrates up to limit entries of the map and reports if the migration is done.
+func (vm *ValueMigration[K, OldValue, Value]) Step(ctx context.Context, limit int) (done bool, err error) {
+	return vm.step(ctx, limit, func() error { return nil })
+}
+
+// StepWithGas migrates the entries of the map, consuming gasPerEntry from the gas
+// meter for each entry, as long as the gas meter has enough remaining gas, and
+// reports if the migration is done.
+func (vm *ValueMigration[K, OldValue, Value]) StepWithGas(ctx context.Context, meter gas.Meter, gasPerEntry gas.Gas) (done bool, err error) {
+	if gasPerEntry == 0 {
+		return false, fmt.Errorf("collections: gas per entry must be positive")
+	}
+	limit := meter.Remaining() / gasPerEntry
+	if limit > uint64(maxMigrationStep) {
+		limit = uint64(maxMigrationStep)
+	}
+	return vm.step(ctx, int(limit), func() error {
+		return meter.Consume(gasPerEntry, "collections value migration")
+	})
+}
+
+// maxMigrationStep bounds the number of entries read at once by StepWithGas.
+const maxMigrationStep = 10000
+
+func (vm *ValueMigration[K, OldValue, Value]) step(ctx context.Context, limit int, consume func() error) (bool, error) {
+	if limit < 0 {
+		return false, fmt.Errorf("collections: invalid migration step limit %d", limit)
+	}
+	status, err := vm.status(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case !status.started:
+		return false, fmt.Errorf("collections: migration of %s not started", vm.m.GetName())
+	case status.done:
+		return true, nil
+	}
+
+	// the entries are read first, then migrated outside of the iteration
+	start := vm.m.prefix
+	if status.cursor != nil {
+		start = append(append([]byte{}, vm.m.prefix...), status.cursor...)
+		start = append(start, 0)
+	}
+	it, err := vm.m.sa(ctx).Iterator(start, nextBytesPrefixKey(vm.m.prefix))
+	if err != nil {
+		return false, err
+	}
+	var rawKeys, rawValues [][]byte
+	for ; it.Valid() && len(rawKeys) <= limit; it.Next() {
+		rawKeys = append(rawKeys, bytes.Clone(it.Key()[len(vm.m.prefix):]))
+		rawValues = append(rawValues, bytes.Clone(it.Value()))
+	}
+	if err := it.Close(); err != nil {
+		return false, err
+	}
+
+	// an extra entry is read to know if the migration is done
+	done := len(rawKeys) <= limit
+	if !done {
+		rawKeys, rawValues = rawKeys[:limit], rawValues[:limit]
+	}
+	for i, rawKey := range rawKeys {
+		if err := consume(); err != nil {
+			return false, err
+		}
+		if err := vm.migrateEntry(ctx, rawKey, rawValues[i]); err != nil {
+			return false, err
+		}
+	}
+
+	if !done {
+		if len(rawKeys) == 0 {
+			return false, nil
+		}
+		return false, vm.setCursor(ctx, rawKeys[len(rawKeys)-1])
+	}
+	if err := vm.progress.Clear(ctx, new(Range[[]byte]).Prefix(migrationAheadPrefix)); err != nil {
+		return false, err
+	}
+	return true, vm.progress.Set(ctx, migrationStatusKey, []byte{migrationDone})
+}
+
+// migrateEntry migrates the entry of the map with the raw key and value, unless
+// it was written ahead of the migration.
+func (vm *ValueMigration[K, OldValue, Value]) migrateEntry(ctx context.Context, rawKey, rawValue []byte) error {
+	aheadKey := migrationAheadKey(rawKey)
+	ahead, err := vm.progress.Has(ctx, aheadKey)
+	if err != nil {
+		return err
+	}
+	if ahead {
+		return vm.progress.Remove(ctx, aheadKey)
+	}
+
+	read, key, err := vm.m.kc.Decode(rawKey)
+	if err != nil {
+		return err
+	}
+	if read != len(rawKey) {
+		return fmt.Errorf("%w: key '%x' not fully consumed, read %d out of %d bytes", ErrEncoding, rawKey, read, len(rawKey))
+	}
+	value, err := vm.migrateValue(ctx, key, rawValue)
+	if err != nil {
+		return err
+	}
+	return vm.m.Set(ctx, key, value)
+}
+
+func (vm *ValueMigration[K, OldValue, Value]) migrateValue(ctx context.Context, key K, rawValue []byte) (Value, error) {
+	oldValue, err := vm.oldValueCodec.Decode(rawValue)
+	if err != nil {
+		var zero Value
+		return zero, fmt.Errorf("%w: old value decode: %w", ErrEncoding, err)
+	}
+	return vm.migrate(ctx, key, oldValue)
+}
+
+// Get returns the value of the key, migrating it from the old value if it isn't
+// migrated yet.
+func (vm *ValueMigration[K, OldValue, Value]) Get(ctx context.Context, key K) (Value, error) {
+	var zero Value
+	rawKey, err := EncodeKeyWithPrefix(nil, vm.m.kc, key)
+	if err != nil {
+		return zero, err
+	}
+	status, err := vm.status(ctx)
+	if err != nil {
+		return zero, err
+	}
+	migrated, err := vm.migrated(ctx, status, rawKey)
+	if err != nil {
+		return zero, err
+	}
+	if migrated {
+		return vm.m.Get(ctx, key)
+	}
+
+	rawValue, err := vm.m.sa(ctx).Get(append(append([]byte{}, vm.m.prefix...), rawKey...))
+	if err != nil {
+		return zero, err
+	}
+	if rawValue == nil {
+		return zero, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, vm.m.kc.Stringify(key), vm.m.vc.ValueType())
+	}
+	return vm.migrateValue(ctx, key, rawValue)
+}
+
+// Set sets the value of the key, marking it as migrated if it is ahead of the
+// migration.
+func (vm *ValueMigration[K, OldValue, Value]) Set(ctx context.Context, key K, value Value) error {
+	rawKey, err := EncodeKeyWithPrefix(nil, vm.m.kc, key)
+	if err != nil {
+		return err
+	}
+	status, err := vm.status(ctx)
+	if err != nil {
+		return err
+	}
+	migrated, err := vm.migrated(ctx, status, rawKey)
+	if err != nil {
+		return err
+	}
+	if !migrated {
+		if err := vm.progress.Set(ctx, migrationAheadKey(rawKey), []byte{}); err != nil {
+			return err
+		}
+	}
+	return vm.m.Set(ctx, key, value)
+}
+
+// Remove removes the key, and its mark if it was written ahead of the migration.
+func (vm *ValueMigration[K, OldValue, Value]) Remove(ctx context.Context, key K) error {
+	rawKey, err := EncodeKeyWithPrefix(nil, vm.m.kc, key)
+	if err != nil {
+		return err
+	}
+	if err := vm.progress.Remove(ctx, migrationAheadKey(rawKey)); err != nil {
+		return err
+	}
+	return vm.m.Remove(ctx, key)
+}
+
+// MigrationHandler returns the handler of the in-place store migration starting the
+// migration, to register with an appmodule.MigrationRegistrar. If eager, all the
+// entries are migrated by the handler, otherwise the migration must be completed
+// with Step or StepWithGas, for instance in the pre blockers of the following blocks.
+func (vm *ValueMigration[K, OldValue, Value]) MigrationHandler(eager bool) appmodule.MigrationHandler {
+	return func(ctx context.Context) error {
+		if err := vm.Start(ctx); err != nil {
+			return err
+		}
+		for eager {
+			done, err := vm.Step(ctx, maxMigrationStep)
+			if err != nil || done {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,167 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/gas"
+)
+
+func newTestValueMigration(t *testing.T) (context.Context, Map[uint64, string], *ValueMigration[uint64, uint64, string]) {
+	t.Helper()
+
+	sk, ctx := deps()
+	// the values are written with the old value codec
+	oldMap := NewMap(NewSchemaBuilder(sk), NewPrefix(0), "m", Uint64Key, Uint64Value)
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, oldMap.Set(ctx, i, i*10))
+	}
+
+	sb := NewSchemaBuilder(sk)
+	m := NewMap(sb, NewPrefix(0), "m", Uint64Key, StringValue)
+	vm := NewValueMigration(sb, NewPrefix(1), "m_migration", m, Uint64Value, func(_ context.Context, key, oldValue uint64) (string, error) {
+		return fmt.Sprintf("%d:%d", key, oldValue), nil
+	})
+	_, err := sb.Build()
+	require.NoError(t, err)
+	return ctx, m, vm
+}
+
+func TestValueMigration(t *testing.T) {
+	ctx, m, vm := newTestValueMigration(t)
+
+	inProgress, err := vm.InProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+	_, err = vm.Step(ctx, 1)
+	require.ErrorContains(t, err, "migration of m not started")
+
+	require.NoError(t, vm.Start(ctx))
+	require.ErrorIs(t, vm.Start(ctx), ErrMigrationStarted)
+	inProgress, err = vm.InProgress(ctx)
+	require.NoError(t, err)
+	require.True(t, inProgress)
+
+	// the values are migrated lazily when read
+	v, err := vm.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "3:30", v)
+	_, err = vm.Get(ctx, 6)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// the values written ahead of the migration are not migrated again
+	require.NoError(t, vm.Set(ctx, 4, "new"))
+	require.NoError(t, vm.Remove(ctx, 5))
+
+	done, err := vm.Step(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, done)
+	v, err = m.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "2:20", v)
+	v, err = vm.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "3:30", v)
+
+	// the values written behind the migration are written directly
+	require.NoError(t, vm.Set(ctx, 1, "updated"))
+
+	done, err = vm.Step(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, done)
+	inProgress, err = vm.InProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+
+	kvs, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	values, err := kvs.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[uint64, string]{
+		{Key: 1, Value: "updated"},
+		{Key: 2, Value: "2:20"},
+		{Key: 3, Value: "3:30"},
+		{Key: 4, Value: "new"},
+	}, values)
+
+	// the marks of the values written ahead of the migration are removed
+	progress, err := vm.progress.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := progress.Keys()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{migrationStatusKey}, keys)
+
+	done, err = vm.Step(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, done)
+}
+
+type testGasMeter struct {
+	consumed, limit gas.Gas
+}
+
+func (m *testGasMeter) Consume(amount gas.Gas, _ string) error {
+	if m.consumed+amount > m.limit {
+		return gas.ErrOutOfGas
+	}
+	m.consumed += amount
+	return nil
+}
+
+func (m *testGasMeter) Refund(amount gas.Gas, _ string) error {
+	m.consumed -= amount
+	return nil
+}
+
+func (m *testGasMeter) Remaining() gas.Gas { return m.limit - m.consumed }
+
+func (m *testGasMeter) Limit() gas.Gas { return m.limit }
+
+func TestValueMigrationStepWithGas(t *testing.T) {
+	ctx, m, vm := newTestValueMigration(t)
+	require.NoError(t, vm.Start(ctx))
+
+	meter := &testGasMeter{limit: 35}
+	done, err := vm.StepWithGas(ctx, meter, 10)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, gas.Gas(30), meter.consumed)
+
+	has, err := m.Has(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, has)
+	v, err := vm.Get(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, "4:40", v)
+
+	done, err = vm.StepWithGas(ctx, &testGasMeter{limit: 100}, 10)
+	require.NoError(t, err)
+	require.True(t, done)
+	v, err = m.Get(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "5:50", v)
+}
+
+func TestValueMigrationHandler(t *testing.T) {
+	ctx, m, vm := newTestValueMigration(t)
+	require.NoError(t, vm.MigrationHandler(true)(ctx))
+
+	inProgress, err := vm.InProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+	values, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	vs, err := values.Values()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:10", "2:20", "3:30", "4:40", "5:50"}, vs)
+
+	// the lazy handler only starts the migration
+	ctx, _, vm = newTestValueMigration(t)
+	require.NoError(t, vm.MigrationHandler(false)(ctx))
+	inProgress, err = vm.InProgress(ctx)
+	require.NoError(t, err)
+	require.True(t, inProgress)
+}
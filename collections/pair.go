@@ -183,6 +183,23 @@ func (p pairKeyCodec[K1, K2]) SizeNonTerminal(key Pair[K1, K2]) int {
 
 // GENESIS
 
+func (p pairKeyCodec[K1, K2]) SchemaCodec() (codec.SchemaCodec[Pair[K1, K2]], error) {
+	part1, err := newKeyPartSchemaCodec(p.keyCodec1)
+	if err != nil {
+		return codec.SchemaCodec[Pair[K1, K2]]{}, err
+	}
+	part2, err := newKeyPartSchemaCodec(p.keyCodec2)
+	if err != nil {
+		return codec.SchemaCodec[Pair[K1, K2]]{}, err
+	}
+
+	return multipartSchemaCodec(
+		[]keyPartSchemaCodec{part1, part2},
+		func(key Pair[K1, K2]) []any { return []any{key.K1(), key.K2()} },
+		func(parts []any) Pair[K1, K2] { return Join(keyPart[K1](parts[0]), keyPart[K2](parts[1])) },
+	), nil
+}
+
 type jsonPairKey [2]json.RawMessage
 
 func (p pairKeyCodec[K1, K2]) EncodeJSON(v Pair[K1, K2]) ([]byte, error) {
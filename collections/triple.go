@@ -70,6 +70,29 @@ type tripleKeyCodec[K1, K2, K3 any] struct {
 	keyCodec3 codec.KeyCodec[K3]
 }
 
+func (t tripleKeyCodec[K1, K2, K3]) SchemaCodec() (codec.SchemaCodec[Triple[K1, K2, K3]], error) {
+	part1, err := newKeyPartSchemaCodec(t.keyCodec1)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+	part2, err := newKeyPartSchemaCodec(t.keyCodec2)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+	part3, err := newKeyPartSchemaCodec(t.keyCodec3)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+
+	return multipartSchemaCodec(
+		[]keyPartSchemaCodec{part1, part2, part3},
+		func(key Triple[K1, K2, K3]) []any { return []any{key.K1(), key.K2(), key.K3()} },
+		func(parts []any) Triple[K1, K2, K3] {
+			return Join3(keyPart[K1](parts[0]), keyPart[K2](parts[1]), keyPart[K3](parts[2]))
+		},
+	), nil
+}
+
 type jsonTripleKey [3]json.RawMessage
 
 func (t tripleKeyCodec[K1, K2, K3]) EncodeJSON(value Triple[K1, K2, K3]) ([]byte, error) {
@@ -20,13 +20,33 @@ type KVPairs = []KVPair
 
 // KVPair represents a change in a key and value of state.
 // Remove being true signals the key must be removed from state.
+// RemoveRange being true signals all the keys in the range [Key, End) must be
+// removed from state.
 type KVPair struct {
-	// Key defines the key being updated.
+	// Key defines the key being updated, or the inclusive start of the range
+	// being removed. An empty start means the range has no lower bound.
 	Key []byte
 	// Value defines the value associated with the updated key.
 	Value []byte
 	// Remove is true when the key must be removed from state.
 	Remove bool
+	// RemoveRange is true when the keys in the range [Key, End) must be removed
+	// from state. The range only applies to the keys written before it in the
+	// changeset, so keys set after it in the same changeset are kept.
+	RemoveRange bool
+	// End defines the exclusive end of the range being removed. An empty end
+	// means the range has no upper bound.
+	End []byte
+}
+
+// Contains returns true if the range removed by the pair contains the given key.
+// It always returns false if the pair is not a range removal.
+func (kv KVPair) Contains(key []byte) bool {
+	if !kv.RemoveRange {
+		return false
+	}
+
+	return bytes.Compare(key, kv.Key) >= 0 && (len(kv.End) == 0 || bytes.Compare(key, kv.End) < 0)
 }
 
 func NewChangeset() *Changeset {
@@ -101,3 +121,29 @@ func (cs *Changeset) AddKVPair(storeKey []byte, pair KVPair) {
 		})
 	}
 }
+
+// AddRangeRemove adds the removal of all the keys in the range [start, end) to
+// the ChangeSet. An empty start or end means the range is unbounded on that side.
+func (cs *Changeset) AddRangeRemove(storeKey, start, end []byte) {
+	cs.AddKVPair(storeKey, KVPair{Key: start, End: end, RemoveRange: true})
+}
+
+// AddPrefixRemove adds the removal of all the keys with the given prefix to the
+// ChangeSet.
+func (cs *Changeset) AddPrefixRemove(storeKey, prefix []byte) {
+	cs.AddRangeRemove(storeKey, prefix, prefixEnd(prefix))
+}
+
+// prefixEnd returns the end of the range of the keys with the given prefix, or
+// nil if the range has no upper bound.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
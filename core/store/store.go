@@ -30,6 +30,15 @@ type KVStore interface {
 	ReverseIterator(start, end []byte) (Iterator, error)
 }
 
+// RangeDeleter is implemented by the KVStores able to delete a range of keys at
+// once, which is recorded as a single range removal in the state changes instead
+// of a removal per key.
+type RangeDeleter interface {
+	// DeleteRange deletes all the keys in the range [start, end). A nil start or
+	// end means the range is unbounded on that side.
+	DeleteRange(start, end []byte) error
+}
+
 // Batch represents a group of writes. They may or may not be written atomically depending on the
 // backend. Callers must call Close on the batch when done.
 //
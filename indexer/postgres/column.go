@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// column is a column of a table, either the column of a schema field or a system column.
+type column struct {
+	name string
+
+	// sqlType is the type of the column in the CREATE TABLE statement.
+	sqlType string
+
+	// dataType is the type of the column as reported by information_schema.columns,
+	// used to check the compatibility of an existing table.
+	dataType string
+
+	nullable bool
+
+	// field is the schema field of the column, nil for system columns.
+	field *schema.Field
+}
+
+const (
+	// singletonColumn is the primary key of the tables of the object types without
+	// key fields, which have a single row.
+	singletonColumn = "_id"
+
+	// deletedColumn marks the deleted rows of the object types which retain deletions.
+	deletedColumn = "_deleted"
+)
+
+// fieldColumn returns the column of the field in the given postgres schema, which
+// is the schema of the enum types.
+func fieldColumn(pgSchema string, field schema.Field) (column, error) {
+	col := column{name: field.Name, nullable: field.Nullable, field: &field}
+	switch field.Kind {
+	case schema.StringKind, schema.Bech32AddressKind:
+		col.sqlType, col.dataType = "TEXT", "text"
+	case schema.BytesKind:
+		col.sqlType, col.dataType = "BYTEA", "bytea"
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind:
+		col.sqlType, col.dataType = "SMALLINT", "smallint"
+	case schema.Uint16Kind, schema.Int32Kind:
+		col.sqlType, col.dataType = "INTEGER", "integer"
+	case schema.Uint32Kind, schema.Int64Kind, schema.DurationKind:
+		col.sqlType, col.dataType = "BIGINT", "bigint"
+	case schema.Uint64Kind:
+		col.sqlType, col.dataType = "NUMERIC(20,0)", "numeric"
+	case schema.IntegerStringKind, schema.DecimalStringKind:
+		col.sqlType, col.dataType = "NUMERIC", "numeric"
+	case schema.BoolKind:
+		col.sqlType, col.dataType = "BOOLEAN", "boolean"
+	case schema.TimeKind:
+		col.sqlType, col.dataType = "TIMESTAMPTZ", "timestamp with time zone"
+	case schema.Float32Kind:
+		col.sqlType, col.dataType = "REAL", "real"
+	case schema.Float64Kind:
+		col.sqlType, col.dataType = "DOUBLE PRECISION", "double precision"
+	case schema.EnumKind:
+		col.sqlType, col.dataType = qualifiedName(pgSchema, field.EnumDefinition.Name), "USER-DEFINED"
+	case schema.JSONKind, schema.ListKind, schema.StructKind, schema.CoinKind, schema.DecCoinKind:
+		col.sqlType, col.dataType = "JSONB", "jsonb"
+	default:
+		return column{}, fmt.Errorf("unsupported kind %s for field %q", field.Kind, field.Name)
+	}
+	return col, nil
+}
+
+// definition returns the definition of the column in a CREATE TABLE statement.
+func (c column) definition() string {
+	def := quoteIdentifier(c.name) + " " + c.sqlType
+	switch {
+	case c.name == singletonColumn:
+		def += " NOT NULL DEFAULT 1 CHECK (" + quoteIdentifier(c.name) + " = 1)"
+	case c.name == deletedColumn:
+		def += " NOT NULL DEFAULT FALSE"
+	case c.nullable:
+		def += " NULL"
+	default:
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// param converts the value of a field to a database/sql parameter of the column.
+func (c column) param(value interface{}) (interface{}, error) {
+	if value == nil {
+		if !c.nullable {
+			return nil, fmt.Errorf("column %q cannot be null", c.name)
+		}
+		return nil, nil
+	}
+	if err := c.field.Kind.ValidateValueType(value); err != nil {
+		return nil, fmt.Errorf("invalid value for column %q: %v", c.name, err)
+	}
+
+	switch c.field.Kind {
+	case schema.Uint64Kind:
+		// unsigned 64-bit integers may overflow the int64 parameters of database/sql
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.DurationKind:
+		return int64(value.(time.Duration)), nil
+	case schema.JSONKind:
+		return string(value.(json.RawMessage)), nil
+	case schema.Bech32AddressKind:
+		return schema.EncodeBech32Address(c.field.AddressPrefix, value.([]byte))
+	case schema.ListKind, schema.StructKind, schema.CoinKind, schema.DecCoinKind:
+		v, err := jsonValue(*c.field, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for column %q: %v", c.name, err)
+		}
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(bz), nil
+	default:
+		return value, nil
+	}
+}
+
+// jsonValue converts the value of a field to a value marshaled as the JSON value of
+// a JSONB column, the addresses being bech32 encoded as in their own columns.
+func jsonValue(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Bech32AddressKind:
+		return schema.EncodeBech32Address(field.AddressPrefix, value.([]byte))
+	case schema.Uint64Kind:
+		// as the other numeric strings, to not lose precision in JSON numbers
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.DurationKind:
+		return int64(value.(time.Duration)), nil
+	case schema.ListKind:
+		elementField := field
+		elementField.Kind, elementField.ElementKind = field.ElementKind, schema.InvalidKind
+		elements := value.([]interface{})
+		values := make([]interface{}, len(elements))
+		for i, element := range elements {
+			v, err := jsonValue(elementField, element)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	case schema.StructKind:
+		fields := value.(map[string]interface{})
+		values := make(map[string]interface{}, len(fields))
+		for _, structField := range field.StructDefinition.Fields {
+			v, err := jsonValue(structField, fields[structField.Name])
+			if err != nil {
+				return nil, err
+			}
+			values[structField.Name] = v
+		}
+		return values, nil
+	default:
+		return value, nil
+	}
+}
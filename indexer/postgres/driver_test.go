@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingDriver is a database/sql driver recording the executed statements,
+// which allows testing the indexer without a postgres database.
+type recordingDriver struct {
+	mtx sync.Mutex
+	dbs map[string]*recordedDB
+}
+
+// recordedDB is the database of a data source name of the recording driver.
+type recordedDB struct {
+	mtx sync.Mutex
+
+	// stmts are the executed statements, followed by their parameters.
+	stmts []string
+
+	// columns are the rows returned by the information_schema.columns queries,
+	// by "schema.table".
+	columns map[string][][]driver.Value
+
+	// failCommit makes the commits fail.
+	failCommit bool
+}
+
+var testDriver = &recordingDriver{dbs: map[string]*recordedDB{}}
+
+func init() {
+	sql.Register("recording", testDriver)
+}
+
+// openRecordedDB opens a database of the recording driver named after the test.
+func openRecordedDB(t *testing.T) (*sql.DB, *recordedDB) {
+	t.Helper()
+
+	rec := &recordedDB{columns: map[string][][]driver.Value{}}
+	testDriver.mtx.Lock()
+	testDriver.dbs[t.Name()] = rec
+	testDriver.mtx.Unlock()
+
+	db, err := sql.Open("recording", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, rec
+}
+
+func (rec *recordedDB) record(stmt string) {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	rec.stmts = append(rec.stmts, stmt)
+}
+
+// take returns the recorded statements and resets them.
+func (rec *recordedDB) take() []string {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	stmts := rec.stmts
+	rec.stmts = nil
+	return stmts
+}
+
+func (d *recordingDriver) Open(name string) (driver.Conn, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	rec, ok := d.dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown database %s", name)
+	}
+	return recordingConn{rec}, nil
+}
+
+type recordingConn struct{ rec *recordedDB }
+
+func (c recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return recordingStmt{rec: c.rec, query: query}, nil
+}
+
+func (c recordingConn) Close() error { return nil }
+
+func (c recordingConn) Begin() (driver.Tx, error) {
+	c.rec.record("BEGIN")
+	return recordingTx(c), nil
+}
+
+type recordingTx struct{ rec *recordedDB }
+
+func (tx recordingTx) Commit() error {
+	if tx.rec.failCommit {
+		return fmt.Errorf("commit failed")
+	}
+	tx.rec.record("COMMIT")
+	return nil
+}
+
+func (tx recordingTx) Rollback() error {
+	tx.rec.record("ROLLBACK")
+	return nil
+}
+
+type recordingStmt struct {
+	rec   *recordedDB
+	query string
+}
+
+func (s recordingStmt) Close() error { return nil }
+
+func (s recordingStmt) NumInput() int { return -1 }
+
+func (s recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	stmt := s.query
+	for _, arg := range args {
+		stmt += fmt.Sprintf(" %#v", arg)
+	}
+	s.rec.record(stmt)
+	return driver.RowsAffected(1), nil
+}
+
+func (s recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if !strings.Contains(s.query, "information_schema.columns") || len(args) != 2 {
+		return nil, fmt.Errorf("unexpected query %s", s.query)
+	}
+	s.rec.mtx.Lock()
+	defer s.rec.mtx.Unlock()
+	return &recordingRows{rows: s.rec.columns[fmt.Sprintf("%s.%s", args[0], args[1])]}, nil
+}
+
+type recordingRows struct{ rows [][]driver.Value }
+
+func (r *recordingRows) Columns() []string {
+	return []string{"column_name", "data_type", "is_nullable"}
+}
+
+func (r *recordingRows) Close() error { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
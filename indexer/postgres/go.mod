@@ -0,0 +1,9 @@
+module cosmossdk.io/indexer/postgres
+
+// NOTE: we are staying on an earlier version of golang to avoid problems building with older codebases,
+// see the note in the go.mod of cosmossdk.io/schema.
+go 1.12
+
+require cosmossdk.io/schema v0.1.1
+
+replace cosmossdk.io/schema => ../../schema
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+// Options are the options of the postgres indexer.
+type Options struct {
+	// DisableRetainDeletions deletes the rows of the object types which retain
+	// deletions, see schema.ObjectType.RetainDeletions, instead of marking them as
+	// deleted in their _deleted column.
+	DisableRetainDeletions bool
+
+	// Logger, if set, logs the statements executed by the indexer.
+	Logger SQLLogger
+}
+
+// StartIndexer creates the block, tx and event tables in the database unless they
+// already exist and returns the listener indexing the blockchain data in it:
+//   - the blocks, transactions and events are inserted in the block, tx and event tables,
+//   - a postgres schema is created for each module passed to InitializeModuleData,
+//     with a table per object type and an enum type per enum definition, see
+//     schema.ModuleSchema,
+//   - the rows of the tables are inserted, updated and deleted on OnObjectUpdate.
+//
+// The writes are done in a database transaction which is committed on Commit.
+// The database must be a postgres database, opened with a database/sql driver
+// such as github.com/lib/pq. The listener must not be called concurrently.
+func StartIndexer(ctx context.Context, db *sql.DB, opts Options) (appdata.Listener, error) {
+	idx := &indexer{
+		ctx:     ctx,
+		db:      db,
+		opts:    opts,
+		modules: map[string]*moduleIndexer{},
+	}
+
+	tx, err := idx.tx()
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+	for _, stmt := range baseTablesSQL {
+		if err := exec(ctx, tx, opts.Logger, stmt); err != nil {
+			return appdata.Listener{}, err
+		}
+	}
+	if err := idx.commit(appdata.CommitData{}); err != nil {
+		return appdata.Listener{}, err
+	}
+
+	return appdata.Listener{
+		InitializeModuleData: idx.initializeModuleData,
+		StartBlock:           idx.startBlock,
+		OnTx:                 idx.onTx,
+		OnEvent:              idx.onEvent,
+		OnObjectUpdate:       idx.onObjectUpdate,
+		Commit:               idx.commit,
+	}, nil
+}
+
+// baseTablesSQL are the statements creating the tables of the blocks, transactions and events.
+var baseTablesSQL = []string{
+	`CREATE TABLE IF NOT EXISTS "block" (
+	"number" BIGINT NOT NULL PRIMARY KEY,
+	"header" JSONB NULL
+);`,
+	`CREATE TABLE IF NOT EXISTS "tx" (
+	"id" BIGSERIAL PRIMARY KEY,
+	"block_number" BIGINT NOT NULL REFERENCES "block" ("number"),
+	"index_in_block" BIGINT NOT NULL,
+	"data" JSONB NULL,
+	"bytes" BYTEA NULL
+);`,
+	`CREATE TABLE IF NOT EXISTS "event" (
+	"id" BIGSERIAL PRIMARY KEY,
+	"block_number" BIGINT NOT NULL REFERENCES "block" ("number"),
+	"tx_index" INTEGER NOT NULL,
+	"msg_index" BIGINT NOT NULL,
+	"event_index" BIGINT NOT NULL,
+	"type" TEXT NOT NULL,
+	"data" JSONB NULL
+);`,
+}
+
+type indexer struct {
+	ctx  context.Context
+	db   *sql.DB
+	opts Options
+
+	// dbTx is the database transaction of the current block, begun by the first
+	// write after a commit.
+	dbTx *sql.Tx
+
+	// height is the height of the current block.
+	height uint64
+
+	modules map[string]*moduleIndexer
+}
+
+func (i *indexer) tx() (*sql.Tx, error) {
+	if i.dbTx != nil {
+		return i.dbTx, nil
+	}
+	tx, err := i.db.BeginTx(i.ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin the database transaction: %v", err)
+	}
+	i.dbTx = tx
+	return tx, nil
+}
+
+func (i *indexer) exec(stmt string, params ...interface{}) error {
+	tx, err := i.tx()
+	if err != nil {
+		return err
+	}
+	return exec(i.ctx, tx, i.opts.Logger, stmt, params...)
+}
+
+func (i *indexer) initializeModuleData(data appdata.ModuleInitializationData) error {
+	if _, ok := i.modules[data.ModuleName]; ok {
+		return fmt.Errorf("module %s already initialized", data.ModuleName)
+	}
+
+	m, err := newModuleIndexer(data.ModuleName, data.Schema, i.opts)
+	if err != nil {
+		return err
+	}
+	tx, err := i.tx()
+	if err != nil {
+		return err
+	}
+	if err := m.initialize(i.ctx, tx, i.opts.Logger); err != nil {
+		return fmt.Errorf("failed to initialize module %s: %v", data.ModuleName, err)
+	}
+
+	i.modules[data.ModuleName] = m
+	return nil
+}
+
+func (i *indexer) startBlock(data appdata.StartBlockData) error {
+	header, err := jsonParam(data.HeaderJSON)
+	if err != nil {
+		return err
+	}
+
+	i.height = data.Height
+	return i.exec(`INSERT INTO "block" ("number", "header") VALUES ($1, $2)
+ON CONFLICT ("number") DO UPDATE SET "header" = EXCLUDED."header";`, int64(data.Height), header)
+}
+
+func (i *indexer) onTx(data appdata.TxData) error {
+	txJSON, err := jsonParam(data.JSON)
+	if err != nil {
+		return err
+	}
+	var bz interface{}
+	if data.Bytes != nil {
+		if bz, err = data.Bytes(); err != nil {
+			return err
+		}
+	}
+
+	return i.exec(`INSERT INTO "tx" ("block_number", "index_in_block", "data", "bytes") VALUES ($1, $2, $3, $4);`,
+		int64(i.height), data.TxIndex, txJSON, bz)
+}
+
+func (i *indexer) onEvent(data appdata.EventData) error {
+	eventJSON, err := jsonParam(data.Data)
+	if err != nil {
+		return err
+	}
+
+	return i.exec(`INSERT INTO "event" ("block_number", "tx_index", "msg_index", "event_index", "type", "data")
+VALUES ($1, $2, $3, $4, $5, $6);`,
+		int64(i.height), data.TxIndex, int64(data.MsgIndex), int64(data.EventIndex), data.Type, eventJSON)
+}
+
+func (i *indexer) onObjectUpdate(data appdata.ObjectUpdateData) error {
+	m, ok := i.modules[data.ModuleName]
+	if !ok {
+		return fmt.Errorf("module %s not initialized", data.ModuleName)
+	}
+
+	tx, err := i.tx()
+	if err != nil {
+		return err
+	}
+	for _, update := range data.Updates {
+		if err := m.update(i.ctx, tx, i.opts.Logger, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *indexer) commit(appdata.CommitData) error {
+	if i.dbTx == nil {
+		return nil
+	}
+
+	tx := i.dbTx
+	i.dbTx = nil
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit the database transaction: %v", err)
+	}
+	return nil
+}
+
+// jsonParam returns the JSON of the data as a parameter of a JSONB column, nil if
+// the data isn't provided.
+func jsonParam(toJSON appdata.ToJSON) (interface{}, error) {
+	if toJSON == nil {
+		return nil, nil
+	}
+	bz, err := toJSON()
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	return string(bz), nil
+}
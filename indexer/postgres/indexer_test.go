@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+var testModuleSchema = schema.ModuleSchema{
+	ObjectTypes: []schema.ObjectType{
+		{
+			Name: "balances",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.Bech32AddressKind, AddressPrefix: "cosmos"},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerStringKind}},
+		},
+		{
+			Name:      "proposals",
+			KeyFields: []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+			ValueFields: []schema.Field{
+				{Name: "status", Kind: schema.EnumKind, EnumDefinition: schema.EnumDefinition{Name: "status", Values: []string{"open", "closed"}}},
+				{Name: "metadata", Kind: schema.JSONKind, Nullable: true},
+			},
+			RetainDeletions: true,
+		},
+		{
+			Name:        "params",
+			ValueFields: []schema.Field{{Name: "enabled", Kind: schema.BoolKind}},
+		},
+	},
+}
+
+func startTestIndexer(t *testing.T, opts Options) (appdata.Listener, *recordedDB) {
+	t.Helper()
+
+	db, rec := openRecordedDB(t)
+	listener, err := StartIndexer(context.Background(), db, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmts := rec.take()
+	if len(stmts) != len(baseTablesSQL)+2 || stmts[0] != "BEGIN" || stmts[len(stmts)-1] != "COMMIT" {
+		t.Fatalf("unexpected base tables creation: %v", stmts)
+	}
+	return listener, rec
+}
+
+func requireStmts(t *testing.T, rec *recordedDB, expected ...string) {
+	t.Helper()
+
+	stmts := rec.take()
+	if !reflect.DeepEqual(expected, stmts) {
+		t.Fatalf("expected statements:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(stmts, "\n"))
+	}
+}
+
+func TestIndexer(t *testing.T) {
+	listener, rec := startTestIndexer(t, Options{})
+
+	err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "test", Schema: testModuleSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmts := rec.take()
+	if stmts[0] != "BEGIN" || stmts[1] != `CREATE SCHEMA IF NOT EXISTS "test";` {
+		t.Fatalf("unexpected statements: %v", stmts)
+	}
+	if !strings.Contains(stmts[2], `CREATE TYPE "test"."status" AS ENUM ('open', 'closed');`) {
+		t.Fatalf("expected the enum type to be created, got %s", stmts[2])
+	}
+	if len(stmts) != 6 {
+		t.Fatalf("expected a table per object type, got %v", stmts)
+	}
+
+	err = listener.StartBlock(appdata.StartBlockData{
+		Height:     1,
+		HeaderJSON: func() (json.RawMessage, error) { return json.RawMessage(`{"height":1}`), nil },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = listener.OnTx(appdata.TxData{TxIndex: 0, Bytes: func() ([]byte, error) { return []byte{0x1}, nil }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = listener.OnEvent(appdata.EventData{TxIndex: -2, EventIndex: 1, Type: "transfer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "balances", Key: []interface{}{make([]byte, 20), "atom"}, Value: "10"},
+			{TypeName: "proposals", Key: uint64(1), Value: schema.MapValueUpdates{"status": "closed"}},
+			{TypeName: "proposals", Key: uint64(2), Delete: true},
+			{TypeName: "params", Value: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireStmts(t, rec,
+		`INSERT INTO "block" ("number", "header") VALUES ($1, $2)
+ON CONFLICT ("number") DO UPDATE SET "header" = EXCLUDED."header"; 1 "{\"height\":1}"`,
+		`INSERT INTO "tx" ("block_number", "index_in_block", "data", "bytes") VALUES ($1, $2, $3, $4); 1 0 <nil> []byte{0x1}`,
+		`INSERT INTO "event" ("block_number", "tx_index", "msg_index", "event_index", "type", "data")
+VALUES ($1, $2, $3, $4, $5, $6); 1 -2 0 1 "transfer" <nil>`,
+		`INSERT INTO "test"."balances" ("address", "denom", "amount") VALUES ($1, $2, $3) ON CONFLICT ("address", "denom") DO UPDATE SET "amount" = EXCLUDED."amount"; "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a" "atom" "10"`,
+		`UPDATE "test"."proposals" SET "status" = $1, "_deleted" = FALSE WHERE "id" = $2; "closed" "1"`,
+		`UPDATE "test"."proposals" SET "_deleted" = TRUE WHERE "id" = $1; "2"`,
+		`INSERT INTO "test"."params" ("enabled") VALUES ($1) ON CONFLICT ("_id") DO UPDATE SET "enabled" = EXCLUDED."enabled"; true`,
+	)
+
+	// the writes are committed on commit
+	if err := listener.Commit(appdata.CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	requireStmts(t, rec, "COMMIT")
+	if err := listener.Commit(appdata.CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	requireStmts(t, rec)
+
+	// the objects of the modules which weren't initialized can't be updated
+	err = listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "other", Updates: []schema.ObjectUpdate{{TypeName: "params", Value: true}}})
+	if err == nil || !strings.Contains(err.Error(), "module other not initialized") {
+		t.Fatalf("expected an error, got %v", err)
+	}
+
+	// the invalid updates are reported
+	err = listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "test", Updates: []schema.ObjectUpdate{{TypeName: "balances", Key: "atom", Value: "10"}}})
+	if err == nil || !strings.Contains(err.Error(), "invalid update of object type balances") {
+		t.Fatalf("expected an error, got %v", err)
+	}
+}
+
+func TestIndexerDisableRetainDeletions(t *testing.T) {
+	listener, rec := startTestIndexer(t, Options{DisableRetainDeletions: true})
+
+	err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "test", Schema: testModuleSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.take()
+
+	err = listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates:    []schema.ObjectUpdate{{TypeName: "proposals", Key: uint64(2), Delete: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireStmts(t, rec, `DELETE FROM "test"."proposals" WHERE "id" = $1; "2"`)
+}
+
+func TestIndexerExistingTables(t *testing.T) {
+	listener, rec := startTestIndexer(t, Options{})
+	rec.columns["test.params"] = [][]driver.Value{
+		{"_id", "integer", "NO"},
+		{"enabled", "text", "NO"},
+	}
+
+	err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "test", Schema: testModuleSchema})
+	if err == nil || !strings.Contains(err.Error(), `column "enabled" of table "test"."params" is incompatible`) {
+		t.Fatalf("expected an error, got %v", err)
+	}
+
+	rec.columns["test.params"] = [][]driver.Value{
+		{"_id", "integer", "NO"},
+		{"enabled", "boolean", "NO"},
+	}
+	err = listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "test", Schema: testModuleSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndexerCommitError(t *testing.T) {
+	listener, rec := startTestIndexer(t, Options{})
+
+	if err := listener.StartBlock(appdata.StartBlockData{Height: 1}); err != nil {
+		t.Fatal(err)
+	}
+	rec.failCommit = true
+	err := listener.Commit(appdata.CommitData{})
+	if err == nil || !strings.Contains(err.Error(), "failed to commit the database transaction") {
+		t.Fatalf("expected an error, got %v", err)
+	}
+}
+
+func TestCreateTableSQL(t *testing.T) {
+	obj, err := newObjectIndexer("test", testModuleSchema.ObjectTypes[1], Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `CREATE TABLE IF NOT EXISTS "test"."proposals" (
+	"id" NUMERIC(20,0) NOT NULL,
+	"status" "test"."status" NOT NULL,
+	"metadata" JSONB NULL,
+	"_deleted" BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY ("id")
+);`
+	if stmt := obj.createTableSQL(); stmt != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, stmt)
+	}
+
+	obj, err = newObjectIndexer("test", testModuleSchema.ObjectTypes[2], Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `CREATE TABLE IF NOT EXISTS "test"."params" (
+	"_id" INTEGER NOT NULL DEFAULT 1 CHECK ("_id" = 1),
+	"enabled" BOOLEAN NOT NULL,
+	PRIMARY KEY ("_id")
+);`
+	if stmt := obj.createTableSQL(); stmt != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, stmt)
+	}
+}
+
+func TestColumnParamJSON(t *testing.T) {
+	field := schema.Field{
+		Name:        "grants",
+		Kind:        schema.ListKind,
+		ElementKind: schema.StructKind,
+		StructDefinition: schema.StructDefinition{
+			Name: "grant",
+			Fields: []schema.Field{
+				{Name: "grantee", Kind: schema.Bech32AddressKind, AddressPrefix: "cosmos"},
+				{Name: "limit", Kind: schema.CoinKind},
+				{Name: "expiration", Kind: schema.TimeKind, Nullable: true},
+			},
+		},
+	}
+	col, err := fieldColumn("test", field)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.sqlType != "JSONB" {
+		t.Fatalf("expected a JSONB column, got %s", col.sqlType)
+	}
+
+	param, err := col.param([]interface{}{
+		map[string]interface{}{
+			"grantee": []byte{
+				0x3e, 0xd4, 0x97, 0xc5, 0x4b, 0xc4, 0x30, 0x26, 0x1b, 0x37,
+				0x6c, 0x16, 0x9b, 0x8a, 0x63, 0x74, 0x8d, 0x5f, 0x6b, 0x90,
+			},
+			"limit": schema.Coin{Denom: "stake", Amount: "100"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"expiration":null,"grantee":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","limit":{"denom":"stake","amount":"100"}}]`
+	if param != expected {
+		t.Fatalf("expected %s, got %v", expected, param)
+	}
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// moduleIndexer indexes the objects of a module in the postgres schema named
+// after the module, with a table per object type.
+type moduleIndexer struct {
+	name    string
+	schema  schema.ModuleSchema
+	objects map[string]*objectIndexer
+}
+
+func newModuleIndexer(name string, moduleSchema schema.ModuleSchema, opts Options) (*moduleIndexer, error) {
+	if !schema.ValidateName(name) {
+		return nil, fmt.Errorf("invalid module name %q", name)
+	}
+	if err := moduleSchema.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid schema of module %s: %v", name, err)
+	}
+
+	m := &moduleIndexer{
+		name:    name,
+		schema:  moduleSchema,
+		objects: make(map[string]*objectIndexer, len(moduleSchema.ObjectTypes)),
+	}
+	for _, typ := range moduleSchema.ObjectTypes {
+		obj, err := newObjectIndexer(name, typ, opts)
+		if err != nil {
+			return nil, fmt.Errorf("object type %s of module %s: %v", typ.Name, name, err)
+		}
+		m.objects[typ.Name] = obj
+	}
+	return m, nil
+}
+
+// initialize creates the postgres schema of the module, its enum types and its
+// tables, unless they already exist, in which case the existing tables are checked
+// against the module schema.
+func (m *moduleIndexer) initialize(ctx context.Context, tx *sql.Tx, logger SQLLogger) error {
+	stmts := []string{"CREATE SCHEMA IF NOT EXISTS " + quoteIdentifier(m.name) + ";"}
+	stmts = append(stmts, m.createEnumTypesSQL()...)
+	for _, stmt := range stmts {
+		if err := exec(ctx, tx, logger, stmt); err != nil {
+			return err
+		}
+	}
+
+	for _, typ := range m.schema.ObjectTypes {
+		obj := m.objects[typ.Name]
+		if err := obj.checkTable(ctx, tx, m.name); err != nil {
+			return err
+		}
+		if err := exec(ctx, tx, logger, obj.createTableSQL()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createEnumTypesSQL returns the statements creating the enum types of the module,
+// which are shared by the fields with the same enum definition.
+func (m *moduleIndexer) createEnumTypesSQL() []string {
+	var stmts []string
+	created := map[string]bool{}
+	for _, typ := range m.schema.ObjectTypes {
+		for _, fields := range [][]schema.Field{typ.KeyFields, typ.ValueFields} {
+			for _, field := range fields {
+				enum := field.EnumDefinition
+				if field.Kind != schema.EnumKind || created[enum.Name] {
+					continue
+				}
+				created[enum.Name] = true
+
+				values := make([]string, len(enum.Values))
+				for i, value := range enum.Values {
+					values[i] = quoteLiteral(value)
+				}
+				// postgres has no CREATE TYPE IF NOT EXISTS
+				stmts = append(stmts, fmt.Sprintf(
+					"DO $$ BEGIN\n\tCREATE TYPE %s AS ENUM (%s);\nEXCEPTION WHEN duplicate_object THEN NULL;\nEND $$;",
+					qualifiedName(m.name, enum.Name), strings.Join(values, ", "),
+				))
+			}
+		}
+	}
+	return stmts
+}
+
+// update applies the object update.
+func (m *moduleIndexer) update(ctx context.Context, tx *sql.Tx, logger SQLLogger, update schema.ObjectUpdate) error {
+	obj, ok := m.objects[update.TypeName]
+	if !ok {
+		return fmt.Errorf("object type %q not found in the schema of module %s", update.TypeName, m.name)
+	}
+
+	var (
+		stmt   string
+		params []interface{}
+		err    error
+	)
+	if update.Delete {
+		stmt, params, err = obj.deleteSQL(update.Key)
+	} else {
+		stmt, params, err = obj.upsertSQL(update)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid update of object type %s of module %s: %v", update.TypeName, m.name, err)
+	}
+	if stmt == "" {
+		return nil
+	}
+	return exec(ctx, tx, logger, stmt, params...)
+}
@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// objectIndexer indexes the objects of an object type in a table, named after the
+// object type, of the postgres schema of the module.
+type objectIndexer struct {
+	typ   schema.ObjectType
+	table string
+
+	// keyColumns are the primary key columns, the singleton column if the object
+	// type has no key fields.
+	keyColumns []column
+
+	valueColumns map[string]column
+
+	// columns are all the columns of the table, in order.
+	columns []column
+
+	retainDeletions bool
+}
+
+func newObjectIndexer(pgSchema string, typ schema.ObjectType, opts Options) (*objectIndexer, error) {
+	o := &objectIndexer{
+		typ:             typ,
+		table:           qualifiedName(pgSchema, typ.Name),
+		valueColumns:    make(map[string]column, len(typ.ValueFields)),
+		retainDeletions: typ.RetainDeletions && !opts.DisableRetainDeletions,
+	}
+
+	for _, field := range typ.KeyFields {
+		col, err := fieldColumn(pgSchema, field)
+		if err != nil {
+			return nil, err
+		}
+		o.keyColumns = append(o.keyColumns, col)
+	}
+	if len(o.keyColumns) == 0 {
+		o.keyColumns = []column{{name: singletonColumn, sqlType: "INTEGER", dataType: "integer"}}
+	}
+	o.columns = append(o.columns, o.keyColumns...)
+
+	for _, field := range typ.ValueFields {
+		col, err := fieldColumn(pgSchema, field)
+		if err != nil {
+			return nil, err
+		}
+		o.valueColumns[field.Name] = col
+		o.columns = append(o.columns, col)
+	}
+
+	if o.retainDeletions {
+		o.columns = append(o.columns, column{name: deletedColumn, sqlType: "BOOLEAN", dataType: "boolean"})
+	}
+
+	return o, nil
+}
+
+// createTableSQL returns the statement creating the table of the object type.
+func (o *objectIndexer) createTableSQL() string {
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE IF NOT EXISTS ")
+	sb.WriteString(o.table)
+	sb.WriteString(" (\n")
+	for _, col := range o.columns {
+		sb.WriteString("\t")
+		sb.WriteString(col.definition())
+		sb.WriteString(",\n")
+	}
+	sb.WriteString("\tPRIMARY KEY (")
+	sb.WriteString(columnList(o.keyColumns))
+	sb.WriteString(")\n);")
+	return sb.String()
+}
+
+// checkTable returns an error if the table already exists with columns which
+// aren't those of the object type.
+func (o *objectIndexer) checkTable(ctx context.Context, tx *sql.Tx, pgSchema string) error {
+	rows, err := tx.QueryContext(ctx, `SELECT column_name, data_type, is_nullable FROM information_schema.columns
+WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position;`, pgSchema, o.typ.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	i := 0
+	for ; rows.Next(); i++ {
+		var name, dataType, isNullable string
+		if err := rows.Scan(&name, &dataType, &isNullable); err != nil {
+			return err
+		}
+		if i >= len(o.columns) {
+			return fmt.Errorf("table %s has the unexpected column %q", o.table, name)
+		}
+		col := o.columns[i]
+		if name != col.name || dataType != col.dataType || (isNullable == "YES") != col.nullable {
+			return fmt.Errorf("column %q of table %s is incompatible with the %q field of type %s",
+				name, o.table, col.name, col.sqlType)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if i > 0 && i < len(o.columns) {
+		return fmt.Errorf("table %s is missing the column %q", o.table, o.columns[i].name)
+	}
+	return nil
+}
+
+// upsertSQL returns the statement inserting or updating the object of the update,
+// only updating the value fields present if the value is a schema.ValueUpdates, in
+// which case the statement is empty if there is nothing to update.
+func (o *objectIndexer) upsertSQL(update schema.ObjectUpdate) (string, []interface{}, error) {
+	if valueUpdates, ok := update.Value.(schema.ValueUpdates); ok {
+		return o.updateSQL(update.Key, valueUpdates)
+	}
+
+	cols, params, err := o.keyParams(update.Key)
+	if err != nil {
+		return "", nil, err
+	}
+	values, err := fieldValues(o.typ.ValueFields, update.Value)
+	if err != nil {
+		return "", nil, err
+	}
+	var sets []string
+	for i, field := range o.typ.ValueFields {
+		col := o.valueColumns[field.Name]
+		param, err := col.param(values[i])
+		if err != nil {
+			return "", nil, err
+		}
+		cols = append(cols, col)
+		params = append(params, param)
+		name := quoteIdentifier(col.name)
+		sets = append(sets, name+" = EXCLUDED."+name)
+	}
+	if o.retainDeletions {
+		sets = append(sets, quoteIdentifier(deletedColumn)+" = FALSE")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO ")
+	sb.WriteString(o.table)
+	if len(cols) == 0 {
+		sb.WriteString(" DEFAULT VALUES")
+	} else {
+		sb.WriteString(" (")
+		sb.WriteString(columnList(cols))
+		sb.WriteString(") VALUES (")
+		for i := range cols {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("$" + strconv.Itoa(i+1))
+		}
+		sb.WriteString(")")
+	}
+	sb.WriteString(" ON CONFLICT (")
+	sb.WriteString(columnList(o.keyColumns))
+	sb.WriteString(")")
+	if len(sets) == 0 {
+		sb.WriteString(" DO NOTHING;")
+	} else {
+		sb.WriteString(" DO UPDATE SET ")
+		sb.WriteString(strings.Join(sets, ", "))
+		sb.WriteString(";")
+	}
+	return sb.String(), params, nil
+}
+
+// updateSQL returns the statement updating the given fields of an existing object.
+// Unlike an upsert, it doesn't require the values of all the non-null columns.
+func (o *objectIndexer) updateSQL(key interface{}, valueUpdates schema.ValueUpdates) (string, []interface{}, error) {
+	var (
+		sets     []string
+		params   []interface{}
+		fieldErr error
+	)
+	err := valueUpdates.Iterate(func(name string, value interface{}) bool {
+		col, ok := o.valueColumns[name]
+		if !ok {
+			fieldErr = fmt.Errorf("unknown value field %q", name)
+			return false
+		}
+		param, err := col.param(value)
+		if err != nil {
+			fieldErr = err
+			return false
+		}
+		params = append(params, param)
+		sets = append(sets, quoteIdentifier(col.name)+" = $"+strconv.Itoa(len(params)))
+		return true
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if fieldErr != nil {
+		return "", nil, fieldErr
+	}
+	if o.retainDeletions {
+		sets = append(sets, quoteIdentifier(deletedColumn)+" = FALSE")
+	}
+	if len(sets) == 0 {
+		return "", nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("UPDATE ")
+	sb.WriteString(o.table)
+	sb.WriteString(" SET ")
+	sb.WriteString(strings.Join(sets, ", "))
+	where, params, err := o.whereKey(key, params)
+	if err != nil {
+		return "", nil, err
+	}
+	sb.WriteString(where)
+	sb.WriteString(";")
+	return sb.String(), params, nil
+}
+
+// deleteSQL returns the statement deleting the object of the given key, or marking
+// it as deleted if the object type retains deletions.
+func (o *objectIndexer) deleteSQL(key interface{}) (string, []interface{}, error) {
+	var sb strings.Builder
+	if o.retainDeletions {
+		sb.WriteString("UPDATE ")
+		sb.WriteString(o.table)
+		sb.WriteString(" SET ")
+		sb.WriteString(quoteIdentifier(deletedColumn))
+		sb.WriteString(" = TRUE")
+	} else {
+		sb.WriteString("DELETE FROM ")
+		sb.WriteString(o.table)
+	}
+
+	where, params, err := o.whereKey(key, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	sb.WriteString(where)
+	sb.WriteString(";")
+	return sb.String(), params, nil
+}
+
+// whereKey returns the WHERE clause selecting the object of the given key, whose
+// parameters are appended to the given ones. It is empty for the object types
+// without key fields, whose tables have a single row.
+func (o *objectIndexer) whereKey(key interface{}, params []interface{}) (string, []interface{}, error) {
+	cols, keyParams, err := o.keyParams(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sb strings.Builder
+	for i, col := range cols {
+		if i == 0 {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		params = append(params, keyParams[i])
+		sb.WriteString(quoteIdentifier(col.name) + " = $" + strconv.Itoa(len(params)))
+	}
+	return sb.String(), params, nil
+}
+
+// keyParams returns the key columns and the parameters of the given key, none if
+// the object type has no key fields as the singleton column takes its default value.
+func (o *objectIndexer) keyParams(key interface{}) ([]column, []interface{}, error) {
+	if len(o.typ.KeyFields) == 0 {
+		return nil, nil, nil
+	}
+
+	values, err := fieldValues(o.typ.KeyFields, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	params := make([]interface{}, len(values))
+	for i, value := range values {
+		if params[i], err = o.keyColumns[i].param(value); err != nil {
+			return nil, nil, err
+		}
+	}
+	return append([]column(nil), o.keyColumns...), params, nil
+}
+
+// fieldValues splits the value of the fields of an object update, see
+// schema.ObjectUpdate, into the values of each field.
+func fieldValues(fields []schema.Field, value interface{}) ([]interface{}, error) {
+	switch len(fields) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []interface{}{value}, nil
+	default:
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a slice of %d values, got %T", len(fields), value)
+		}
+		if len(values) != len(fields) {
+			return nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+		}
+		return values, nil
+	}
+}
+
+func columnList(cols []column) string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = quoteIdentifier(col.name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// SQLLogger logs the statements executed by the indexer with their parameters.
+type SQLLogger = func(msg, sql string, params ...interface{})
+
+func exec(ctx context.Context, tx *sql.Tx, logger SQLLogger, stmt string, params ...interface{}) error {
+	if logger != nil {
+		logger("exec", stmt, params...)
+	}
+	if _, err := tx.ExecContext(ctx, stmt, params...); err != nil {
+		return fmt.Errorf("failed to execute %q: %v", stmt, err)
+	}
+	return nil
+}
+
+// quoteIdentifier quotes a postgres identifier, such as a schema, table or column name.
+func quoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// qualifiedName returns the quoted name of an object of the given postgres schema.
+func qualifiedName(pgSchema, name string) string {
+	return quoteIdentifier(pgSchema) + "." + quoteIdentifier(name)
+}
+
+// quoteLiteral quotes a postgres string literal.
+func quoteLiteral(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
@@ -0,0 +1,23 @@
+module cosmossdk.io/indexer/postgres/tests
+
+go 1.22
+
+require (
+	cosmossdk.io/indexer/postgres v0.0.0-00010101000000-000000000000
+	cosmossdk.io/schema v0.1.1
+	github.com/fergusstrange/embedded-postgres v1.25.0
+	github.com/lib/pq v1.10.9
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/xi2/xz v0.0.0-20171230120015-48954b6210f8 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace (
+	cosmossdk.io/indexer/postgres => ../.
+	cosmossdk.io/schema => ../../../schema
+)
@@ -27,6 +27,7 @@ type AppBuilder[T transaction.Tx] struct {
 	branch      func(state store.ReaderMap) store.WriterMap
 	txValidator func(ctx context.Context, tx T) error
 	postTxExec  func(ctx context.Context, tx T, success bool) error
+	stfOptions  []stf.Option
 }
 
 // DefaultGenesis returns a default genesis from the registered AppModule's.
@@ -109,6 +110,7 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 		valUpdate,
 		a.postTxExec,
 		a.branch,
+		a.stfOptions...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create STF: %w", err)
@@ -185,3 +187,11 @@ func AppBuilderWithPostTxExec[T transaction.Tx](
 		a.postTxExec = postTxExec
 	}
 }
+
+// AppBuilderWithParallelExecution enables the optimistic parallel execution of block transactions
+// over the provided number of workers. Results are identical to sequential execution.
+func AppBuilderWithParallelExecution[T transaction.Tx](workers int) AppBuilderOption[T] {
+	return func(a *AppBuilder[T]) {
+		a.stfOptions = append(a.stfOptions, stf.WithParallelExecution(workers))
+	}
+}
@@ -0,0 +1,167 @@
+package appdata
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AsyncListenerOptions are the options of AsyncListener.
+type AsyncListenerOptions struct {
+	// BufferSize is the number of packets which can be buffered before the callbacks
+	// of the listener block until the target listener catches up. With no buffer,
+	// the callbacks block until their packet is received by the target goroutine.
+	BufferSize int
+
+	// DoneChan stops the goroutine of the listener when it is closed, after which
+	// the callbacks of the listener return an error.
+	DoneChan <-chan struct{}
+}
+
+// AsyncListener returns a listener which passes the data to the target listener
+// in a goroutine, so that the source doesn't wait for the target to process it,
+// until Commit. The packets are buffered in a channel of the size of the options,
+// which applies backpressure to the source when it is full.
+//
+// Commit waits for the target to process all the data passed before it and
+// returns the first error of the target since the previous commit. After an
+// error, the following data is dropped until the next commit, and the callbacks
+// return the error as soon as possible.
+//
+// The callbacks of the returned listener are set for the callbacks set in the
+// target, and Commit. As ToBytes and ToJSON functions are called by the target
+// goroutine, they must be safe to call concurrently with the source.
+func AsyncListener(listener Listener, opts AsyncListenerOptions) Listener {
+	l := &asyncListener{
+		target:  listener,
+		packets: make(chan Packet, opts.BufferSize),
+		commits: make(chan error),
+		done:    opts.DoneChan,
+	}
+	go l.run()
+
+	async := Listener{Commit: l.commit}
+	if listener.InitializeModuleData != nil {
+		async.InitializeModuleData = func(data ModuleInitializationData) error { return l.send(data) }
+	}
+	if listener.StartBlock != nil {
+		async.StartBlock = func(data StartBlockData) error { return l.send(data) }
+	}
+	if listener.OnTx != nil {
+		async.OnTx = func(data TxData) error { return l.send(data) }
+	}
+	if listener.OnEvent != nil {
+		async.OnEvent = func(data EventData) error { return l.send(data) }
+	}
+	if listener.OnKVPair != nil {
+		async.OnKVPair = func(data KVPairData) error { return l.send(data) }
+	}
+	if listener.OnObjectUpdate != nil {
+		async.OnObjectUpdate = func(data ObjectUpdateData) error { return l.send(data) }
+	}
+	return async
+}
+
+type asyncListener struct {
+	target Listener
+
+	packets chan Packet
+
+	// commits receives the results of the commits.
+	commits chan error
+
+	done <-chan struct{}
+
+	mtx sync.Mutex
+	// err is the first error of the target since the last commit.
+	err error
+}
+
+func (l *asyncListener) run() {
+	for {
+		select {
+		case <-l.done:
+			return
+		case packet := <-l.packets:
+			if _, ok := packet.(CommitData); ok {
+				err := l.getErr()
+				if err == nil {
+					err = l.target.SendPacket(packet)
+				}
+				l.setErr(nil)
+
+				select {
+				case l.commits <- err:
+				case <-l.done:
+					return
+				}
+				continue
+			}
+
+			if l.getErr() != nil {
+				continue
+			}
+			if err := l.target.SendPacket(packet); err != nil {
+				l.setErr(err)
+			}
+		}
+	}
+}
+
+func (l *asyncListener) send(packet Packet) error {
+	if err := l.getErr(); err != nil {
+		return err
+	}
+	if l.stopped() {
+		return errAsyncListenerStopped
+	}
+
+	select {
+	case l.packets <- packet:
+		return nil
+	case <-l.done:
+		return errAsyncListenerStopped
+	}
+}
+
+func (l *asyncListener) commit(data CommitData) error {
+	// unlike the other packets, the commit is sent after an error, so that the
+	// error is reset for the next commit
+	if l.stopped() {
+		return errAsyncListenerStopped
+	}
+	select {
+	case l.packets <- data:
+	case <-l.done:
+		return errAsyncListenerStopped
+	}
+
+	select {
+	case err := <-l.commits:
+		return err
+	case <-l.done:
+		return errAsyncListenerStopped
+	}
+}
+
+func (l *asyncListener) stopped() bool {
+	select {
+	case <-l.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *asyncListener) getErr() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.err
+}
+
+func (l *asyncListener) setErr(err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.err = err
+}
+
+var errAsyncListenerStopped = fmt.Errorf("async listener stopped")
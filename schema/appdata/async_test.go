@@ -0,0 +1,128 @@
+package appdata
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// recordingListener returns a listener recording the heights of the started
+// blocks and the commits, failing the blocks of the given height.
+func recordingListener(failHeight uint64) (Listener, *[]string) {
+	var calls []string
+	return Listener{
+		StartBlock: func(data StartBlockData) error {
+			calls = append(calls, fmt.Sprintf("block %d", data.Height))
+			if data.Height == failHeight {
+				return fmt.Errorf("block %d failed", data.Height)
+			}
+			return nil
+		},
+		Commit: func(CommitData) error {
+			calls = append(calls, "commit")
+			return nil
+		},
+	}, &calls
+}
+
+func TestAsyncListener(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	target, calls := recordingListener(2)
+	listener := AsyncListener(target, AsyncListenerOptions{BufferSize: 2, DoneChan: done})
+	if listener.OnTx != nil || listener.OnKVPair != nil {
+		t.Fatal("expected only the callbacks of the target to be set")
+	}
+
+	if err := listener.StartBlock(StartBlockData{Height: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.Commit(CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	// the commit waits for the data to be processed
+	if expected := []string{"block 1", "commit"}; !reflect.DeepEqual(expected, *calls) {
+		t.Fatalf("expected %v, got %v", expected, *calls)
+	}
+
+	// the errors are returned by the commit, and the data is dropped until then
+	if err := listener.StartBlock(StartBlockData{Height: 2}); err != nil {
+		t.Fatal(err)
+	}
+	_ = listener.StartBlock(StartBlockData{Height: 3})
+	if err := listener.Commit(CommitData{}); err == nil || err.Error() != "block 2 failed" {
+		t.Fatalf("expected the error of the block, got %v", err)
+	}
+	if expected := []string{"block 1", "commit", "block 2"}; !reflect.DeepEqual(expected, *calls) {
+		t.Fatalf("expected %v, got %v", expected, *calls)
+	}
+
+	// the error is reset by the commit
+	if err := listener.StartBlock(StartBlockData{Height: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.Commit(CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"block 1", "commit", "block 2", "block 4", "commit"}; !reflect.DeepEqual(expected, *calls) {
+		t.Fatalf("expected %v, got %v", expected, *calls)
+	}
+}
+
+func TestAsyncListenerBackpressure(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	release := make(chan struct{})
+	started := make(chan uint64, 3)
+	listener := AsyncListener(Listener{
+		StartBlock: func(data StartBlockData) error {
+			started <- data.Height
+			<-release
+			return nil
+		},
+	}, AsyncListenerOptions{BufferSize: 1, DoneChan: done})
+
+	// the first block is processed and the second one is buffered
+	if err := listener.StartBlock(StartBlockData{Height: 1}); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	if err := listener.StartBlock(StartBlockData{Height: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// so the third one blocks until the target catches up
+	sent := make(chan struct{})
+	go func() {
+		_ = listener.StartBlock(StartBlockData{Height: 3})
+		close(sent)
+	}()
+	select {
+	case <-sent:
+		t.Fatal("expected the callback to block while the buffer is full")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	<-sent
+	if err := listener.Commit(CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAsyncListenerDone(t *testing.T) {
+	done := make(chan struct{})
+	target, _ := recordingListener(0)
+	listener := AsyncListener(target, AsyncListenerOptions{DoneChan: done})
+	close(done)
+
+	if err := listener.StartBlock(StartBlockData{Height: 1}); err != errAsyncListenerStopped {
+		t.Fatalf("expected the listener to be stopped, got %v", err)
+	}
+	if err := listener.Commit(CommitData{}); err != errAsyncListenerStopped {
+		t.Fatalf("expected the listener to be stopped, got %v", err)
+	}
+}
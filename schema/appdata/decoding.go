@@ -0,0 +1,146 @@
+package appdata
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/schema"
+)
+
+// DecodingOptions are the options of DecodingMiddleware.
+type DecodingOptions struct {
+	// ModuleFilter, if set, restricts the logical decoding to the modules for which
+	// it returns true.
+	ModuleFilter func(moduleName string) bool
+}
+
+// ModuleCodecs returns the module codecs of the modules, given by module name,
+// which implement schema.HasModuleCodec.
+func ModuleCodecs(modules map[string]interface{}) (map[string]schema.ModuleCodec, error) {
+	codecs := map[string]schema.ModuleCodec{}
+	for name, module := range modules {
+		hasCodec, ok := module.(schema.HasModuleCodec)
+		if !ok {
+			continue
+		}
+
+		cdc, err := hasCodec.ModuleCodec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the module codec of module %s: %v", name, err)
+		}
+		codecs[name] = cdc
+	}
+	return codecs, nil
+}
+
+// DecodingMiddleware returns a listener which does the logical decoding of the
+// modules, given by module name, which implement schema.HasModuleCodec, for the
+// target listener. It calls the InitializeModuleData callback of the target with
+// the schema of each of these modules, in the order of their names, before
+// returning, and decodes the key-value pairs passed to OnKVPair with the
+// KVDecoder of their module into the object updates passed to OnObjectUpdate,
+// after checking that they are valid for the schema of the module.
+// The key-value pairs are still passed to the OnKVPair callback of the target.
+// If the target doesn't listen to object updates, it is returned as is.
+func DecodingMiddleware(target Listener, modules map[string]interface{}, opts DecodingOptions) (Listener, error) {
+	if target.OnObjectUpdate == nil {
+		return target, nil
+	}
+
+	codecs, err := ModuleCodecs(modules)
+	if err != nil {
+		return Listener{}, err
+	}
+
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		if opts.ModuleFilter != nil && !opts.ModuleFilter(name) {
+			delete(codecs, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		moduleSchema := codecs[name].Schema
+		if err := moduleSchema.Validate(); err != nil {
+			return Listener{}, fmt.Errorf("invalid schema of module %s: %v", name, err)
+		}
+		if target.InitializeModuleData != nil {
+			err := target.InitializeModuleData(ModuleInitializationData{ModuleName: name, Schema: moduleSchema})
+			if err != nil {
+				return Listener{}, err
+			}
+		}
+	}
+
+	d := decoder{target: target, codecs: codecs}
+	listener := target
+	listener.InitializeModuleData = d.initializeModuleData
+	listener.OnKVPair = d.onKVPair
+	return listener, nil
+}
+
+type decoder struct {
+	target Listener
+
+	// codecs are the codecs of the modules which are decoded.
+	codecs map[string]schema.ModuleCodec
+}
+
+func (d decoder) initializeModuleData(data ModuleInitializationData) error {
+	// the decoded modules are already initialized
+	if _, ok := d.codecs[data.ModuleName]; ok || d.target.InitializeModuleData == nil {
+		return nil
+	}
+	return d.target.InitializeModuleData(data)
+}
+
+func (d decoder) onKVPair(data KVPairData) error {
+	if d.target.OnKVPair != nil {
+		if err := d.target.OnKVPair(data); err != nil {
+			return err
+		}
+	}
+
+	// the consecutive updates of a module are passed to OnObjectUpdate together
+	var pending ObjectUpdateData
+	flush := func() error {
+		if len(pending.Updates) == 0 {
+			return nil
+		}
+		err := d.target.OnObjectUpdate(pending)
+		pending = ObjectUpdateData{}
+		return err
+	}
+
+	for _, kv := range data.Updates {
+		cdc, ok := d.codecs[kv.ModuleName]
+		if !ok || cdc.KVDecoder == nil {
+			continue
+		}
+
+		updates, err := cdc.KVDecoder(kv.Update)
+		if err != nil {
+			return fmt.Errorf("failed to decode the key-value pair %x of module %s: %v", kv.Update.Key, kv.ModuleName, err)
+		}
+		for _, update := range updates {
+			if err := cdc.Schema.ValidateObjectUpdate(update); err != nil {
+				return fmt.Errorf("invalid object update decoded from the key-value pair %x of module %s: %v", kv.Update.Key, kv.ModuleName, err)
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		if pending.ModuleName != kv.ModuleName {
+			if err := flush(); err != nil {
+				return err
+			}
+			pending.ModuleName = kv.ModuleName
+		}
+		pending.Updates = append(pending.Updates, updates...)
+	}
+	return flush()
+}
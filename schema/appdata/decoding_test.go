@@ -0,0 +1,176 @@
+package appdata
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/schema"
+)
+
+// testModule is a module storing string values under single byte keys.
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: schema.ModuleSchema{ObjectTypes: []schema.ObjectType{{
+			Name:        "values",
+			KeyFields:   []schema.Field{{Name: "key", Kind: schema.Uint8Kind}},
+			ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+		}}},
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			switch {
+			case len(update.Key) != 1:
+				return nil, fmt.Errorf("invalid key %x", update.Key)
+			case update.Key[0] == 0xff:
+				// not an object
+				return nil, nil
+			case update.Delete:
+				return []schema.ObjectUpdate{{TypeName: "values", Key: update.Key[0], Delete: true}}, nil
+			case len(update.Value) == 0:
+				// an invalid object update
+				return []schema.ObjectUpdate{{TypeName: "values", Key: update.Key[0], Value: 1}}, nil
+			default:
+				return []schema.ObjectUpdate{{TypeName: "values", Key: update.Key[0], Value: string(update.Value)}}, nil
+			}
+		},
+	}, nil
+}
+
+type failingModule struct{}
+
+func (failingModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{}, fmt.Errorf("no codec")
+}
+
+func TestDecodingMiddleware(t *testing.T) {
+	var (
+		initialized []string
+		kvPairs     int
+		updates     []ObjectUpdateData
+	)
+	target := Listener{
+		InitializeModuleData: func(data ModuleInitializationData) error {
+			initialized = append(initialized, data.ModuleName)
+			return nil
+		},
+		OnKVPair: func(data KVPairData) error {
+			kvPairs += len(data.Updates)
+			return nil
+		},
+		OnObjectUpdate: func(data ObjectUpdateData) error {
+			updates = append(updates, data)
+			return nil
+		},
+	}
+
+	listener, err := DecodingMiddleware(target, map[string]interface{}{
+		"b":     testModule{},
+		"a":     testModule{},
+		"plain": struct{}{},
+	}, DecodingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string{"a", "b"}, initialized) {
+		t.Fatalf("expected the modules with a codec to be initialized, got %v", initialized)
+	}
+
+	// the initialization of the decoded modules isn't repeated
+	if err := listener.InitializeModuleData(ModuleInitializationData{ModuleName: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.InitializeModuleData(ModuleInitializationData{ModuleName: "plain"}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string{"a", "b", "plain"}, initialized) {
+		t.Fatalf("unexpected initialized modules %v", initialized)
+	}
+
+	err = listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{
+		{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{1}, Value: []byte("one")}},
+		{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{2}, Delete: true}},
+		{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{0xff}, Value: []byte("ignored")}},
+		{ModuleName: "plain", Update: schema.KVPairUpdate{Key: []byte{1, 2}, Value: []byte("raw")}},
+		{ModuleName: "b", Update: schema.KVPairUpdate{Key: []byte{3}, Value: []byte("three")}},
+		{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{4}, Value: []byte("four")}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kvPairs != 6 {
+		t.Fatalf("expected the key-value pairs to be passed to the target, got %d", kvPairs)
+	}
+	expected := []ObjectUpdateData{
+		{ModuleName: "a", Updates: []schema.ObjectUpdate{
+			{TypeName: "values", Key: uint8(1), Value: "one"},
+			{TypeName: "values", Key: uint8(2), Delete: true},
+		}},
+		{ModuleName: "b", Updates: []schema.ObjectUpdate{{TypeName: "values", Key: uint8(3), Value: "three"}}},
+		{ModuleName: "a", Updates: []schema.ObjectUpdate{{TypeName: "values", Key: uint8(4), Value: "four"}}},
+	}
+	if !reflect.DeepEqual(expected, updates) {
+		t.Fatalf("expected updates %v, got %v", expected, updates)
+	}
+
+	// the decoding errors are reported
+	err = listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{1, 2}}}}})
+	if err == nil || !strings.Contains(err.Error(), "failed to decode the key-value pair 0102 of module a") {
+		t.Fatalf("expected a decoding error, got %v", err)
+	}
+
+	// and so are the invalid updates
+	err = listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{{ModuleName: "b", Update: schema.KVPairUpdate{Key: []byte{1}}}}})
+	if err == nil || !strings.Contains(err.Error(), "invalid object update decoded from the key-value pair 01 of module b") {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestDecodingMiddlewareOptions(t *testing.T) {
+	var initialized []string
+	target := Listener{
+		InitializeModuleData: func(data ModuleInitializationData) error {
+			initialized = append(initialized, data.ModuleName)
+			return nil
+		},
+		OnObjectUpdate: func(data ObjectUpdateData) error {
+			if data.ModuleName != "a" {
+				t.Fatalf("unexpected update of module %s", data.ModuleName)
+			}
+			return nil
+		},
+	}
+
+	listener, err := DecodingMiddleware(target, map[string]interface{}{"a": testModule{}, "b": testModule{}}, DecodingOptions{
+		ModuleFilter: func(moduleName string) bool { return moduleName == "a" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string{"a"}, initialized) {
+		t.Fatalf("expected only the filtered modules to be initialized, got %v", initialized)
+	}
+	err = listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{
+		{ModuleName: "a", Update: schema.KVPairUpdate{Key: []byte{1}, Value: []byte("one")}},
+		{ModuleName: "b", Update: schema.KVPairUpdate{Key: []byte{1}, Value: []byte("one")}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the module codec errors are reported
+	_, err = DecodingMiddleware(target, map[string]interface{}{"failing": failingModule{}}, DecodingOptions{})
+	if err == nil || !strings.Contains(err.Error(), "failed to get the module codec of module failing") {
+		t.Fatalf("expected an error, got %v", err)
+	}
+
+	// the targets which don't listen to object updates are returned as is
+	listener, err = DecodingMiddleware(Listener{}, map[string]interface{}{"failing": failingModule{}}, DecodingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listener.OnKVPair != nil {
+		t.Fatal("expected the target to be returned as is")
+	}
+}
@@ -0,0 +1,40 @@
+package appdata
+
+// ModuleFilterListener returns a listener which only passes the module data of
+// the modules for which the filter returns true to the target listener: the
+// InitializeModuleData, OnKVPair and OnObjectUpdate data of the other modules is
+// dropped, while the block, transaction and event data is passed as is.
+func ModuleFilterListener(listener Listener, filter func(moduleName string) bool) Listener {
+	filtered := listener
+	if listener.InitializeModuleData != nil {
+		filtered.InitializeModuleData = func(data ModuleInitializationData) error {
+			if !filter(data.ModuleName) {
+				return nil
+			}
+			return listener.InitializeModuleData(data)
+		}
+	}
+	if listener.OnKVPair != nil {
+		filtered.OnKVPair = func(data KVPairData) error {
+			updates := make([]ModuleKVPairUpdate, 0, len(data.Updates))
+			for _, update := range data.Updates {
+				if filter(update.ModuleName) {
+					updates = append(updates, update)
+				}
+			}
+			if len(updates) == 0 {
+				return nil
+			}
+			return listener.OnKVPair(KVPairData{Updates: updates})
+		}
+	}
+	if listener.OnObjectUpdate != nil {
+		filtered.OnObjectUpdate = func(data ObjectUpdateData) error {
+			if !filter(data.ModuleName) {
+				return nil
+			}
+			return listener.OnObjectUpdate(data)
+		}
+	}
+	return filtered
+}
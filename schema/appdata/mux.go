@@ -0,0 +1,134 @@
+package appdata
+
+// ListenerMux returns a listener which passes the data to all the given listeners,
+// in order. The callbacks of the returned listener are only set if they are set in
+// at least one of the listeners, and return the first error of the listeners.
+// Passing the listeners through AsyncListener lets them process the data
+// concurrently, so that the slowest listener doesn't hold back the others.
+func ListenerMux(listeners ...Listener) Listener {
+	var mux Listener
+
+	var initializeModuleData []func(ModuleInitializationData) error
+	for _, l := range listeners {
+		if l.InitializeModuleData != nil {
+			initializeModuleData = append(initializeModuleData, l.InitializeModuleData)
+		}
+	}
+	if len(initializeModuleData) > 0 {
+		mux.InitializeModuleData = func(data ModuleInitializationData) error {
+			for _, cb := range initializeModuleData {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var startBlock []func(StartBlockData) error
+	for _, l := range listeners {
+		if l.StartBlock != nil {
+			startBlock = append(startBlock, l.StartBlock)
+		}
+	}
+	if len(startBlock) > 0 {
+		mux.StartBlock = func(data StartBlockData) error {
+			for _, cb := range startBlock {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var onTx []func(TxData) error
+	for _, l := range listeners {
+		if l.OnTx != nil {
+			onTx = append(onTx, l.OnTx)
+		}
+	}
+	if len(onTx) > 0 {
+		mux.OnTx = func(data TxData) error {
+			for _, cb := range onTx {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var onEvent []func(EventData) error
+	for _, l := range listeners {
+		if l.OnEvent != nil {
+			onEvent = append(onEvent, l.OnEvent)
+		}
+	}
+	if len(onEvent) > 0 {
+		mux.OnEvent = func(data EventData) error {
+			for _, cb := range onEvent {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var onKVPair []func(KVPairData) error
+	for _, l := range listeners {
+		if l.OnKVPair != nil {
+			onKVPair = append(onKVPair, l.OnKVPair)
+		}
+	}
+	if len(onKVPair) > 0 {
+		mux.OnKVPair = func(data KVPairData) error {
+			for _, cb := range onKVPair {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var onObjectUpdate []func(ObjectUpdateData) error
+	for _, l := range listeners {
+		if l.OnObjectUpdate != nil {
+			onObjectUpdate = append(onObjectUpdate, l.OnObjectUpdate)
+		}
+	}
+	if len(onObjectUpdate) > 0 {
+		mux.OnObjectUpdate = func(data ObjectUpdateData) error {
+			for _, cb := range onObjectUpdate {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var commit []func(CommitData) error
+	for _, l := range listeners {
+		if l.Commit != nil {
+			commit = append(commit, l.Commit)
+		}
+	}
+	if len(commit) > 0 {
+		mux.Commit = func(data CommitData) error {
+			// all the listeners are committed, even if one of them fails, so that
+			// the async listeners are waited for
+			var firstErr error
+			for _, cb := range commit {
+				if err := cb(data); err != nil && firstErr == nil {
+					firstErr = err
+				}
+			}
+			return firstErr
+		}
+	}
+
+	return mux
+}
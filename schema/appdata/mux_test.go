@@ -0,0 +1,112 @@
+package appdata
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestListenerMux(t *testing.T) {
+	var calls []string
+	listener := func(name string, fail bool) Listener {
+		return Listener{
+			OnTx: func(TxData) error {
+				calls = append(calls, name+" tx")
+				if fail {
+					return fmt.Errorf("%s failed", name)
+				}
+				return nil
+			},
+			Commit: func(CommitData) error {
+				calls = append(calls, name+" commit")
+				if fail {
+					return fmt.Errorf("%s failed", name)
+				}
+				return nil
+			},
+		}
+	}
+
+	mux := ListenerMux(listener("a", false), Listener{}, listener("b", false))
+	if mux.OnEvent != nil || mux.StartBlock != nil {
+		t.Fatal("expected only the callbacks of the listeners to be set")
+	}
+	if err := mux.OnTx(TxData{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mux.Commit(CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"a tx", "b tx", "a commit", "b commit"}; !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+
+	// the callbacks stop at the first error, but all the listeners are committed
+	calls = nil
+	mux = ListenerMux(listener("a", true), listener("b", false))
+	if err := mux.OnTx(TxData{}); err == nil || err.Error() != "a failed" {
+		t.Fatalf("expected an error, got %v", err)
+	}
+	if err := mux.Commit(CommitData{}); err == nil || err.Error() != "a failed" {
+		t.Fatalf("expected an error, got %v", err)
+	}
+	if expected := []string{"a tx", "a commit", "b commit"}; !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+}
+
+func TestModuleFilterListener(t *testing.T) {
+	var (
+		initialized []string
+		kvPairs     []KVPairData
+		updates     []string
+		txs         int
+	)
+	listener := ModuleFilterListener(Listener{
+		InitializeModuleData: func(data ModuleInitializationData) error {
+			initialized = append(initialized, data.ModuleName)
+			return nil
+		},
+		OnKVPair: func(data KVPairData) error {
+			kvPairs = append(kvPairs, data)
+			return nil
+		},
+		OnObjectUpdate: func(data ObjectUpdateData) error {
+			updates = append(updates, data.ModuleName)
+			return nil
+		},
+		OnTx: func(TxData) error {
+			txs++
+			return nil
+		},
+	}, func(moduleName string) bool { return moduleName == "bank" })
+
+	for _, module := range []string{"bank", "staking"} {
+		if err := listener.InitializeModuleData(ModuleInitializationData{ModuleName: module}); err != nil {
+			t.Fatal(err)
+		}
+		if err := listener.OnObjectUpdate(ObjectUpdateData{ModuleName: module}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{{ModuleName: "bank"}, {ModuleName: "staking"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.OnKVPair(KVPairData{Updates: []ModuleKVPairUpdate{{ModuleName: "staking"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.OnTx(TxData{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]string{"bank"}, initialized) || !reflect.DeepEqual([]string{"bank"}, updates) {
+		t.Fatalf("expected only the bank module to be passed, got %v and %v", initialized, updates)
+	}
+	if expected := []KVPairData{{Updates: []ModuleKVPairUpdate{{ModuleName: "bank"}}}}; !reflect.DeepEqual(expected, kvPairs) {
+		t.Fatalf("expected %v, got %v", expected, kvPairs)
+	}
+	if txs != 1 {
+		t.Fatal("expected the transactions to be passed")
+	}
+}
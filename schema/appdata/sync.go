@@ -0,0 +1,271 @@
+package appdata
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/schema"
+)
+
+// SyncSource provides the state of the modules at a given version, such as the
+// state storage of store/v2 or a multistore of the version.
+type SyncSource interface {
+	// IterateAllKVPairs calls fn with the key-value pairs of the module at the
+	// version, in key order, until fn returns an error, which is returned.
+	IterateAllKVPairs(version uint64, moduleName string, fn func(key, value []byte) error) error
+}
+
+// SyncOptions are the options of Sync and CatchUpListener.
+type SyncOptions struct {
+	// ModuleFilter, if set, restricts the synchronization to the modules for which
+	// it returns true.
+	ModuleFilter func(moduleName string) bool
+
+	// BatchSize is the maximum number of object updates passed to each OnObjectUpdate
+	// call. It defaults to 1000.
+	BatchSize int
+}
+
+const defaultSyncBatchSize = 1000
+
+// Sync passes the state of the modules, given by module name, which implement
+// schema.HasModuleCodec at the version of the source to the listener, as the
+// object updates decoded by the KVDecoder of their module. The modules are
+// synchronized in the order of their names, after calling the
+// InitializeModuleData callback of the listener with their schema, and the
+// data is committed once all the modules are synchronized.
+func Sync(listener Listener, source SyncSource, version uint64, modules map[string]interface{}, opts SyncOptions) error {
+	if listener.OnObjectUpdate == nil {
+		return nil
+	}
+
+	codecs, err := ModuleCodecs(modules)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		if opts.ModuleFilter != nil && !opts.ModuleFilter(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSyncBatchSize
+	}
+
+	for _, name := range names {
+		cdc := codecs[name]
+		if err := cdc.Schema.Validate(); err != nil {
+			return fmt.Errorf("invalid schema of module %s: %v", name, err)
+		}
+		if listener.InitializeModuleData != nil {
+			err := listener.InitializeModuleData(ModuleInitializationData{ModuleName: name, Schema: cdc.Schema})
+			if err != nil {
+				return err
+			}
+		}
+		if cdc.KVDecoder == nil {
+			continue
+		}
+
+		pending := ObjectUpdateData{ModuleName: name}
+		err := source.IterateAllKVPairs(version, name, func(key, value []byte) error {
+			updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+			if err != nil {
+				return fmt.Errorf("failed to decode the key-value pair %x of module %s: %v", key, name, err)
+			}
+			for _, update := range updates {
+				if err := cdc.Schema.ValidateObjectUpdate(update); err != nil {
+					return fmt.Errorf("invalid object update decoded from the key-value pair %x of module %s: %v", key, name, err)
+				}
+			}
+
+			pending.Updates = append(pending.Updates, updates...)
+			if len(pending.Updates) < batchSize {
+				return nil
+			}
+			err = listener.OnObjectUpdate(pending)
+			pending.Updates = nil
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to synchronize module %s at version %d: %v", name, version, err)
+		}
+		if len(pending.Updates) > 0 {
+			if err := listener.OnObjectUpdate(pending); err != nil {
+				return err
+			}
+		}
+	}
+
+	if listener.Commit != nil {
+		return listener.Commit(CommitData{})
+	}
+	return nil
+}
+
+// CatchUpListener returns a listener which synchronizes the state of the modules
+// with Sync at the version preceding the first block it receives, so that a
+// listener attached to a running node receives the full state of the modules
+// before switching to the live data.
+//
+// The synchronization runs in its own goroutine, so that it doesn't block the
+// source: the data received meanwhile, starting with the first block, is buffered
+// in memory and passed to the listener once the synchronization is committed.
+// If the synchronization fails, the next callback returns the error and it is
+// retried with the next block, at the same version, so that the updates already
+// passed to the listener, which haven't been committed, are passed again and
+// the buffered data still follows the synchronized state. The source must keep
+// the version until the synchronization succeeds.
+//
+// The listener should decode the key-value pairs of the following blocks, for
+// instance with DecodingMiddleware, which doesn't repeat the initialization of
+// the modules it decodes. As the functions of the buffered data, such as ToJSON,
+// are called by the goroutine, they must be safe to call after the callbacks
+// returned.
+func CatchUpListener(listener Listener, source SyncSource, modules map[string]interface{}, opts SyncOptions) Listener {
+	return newCatchUpListener(listener, source, modules, opts).listener()
+}
+
+type catchUpState int
+
+const (
+	catchUpWaiting catchUpState = iota // waiting for the first block
+	catchUpSyncing                     // synchronizing, or retrying with the next block
+	catchUpSynced                      // passing the data to the listener
+)
+
+type catchUpListener struct {
+	target  Listener
+	source  SyncSource
+	modules map[string]interface{}
+	opts    SyncOptions
+
+	mtx   sync.Mutex
+	state catchUpState
+	// running is true while the goroutine synchronizes the state or passes the
+	// buffered data to the listener.
+	running bool
+	// version is the version the state is synchronized at.
+	version uint64
+	// buffered is the data received since the first block, until it is passed to
+	// the listener.
+	buffered []Packet
+	// err is the error of the goroutine, returned by the next callback.
+	err error
+}
+
+func newCatchUpListener(listener Listener, source SyncSource, modules map[string]interface{}, opts SyncOptions) *catchUpListener {
+	return &catchUpListener{
+		target:  listener,
+		source:  source,
+		modules: modules,
+		opts:    opts,
+	}
+}
+
+func (l *catchUpListener) listener() Listener {
+	listener := l.target
+	catchUp := Listener{StartBlock: func(data StartBlockData) error { return l.send(data) }}
+	if listener.InitializeModuleData != nil {
+		catchUp.InitializeModuleData = func(data ModuleInitializationData) error { return l.send(data) }
+	}
+	if listener.OnTx != nil {
+		catchUp.OnTx = func(data TxData) error { return l.send(data) }
+	}
+	if listener.OnEvent != nil {
+		catchUp.OnEvent = func(data EventData) error { return l.send(data) }
+	}
+	if listener.OnKVPair != nil {
+		catchUp.OnKVPair = func(data KVPairData) error { return l.send(data) }
+	}
+	if listener.OnObjectUpdate != nil {
+		catchUp.OnObjectUpdate = func(data ObjectUpdateData) error { return l.send(data) }
+	}
+	if listener.Commit != nil {
+		catchUp.Commit = func(data CommitData) error { return l.send(data) }
+	}
+	return catchUp
+}
+
+func (l *catchUpListener) send(packet Packet) error {
+	l.mtx.Lock()
+	if l.state == catchUpWaiting {
+		block, ok := packet.(StartBlockData)
+		switch {
+		case !ok:
+		case block.Height <= 1:
+			// there is no state to synchronize before the first block
+			l.state = catchUpSynced
+		default:
+			l.state = catchUpSyncing
+			l.version = block.Height - 1
+		}
+	}
+
+	if l.state != catchUpSyncing && !l.running {
+		err := l.err
+		l.err = nil
+		l.mtx.Unlock()
+		if err != nil {
+			return err
+		}
+		return l.target.SendPacket(packet)
+	}
+
+	l.buffered = append(l.buffered, packet)
+	err := l.err
+	l.err = nil
+	if _, ok := packet.(StartBlockData); ok && l.state == catchUpSyncing && !l.running {
+		l.running = true
+		go l.run()
+	}
+	l.mtx.Unlock()
+	return err
+}
+
+// run synchronizes the state, if it isn't synchronized yet, and passes the
+// buffered data to the listener, until there is none left.
+func (l *catchUpListener) run() {
+	l.mtx.Lock()
+	state, version := l.state, l.version
+	l.mtx.Unlock()
+
+	if state == catchUpSyncing {
+		if err := Sync(l.target, l.source, version, l.modules, l.opts); err != nil {
+			l.mtx.Lock()
+			l.err = fmt.Errorf("failed to catch up with the state at version %d: %v", version, err)
+			l.running = false
+			l.mtx.Unlock()
+			return
+		}
+	}
+
+	l.mtx.Lock()
+	l.state = catchUpSynced
+	for len(l.buffered) > 0 {
+		buffered := l.buffered
+		l.buffered = nil
+		l.mtx.Unlock()
+
+		var firstErr error
+		for _, packet := range buffered {
+			if err := l.target.SendPacket(packet); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+
+		l.mtx.Lock()
+		if l.err == nil {
+			l.err = firstErr
+		}
+	}
+	l.running = false
+	l.mtx.Unlock()
+}
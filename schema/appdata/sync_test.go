@@ -0,0 +1,205 @@
+package appdata
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// mapSyncSource is a sync source storing the key-value pairs of the modules by version.
+type mapSyncSource map[uint64]map[string]map[string]string
+
+func (s mapSyncSource) IterateAllKVPairs(version uint64, moduleName string, fn func(key, value []byte) error) error {
+	kvs := s[version][moduleName]
+	keys := make([]string, 0, len(kvs))
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := fn([]byte(key), []byte(kvs[key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestSync(t *testing.T) {
+	var (
+		calls   []string
+		updates []ObjectUpdateData
+	)
+	listener := Listener{
+		InitializeModuleData: func(data ModuleInitializationData) error {
+			calls = append(calls, "initialize "+data.ModuleName)
+			return nil
+		},
+		OnObjectUpdate: func(data ObjectUpdateData) error {
+			calls = append(calls, "update "+data.ModuleName)
+			updates = append(updates, data)
+			return nil
+		},
+		Commit: func(CommitData) error {
+			calls = append(calls, "commit")
+			return nil
+		},
+	}
+	source := mapSyncSource{2: {
+		"a": {"\x01": "one", "\x02": "two", "\x03": "three", "\xff": "ignored"},
+		"b": {"\x01": "one"},
+	}}
+	modules := map[string]interface{}{"a": testModule{}, "b": testModule{}, "plain": struct{}{}}
+
+	if err := Sync(listener, source, 2, modules, SyncOptions{BatchSize: 2}); err != nil {
+		t.Fatal(err)
+	}
+	expectedCalls := []string{"initialize a", "update a", "update a", "initialize b", "update b", "commit"}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Fatalf("expected %v, got %v", expectedCalls, calls)
+	}
+	expected := []ObjectUpdateData{
+		{ModuleName: "a", Updates: []schema.ObjectUpdate{
+			{TypeName: "values", Key: uint8(1), Value: "one"},
+			{TypeName: "values", Key: uint8(2), Value: "two"},
+		}},
+		{ModuleName: "a", Updates: []schema.ObjectUpdate{{TypeName: "values", Key: uint8(3), Value: "three"}}},
+		{ModuleName: "b", Updates: []schema.ObjectUpdate{{TypeName: "values", Key: uint8(1), Value: "one"}}},
+	}
+	if !reflect.DeepEqual(expected, updates) {
+		t.Fatalf("expected updates %v, got %v", expected, updates)
+	}
+
+	// the modules can be filtered
+	calls = nil
+	err := Sync(listener, source, 2, modules, SyncOptions{ModuleFilter: func(name string) bool { return name == "b" }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expectedCalls := []string{"initialize b", "update b", "commit"}; !reflect.DeepEqual(expectedCalls, calls) {
+		t.Fatalf("expected %v, got %v", expectedCalls, calls)
+	}
+
+	// the decoding errors are reported
+	source[3] = map[string]map[string]string{"a": {"\x01\x02": "invalid"}}
+	err = Sync(listener, source, 3, modules, SyncOptions{})
+	if err == nil || !strings.Contains(err.Error(), "failed to synchronize module a at version 3: failed to decode the key-value pair 0102") {
+		t.Fatalf("expected a decoding error, got %v", err)
+	}
+}
+
+// blockingSyncSource is a sync source blocking until it is released.
+type blockingSyncSource struct {
+	SyncSource
+	release chan struct{}
+}
+
+func (s blockingSyncSource) IterateAllKVPairs(version uint64, moduleName string, fn func(key, value []byte) error) error {
+	<-s.release
+	return s.SyncSource.IterateAllKVPairs(version, moduleName, fn)
+}
+
+// wait waits for the goroutine of the listener to be done.
+func (l *catchUpListener) wait(t *testing.T) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		l.mtx.Lock()
+		running := l.running
+		l.mtx.Unlock()
+		if !running {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("the catch-up listener is still running")
+}
+
+func TestCatchUpListener(t *testing.T) {
+	var (
+		mtx   sync.Mutex
+		calls []string
+	)
+	record := func(call string) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		calls = append(calls, call)
+	}
+	getCalls := func() []string {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return append([]string(nil), calls...)
+	}
+	listener := Listener{
+		StartBlock: func(data StartBlockData) error {
+			record(fmt.Sprintf("block %d", data.Height))
+			return nil
+		},
+		OnObjectUpdate: func(data ObjectUpdateData) error {
+			for _, update := range data.Updates {
+				record(fmt.Sprintf("update %v", update.Value))
+			}
+			return nil
+		},
+		Commit: func(CommitData) error {
+			record("commit")
+			return nil
+		},
+	}
+	source := blockingSyncSource{SyncSource: mapSyncSource{4: {"a": {"\x01": "one", "\x01\x02": "invalid"}}}, release: make(chan struct{})}
+	modules := map[string]interface{}{"a": testModule{}}
+	l := newCatchUpListener(listener, source, modules, SyncOptions{BatchSize: 1})
+	catchUp := l.listener()
+
+	// the synchronization doesn't block the source, the data is buffered
+	if err := catchUp.StartBlock(StartBlockData{Height: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := catchUp.Commit(CommitData{}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := getCalls(); len(calls) != 0 {
+		t.Fatalf("expected no calls during the synchronization, got %v", calls)
+	}
+	close(source.release)
+	l.wait(t)
+
+	// the failed synchronization is reported and retried at the same version with
+	// the next block, the updates passed before the failure aren't committed
+	if err := catchUp.StartBlock(StartBlockData{Height: 6}); err == nil || !strings.Contains(err.Error(), "failed to catch up with the state at version 4") {
+		t.Fatalf("expected a synchronization error, got %v", err)
+	}
+	l.wait(t)
+	source.SyncSource.(mapSyncSource)[4]["a"] = map[string]string{"\x01": "one"}
+	if err := catchUp.Commit(CommitData{}); err == nil {
+		t.Fatal("expected a synchronization error")
+	}
+	if err := catchUp.StartBlock(StartBlockData{Height: 7}); err != nil {
+		t.Fatal(err)
+	}
+	l.wait(t)
+	if err := catchUp.StartBlock(StartBlockData{Height: 8}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"update one", "update one", "update one", "commit",
+		"block 5", "commit", "block 6", "commit", "block 7", "block 8",
+	}
+	if calls := getCalls(); !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+
+	// there is nothing to synchronize before the first block
+	calls = nil
+	catchUp = CatchUpListener(listener, source, modules, SyncOptions{})
+	if err := catchUp.StartBlock(StartBlockData{Height: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"block 1"}; !reflect.DeepEqual(expected, getCalls()) {
+		t.Fatalf("expected %v, got %v", expected, getCalls())
+	}
+}
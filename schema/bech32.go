@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The bech32 encoding of the addresses is implemented here, following BIP-173, to
+// keep this module free of any dependency.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// EncodeBech32Address encodes the bytes of the address of a Bech32AddressKind field
+// as a bech32 string with the given human readable prefix.
+func EncodeBech32Address(prefix string, bz []byte) (string, error) {
+	if prefix == "" {
+		return "", fmt.Errorf("missing bech32 prefix")
+	}
+
+	data := convertBits(bz, 8, 5, true)
+	values := append(bech32ExpandPrefix(prefix), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ 1
+
+	var sb strings.Builder
+	sb.Grow(len(prefix) + 1 + len(data) + 6)
+	sb.WriteString(prefix)
+	sb.WriteByte('1')
+	for _, b := range data {
+		sb.WriteByte(bech32Charset[b])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String(), nil
+}
+
+// DecodeBech32Address decodes the bech32 string of the address of a Bech32AddressKind
+// field, which must have the given human readable prefix. The string may be lower or
+// upper case, but not mixed case.
+func DecodeBech32Address(prefix, str string) ([]byte, error) {
+	lower := strings.ToLower(str)
+	if lower != str && strings.ToUpper(str) != str {
+		return nil, fmt.Errorf("invalid bech32 string %q: mixed case", str)
+	}
+	str = lower
+	sep := strings.LastIndexByte(str, '1')
+	if sep < 1 || sep+7 > len(str) {
+		return nil, fmt.Errorf("invalid bech32 string %q", str)
+	}
+	if str[:sep] != prefix {
+		return nil, fmt.Errorf("invalid bech32 prefix %q, expected %q", str[:sep], prefix)
+	}
+
+	values := make([]byte, 0, len(str)-sep-1)
+	for i := sep + 1; i < len(str); i++ {
+		v := strings.IndexByte(bech32Charset, str[i])
+		if v < 0 {
+			return nil, fmt.Errorf("invalid bech32 character %q in %q", str[i], str)
+		}
+		values = append(values, byte(v))
+	}
+	if bech32Polymod(append(bech32ExpandPrefix(prefix), values...)) != 1 {
+		return nil, fmt.Errorf("invalid bech32 checksum of %q", str)
+	}
+
+	data := convertBits(values[:len(values)-6], 5, 8, false)
+	if data == nil {
+		return nil, fmt.Errorf("invalid bech32 padding of %q", str)
+	}
+	return data, nil
+}
+
+// convertBits regroups the bits of the values by groups of fromBits into groups of
+// toBits, padding the last group if pad is set, or returning nil if the padding is
+// invalid otherwise.
+func convertBits(values []byte, fromBits, toBits uint, pad bool) []byte {
+	var (
+		acc  uint32
+		bits uint
+		out  = make([]byte, 0, len(values)*int(fromBits)/int(toBits)+1)
+		max  = uint32(1)<<toBits - 1
+	)
+	for _, v := range values {
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte((acc>>bits)&max))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte((acc<<(toBits-bits))&max))
+		}
+	} else if bits >= fromBits || (acc<<(toBits-bits))&max != 0 {
+		return nil
+	}
+	return out
+}
+
+func bech32ExpandPrefix(prefix string) []byte {
+	expanded := make([]byte, 0, len(prefix)*2+1)
+	for i := 0; i < len(prefix); i++ {
+		expanded = append(expanded, prefix[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(prefix); i++ {
+		expanded = append(expanded, prefix[i]&31)
+	}
+	return expanded
+}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := uint(0); i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"bytes"
+	"testing"
+)
+
+var bech32TestAddress = []byte{
+	0x3e, 0xd4, 0x97, 0xc5, 0x4b, 0xc4, 0x30, 0x26, 0x1b, 0x37,
+	0x6c, 0x16, 0x9b, 0x8a, 0x63, 0x74, 0x8d, 0x5f, 0x6b, 0x90,
+}
+
+func TestEncodeBech32Address(t *testing.T) {
+	addr, err := EncodeBech32Address("cosmos", bech32TestAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv"; addr != expected {
+		t.Fatalf("expected %s, got %s", expected, addr)
+	}
+
+	if _, err := EncodeBech32Address("", []byte{0x1}); err == nil {
+		t.Fatal("expected an error for the missing prefix")
+	}
+}
+
+func TestDecodeBech32Address(t *testing.T) {
+	for _, str := range []string{
+		"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv",
+		"COSMOS18M2F032TCSCZVXEHDSTFHZNRWJX476USKGENVV",
+	} {
+		bz, err := DecodeBech32Address("cosmos", str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(bz, bech32TestAddress) {
+			t.Fatalf("expected %x, got %x", bech32TestAddress, bz)
+		}
+	}
+
+	for str, expected := range map[string]string{
+		"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvw": "checksum",
+		"cosmos18m2f032tcsczvxehdstfhznrwjx476usKGENVV": "mixed case",
+		"osmo18m2f032tcsczvxehdstfhznrwjx476uskgenvv":   "prefix",
+	} {
+		if _, err := DecodeBech32Address("cosmos", str); err == nil {
+			t.Fatalf("expected a %s error for %s", expected, str)
+		}
+	}
+}
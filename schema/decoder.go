@@ -16,6 +16,11 @@ type ModuleCodec struct {
 	// KVDecoder is a function that decodes a key-value pair into an ObjectUpdate.
 	// If it is nil, the module doesn't support state decoding directly.
 	KVDecoder KVDecoder
+
+	// KVEncoder is a function that encodes an ObjectUpdate into key-value pairs, the
+	// inverse of KVDecoder. If it is nil, the module doesn't support importing objects,
+	// such as the genesis rows of the cosmossdk.io/schema/genesis package.
+	KVEncoder KVEncoder
 }
 
 // KVDecoder is a function that decodes a key-value pair into one or more ObjectUpdate's.
@@ -26,6 +31,10 @@ type ModuleCodec struct {
 // were decodable to aid debugging.
 type KVDecoder = func(KVPairUpdate) ([]ObjectUpdate, error)
 
+// KVEncoder is a function that encodes an ObjectUpdate, which isn't a delete, into
+// the key-value pairs storing the object, the inverse of KVDecoder.
+type KVEncoder = func(ObjectUpdate) ([]KVPairUpdate, error)
+
 // KVPairUpdate represents a key-value pair set or delete.
 type KVPairUpdate struct {
 	// Key is the key of the key-value pair.
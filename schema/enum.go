@@ -50,7 +50,7 @@ func (e EnumDefinition) ValidateValue(value string) error {
 
 // checkEnumCompatibility checks that the enum values are consistent across object types and fields.
 func checkEnumCompatibility(enumValueMap map[string]map[string]bool, field Field) error {
-	if field.Kind != EnumKind {
+	if field.definitionKind() != EnumKind {
 		return nil
 	}
 
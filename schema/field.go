@@ -10,6 +10,11 @@ type Field struct {
 	// Kind is the basic type of the field.
 	Kind Kind
 
+	// ElementKind is the kind of the elements of the list and is only valid when Kind is ListKind.
+	// The AddressPrefix, EnumDefinition and StructDefinition of the field then apply to the elements.
+	// Lists of lists are not supported.
+	ElementKind Kind
+
 	// Nullable indicates whether null values are accepted for the field. Key fields CANNOT be nullable.
 	Nullable bool
 
@@ -22,6 +27,9 @@ type Field struct {
 	// the same values for the same enum name. This possibly introduces some duplication of
 	// definitions but makes it easier to reason about correctness and validation in isolation.
 	EnumDefinition EnumDefinition
+
+	// StructDefinition is the definition of the struct type and is only valid when Kind is StructKind.
+	StructDefinition StructDefinition
 }
 
 // Validate validates the field.
@@ -36,28 +44,61 @@ func (c Field) Validate() error {
 		return fmt.Errorf("invalid field kind for %q: %v", c.Name, err)
 	}
 
+	// element kind only valid with ListKind
+	if c.Kind == ListKind {
+		if err := c.ElementKind.Validate(); err != nil {
+			return fmt.Errorf("invalid element kind for %q: %v", c.Name, err)
+		}
+		if c.ElementKind == ListKind {
+			return fmt.Errorf("lists of lists are not supported for field %q", c.Name)
+		}
+	} else if c.ElementKind != InvalidKind {
+		return fmt.Errorf("element kind is only valid for field %q with type ListKind", c.Name)
+	}
+
+	kind := c.definitionKind()
+
 	// address prefix only valid with Bech32AddressKind
-	if c.Kind == Bech32AddressKind && c.AddressPrefix == "" {
+	if kind == Bech32AddressKind && c.AddressPrefix == "" {
 		return fmt.Errorf("missing address prefix for field %q", c.Name)
-	} else if c.Kind != Bech32AddressKind && c.AddressPrefix != "" {
+	} else if kind != Bech32AddressKind && c.AddressPrefix != "" {
 		return fmt.Errorf("address prefix is only valid for field %q with type Bech32AddressKind", c.Name)
 	}
 
 	// enum definition only valid with EnumKind
-	if c.Kind == EnumKind {
+	if kind == EnumKind {
 		if err := c.EnumDefinition.Validate(); err != nil {
 			return fmt.Errorf("invalid enum definition for field %q: %v", c.Name, err)
 		}
-	} else if c.Kind != EnumKind && (c.EnumDefinition.Name != "" || c.EnumDefinition.Values != nil) {
+	} else if c.EnumDefinition.Name != "" || c.EnumDefinition.Values != nil {
 		return fmt.Errorf("enum definition is only valid for field %q with type EnumKind", c.Name)
 	}
 
+	// struct definition only valid with StructKind
+	if kind == StructKind {
+		if err := c.StructDefinition.Validate(); err != nil {
+			return fmt.Errorf("invalid struct definition for field %q: %v", c.Name, err)
+		}
+	} else if c.StructDefinition.Name != "" || c.StructDefinition.Fields != nil {
+		return fmt.Errorf("struct definition is only valid for field %q with type StructKind", c.Name)
+	}
+
 	return nil
 }
 
+// definitionKind returns the kind to which the address prefix, enum and struct
+// definitions of the field apply, the kind of the elements for a list.
+func (c Field) definitionKind() Kind {
+	if c.Kind == ListKind {
+		return c.ElementKind
+	}
+	return c.Kind
+}
+
 // ValidateValue validates that the value conforms to the field's kind and nullability.
 // Unlike Kind.ValidateValue, it also checks that the value conforms to the EnumDefinition
-// if the field is an EnumKind.
+// if the field is an EnumKind, to the StructDefinition if the field is a StructKind and
+// that the elements of ListKind values conform to the ElementKind.
 func (c Field) ValidateValue(value interface{}) error {
 	if value == nil {
 		if !c.Nullable {
@@ -70,8 +111,19 @@ func (c Field) ValidateValue(value interface{}) error {
 		return fmt.Errorf("invalid value for field %q: %v", c.Name, err)
 	}
 
-	if c.Kind == EnumKind {
+	switch c.Kind {
+	case EnumKind:
 		return c.EnumDefinition.ValidateValue(value.(string))
+	case StructKind:
+		return c.StructDefinition.ValidateValue(value.(map[string]interface{}))
+	case ListKind:
+		element := c
+		element.Kind, element.ElementKind, element.Nullable = c.ElementKind, InvalidKind, false
+		for i, v := range value.([]interface{}) {
+			if err := element.ValidateValue(v); err != nil {
+				return fmt.Errorf("invalid element %d: %v", i, err)
+			}
+		}
 	}
 
 	return nil
@@ -77,6 +77,79 @@ func TestField_Validate(t *testing.T) {
 				EnumDefinition: EnumDefinition{Name: "enum", Values: []string{"a", "b"}},
 			},
 		},
+		{
+			name: "valid list",
+			field: Field{
+				Name:          "field1",
+				Kind:          ListKind,
+				ElementKind:   Bech32AddressKind,
+				AddressPrefix: "cosmos",
+			},
+		},
+		{
+			name: "list without element kind",
+			field: Field{
+				Name: "field1",
+				Kind: ListKind,
+			},
+			errContains: "invalid element kind for \"field1\"",
+		},
+		{
+			name: "list of lists",
+			field: Field{
+				Name:        "field1",
+				Kind:        ListKind,
+				ElementKind: ListKind,
+			},
+			errContains: "lists of lists are not supported",
+		},
+		{
+			name: "element kind with non-ListKind",
+			field: Field{
+				Name:        "field1",
+				Kind:        StringKind,
+				ElementKind: StringKind,
+			},
+			errContains: "element kind is only valid for field \"field1\" with type ListKind",
+		},
+		{
+			name: "list of enums without enum definition",
+			field: Field{
+				Name:        "field1",
+				Kind:        ListKind,
+				ElementKind: EnumKind,
+			},
+			errContains: "invalid enum definition",
+		},
+		{
+			name: "valid struct",
+			field: Field{
+				Name: "field1",
+				Kind: StructKind,
+				StructDefinition: StructDefinition{Name: "struct", Fields: []Field{
+					{Name: "a", Kind: StringKind},
+					{Name: "b", Kind: ListKind, ElementKind: CoinKind},
+				}},
+			},
+		},
+		{
+			name: "invalid struct definition",
+			field: Field{
+				Name:             "field1",
+				Kind:             StructKind,
+				StructDefinition: StructDefinition{Name: "struct", Fields: []Field{{Name: "a"}}},
+			},
+			errContains: "invalid struct definition for field \"field1\"",
+		},
+		{
+			name: "struct definition with non-StructKind",
+			field: Field{
+				Name:             "field1",
+				Kind:             StringKind,
+				StructDefinition: StructDefinition{Name: "struct"},
+			},
+			errContains: "struct definition is only valid for field \"field1\" with type StructKind",
+		},
 	}
 
 	for _, tt := range tests {
@@ -162,6 +235,74 @@ func TestField_ValidateValue(t *testing.T) {
 			value:       "c",
 			errContains: "not a valid enum value",
 		},
+		{
+			name: "valid list",
+			field: Field{
+				Name:           "field1",
+				Kind:           ListKind,
+				ElementKind:    EnumKind,
+				EnumDefinition: EnumDefinition{Name: "enum", Values: []string{"a", "b"}},
+			},
+			value: []interface{}{"a", "b", "a"},
+		},
+		{
+			name: "invalid list element",
+			field: Field{
+				Name:           "field1",
+				Kind:           ListKind,
+				ElementKind:    EnumKind,
+				EnumDefinition: EnumDefinition{Name: "enum", Values: []string{"a", "b"}},
+			},
+			value:       []interface{}{"a", "c"},
+			errContains: "invalid element 1",
+		},
+		{
+			name: "null list element",
+			field: Field{
+				Name:        "field1",
+				Kind:        ListKind,
+				ElementKind: StringKind,
+				Nullable:    true,
+			},
+			value:       []interface{}{nil},
+			errContains: "invalid element 0: field \"field1\" cannot be null",
+		},
+		{
+			name: "valid struct",
+			field: Field{
+				Name: "field1",
+				Kind: StructKind,
+				StructDefinition: StructDefinition{Name: "struct", Fields: []Field{
+					{Name: "a", Kind: StringKind},
+					{Name: "b", Kind: CoinKind, Nullable: true},
+				}},
+			},
+			value: map[string]interface{}{"a": "hello"},
+		},
+		{
+			name: "invalid struct field",
+			field: Field{
+				Name: "field1",
+				Kind: StructKind,
+				StructDefinition: StructDefinition{Name: "struct", Fields: []Field{
+					{Name: "a", Kind: StringKind},
+				}},
+			},
+			value:       map[string]interface{}{"a": 1},
+			errContains: "invalid value for struct struct: invalid value for field \"a\"",
+		},
+		{
+			name: "unexpected struct field",
+			field: Field{
+				Name: "field1",
+				Kind: StructKind,
+				StructDefinition: StructDefinition{Name: "struct", Fields: []Field{
+					{Name: "a", Kind: StringKind},
+				}},
+			},
+			value:       map[string]interface{}{"a": "hello", "b": "world"},
+			errContains: "unexpected field \"b\" in value of struct struct",
+		},
 	}
 
 	for _, tt := range tests {
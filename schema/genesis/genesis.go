@@ -0,0 +1,289 @@
+// Package genesis implements the genesis import and export of the modules which
+// implement schema.HasModuleCodec, as the rows of the objects of their module schema.
+//
+// The genesis of a module has a field per object type, named after the object type,
+// whose data is a stream of JSON lines with one JSON object per object, the rows.
+// The objects are exported with the KVDecoder of the module codec and imported with
+// its KVEncoder, so that new modules get a streamable and diffable genesis without
+// any custom code.
+package genesis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/schema"
+)
+
+// Source is a source of genesis data, which returns a reader of the data of the
+// field, or nil if there is no data for the field. It is identical to
+// appmodule.GenesisSource.
+type Source = func(field string) (io.ReadCloser, error)
+
+// Target is a target of genesis data, which returns a writer of the data of the
+// field. It is identical to appmodule.GenesisTarget.
+type Target = func(field string) (io.WriteCloser, error)
+
+// Store is the state of a module, as key-value pairs.
+type Store interface {
+	// IterateAllKVPairs calls fn with the key-value pairs of the module state, in
+	// key order, until fn returns an error, which is returned.
+	IterateAllKVPairs(ctx context.Context, fn func(key, value []byte) error) error
+
+	// Set sets the value of the key in the module state.
+	Set(ctx context.Context, key, value []byte) error
+}
+
+// Handler imports and exports the genesis of a module as the rows of its objects.
+// Its methods match those of appmodule.HasGenesisAuto, which modules can implement
+// by delegating to a Handler, and JSONHandler adapts it to appmodule.HasGenesis.
+//
+// Only the objects of the module schema are imported: the state derived from them
+// which isn't part of the schema, such as secondary indexes, must be rebuilt by the
+// module after InitGenesis, for instance with collections.IndexedMap.RebuildIndexes.
+type Handler struct {
+	codec schema.ModuleCodec
+	store Store
+}
+
+// NewHandler returns the genesis handler of the module, whose module codec must
+// have both a KVDecoder and a KVEncoder, using the given store.
+func NewHandler(module schema.HasModuleCodec, store Store) (Handler, error) {
+	codec, err := module.ModuleCodec()
+	if err != nil {
+		return Handler{}, err
+	}
+	if err := codec.Schema.Validate(); err != nil {
+		return Handler{}, err
+	}
+	if codec.KVDecoder == nil || codec.KVEncoder == nil {
+		return Handler{}, fmt.Errorf("the module codec must have a KVDecoder and a KVEncoder")
+	}
+
+	return Handler{codec: codec, store: store}, nil
+}
+
+// DefaultGenesis writes the default genesis of the module, which has no object.
+func (h Handler) DefaultGenesis(target Target) error {
+	for _, typ := range h.codec.Schema.ObjectTypes {
+		wc, err := target(typ.Name)
+		if err != nil {
+			return err
+		}
+		if err := wc.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateGenesis validates the rows of the genesis of the module, and that their
+// objects can be encoded.
+func (h Handler) ValidateGenesis(source Source) error {
+	return h.readGenesis(source, func(schema.KVPairUpdate) error { return nil })
+}
+
+// InitGenesis imports the rows of the genesis of the module into the store.
+func (h Handler) InitGenesis(ctx context.Context, source Source) error {
+	return h.readGenesis(source, func(pair schema.KVPairUpdate) error {
+		return h.store.Set(ctx, pair.Key, pair.Value)
+	})
+}
+
+func (h Handler) readGenesis(source Source, onKVPair func(schema.KVPairUpdate) error) error {
+	for _, typ := range h.codec.Schema.ObjectTypes {
+		if err := h.readObjects(source, typ, onKVPair); err != nil {
+			return fmt.Errorf("invalid genesis of object type %s: %v", typ.Name, err)
+		}
+	}
+	return nil
+}
+
+func (h Handler) readObjects(source Source, typ schema.ObjectType, onKVPair func(schema.KVPairUpdate) error) error {
+	rc, err := source(typ.Name)
+	if err != nil {
+		return err
+	}
+	if rc == nil {
+		return nil
+	}
+	defer rc.Close()
+
+	decoder := json.NewDecoder(rc)
+	for i := 0; ; i++ {
+		var row map[string]json.RawMessage
+		if err := decoder.Decode(&row); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("invalid row %d: %v", i, err)
+		}
+
+		update, err := rowObjectUpdate(typ, row)
+		if err != nil {
+			return fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		pairs, err := h.codec.KVEncoder(update)
+		if err != nil {
+			return fmt.Errorf("failed to encode row %d: %v", i, err)
+		}
+		for _, pair := range pairs {
+			if pair.Delete {
+				return fmt.Errorf("unexpected deletion encoding row %d", i)
+			}
+			if err := onKVPair(pair); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ExportGenesis exports the objects of the module in the store as the rows of
+// the genesis.
+func (h Handler) ExportGenesis(ctx context.Context, target Target) (err error) {
+	var closers []io.Closer
+	// the writers are flushed and closed once the export is done, returning their
+	// first error unless the export failed
+	defer func() {
+		for _, c := range closers {
+			if closeErr := c.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}()
+
+	writers := make(map[string]*bufio.Writer, len(h.codec.Schema.ObjectTypes))
+	objectTypes := make(map[string]schema.ObjectType, len(h.codec.Schema.ObjectTypes))
+	for _, typ := range h.codec.Schema.ObjectTypes {
+		wc, err := target(typ.Name)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(wc)
+		closers = append(closers, flushCloser{w, wc})
+		writers[typ.Name] = w
+		objectTypes[typ.Name] = typ
+	}
+
+	var buf bytes.Buffer
+	return h.store.IterateAllKVPairs(ctx, func(key, value []byte) error {
+		updates, err := h.codec.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+		if err != nil {
+			return fmt.Errorf("failed to decode the key-value pair %x: %v", key, err)
+		}
+
+		for _, update := range updates {
+			typ, ok := objectTypes[update.TypeName]
+			if !ok {
+				return fmt.Errorf("unknown object type %s decoded from the key-value pair %x", update.TypeName, key)
+			}
+			values, err := objectValues(typ, update)
+			if err != nil {
+				return fmt.Errorf("invalid object decoded from the key-value pair %x: %v", key, err)
+			}
+
+			buf.Reset()
+			if err := writeRow(&buf, objectFields(typ), values); err != nil {
+				return fmt.Errorf("invalid object decoded from the key-value pair %x: %v", key, err)
+			}
+			buf.WriteByte('\n')
+			if _, err := writers[typ.Name].Write(buf.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// flushCloser flushes the buffered writer of a genesis field before closing it.
+type flushCloser struct {
+	w  *bufio.Writer
+	wc io.WriteCloser
+}
+
+func (f flushCloser) Close() error {
+	err := f.w.Flush()
+	if closeErr := f.wc.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// objectFields returns the key fields followed by the value fields of the object type.
+func objectFields(typ schema.ObjectType) []schema.Field {
+	fields := make([]schema.Field, 0, len(typ.KeyFields)+len(typ.ValueFields))
+	fields = append(fields, typ.KeyFields...)
+	return append(fields, typ.ValueFields...)
+}
+
+// objectValues returns the values of the key fields followed by the values of the
+// value fields of the object update.
+func objectValues(typ schema.ObjectType, update schema.ObjectUpdate) ([]interface{}, error) {
+	if err := typ.ValidateObjectUpdate(update); err != nil {
+		return nil, err
+	}
+	values := fieldValues(typ.KeyFields, update.Key)
+
+	valueUpdates, ok := update.Value.(schema.ValueUpdates)
+	if !ok {
+		return append(values, fieldValues(typ.ValueFields, update.Value)...), nil
+	}
+	byName := map[string]interface{}{}
+	err := valueUpdates.Iterate(func(name string, value interface{}) bool {
+		byName[name] = value
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range typ.ValueFields {
+		values = append(values, byName[field.Name])
+	}
+	return values, nil
+}
+
+// fieldValues returns the values of the fields, from the value of an object key
+// or value.
+func fieldValues(fields []schema.Field, value interface{}) []interface{} {
+	switch len(fields) {
+	case 0:
+		return nil
+	case 1:
+		return []interface{}{value}
+	default:
+		return value.([]interface{})
+	}
+}
+
+// rowObjectUpdate returns the object update of the row.
+func rowObjectUpdate(typ schema.ObjectType, row map[string]json.RawMessage) (schema.ObjectUpdate, error) {
+	values, err := readRow(objectFields(typ), row)
+	if err != nil {
+		return schema.ObjectUpdate{}, err
+	}
+
+	update := schema.ObjectUpdate{
+		TypeName: typ.Name,
+		Key:      objectValue(values[:len(typ.KeyFields)]),
+		Value:    objectValue(values[len(typ.KeyFields):]),
+	}
+	if err := typ.ValidateObjectUpdate(update); err != nil {
+		return schema.ObjectUpdate{}, err
+	}
+	return update, nil
+}
+
+// objectValue returns the value of an object key or value from the values of its fields.
+func objectValue(values []interface{}) interface{} {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
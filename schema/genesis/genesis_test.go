@@ -0,0 +1,291 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+var testModuleSchema = schema.ModuleSchema{
+	ObjectTypes: []schema.ObjectType{
+		{
+			Name: "balances",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.Bech32AddressKind, AddressPrefix: "cosmos"},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerStringKind}},
+		},
+		{
+			Name: "params",
+			ValueFields: []schema.Field{
+				{Name: "max_supply", Kind: schema.Uint64Kind},
+				{Name: "period", Kind: schema.DurationKind},
+				{Name: "start", Kind: schema.TimeKind, Nullable: true},
+				{Name: "fees", Kind: schema.ListKind, ElementKind: schema.CoinKind},
+				{
+					Name: "limits",
+					Kind: schema.StructKind,
+					StructDefinition: schema.StructDefinition{
+						Name: "limits",
+						Fields: []schema.Field{
+							{Name: "max_txs", Kind: schema.Int32Kind},
+							{Name: "memo", Kind: schema.StringKind, Nullable: true},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// testModule is a module whose codec stores the objects in memory, by key-value
+// pair key, the key-value pairs only referencing them.
+type testModule struct {
+	objects map[string]schema.ObjectUpdate
+}
+
+func (m testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: testModuleSchema,
+		KVDecoder: func(pair schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			update, ok := m.objects[string(pair.Key)]
+			if !ok {
+				return nil, fmt.Errorf("unknown key %s", pair.Key)
+			}
+			return []schema.ObjectUpdate{update}, nil
+		},
+		KVEncoder: func(update schema.ObjectUpdate) ([]schema.KVPairUpdate, error) {
+			key := fmt.Sprintf("%s/%v", update.TypeName, update.Key)
+			m.objects[key] = update
+			return []schema.KVPairUpdate{{Key: []byte(key), Value: []byte{1}}}, nil
+		},
+	}, nil
+}
+
+// mapStore is an in-memory store.
+type mapStore map[string][]byte
+
+func (s mapStore) IterateAllKVPairs(_ context.Context, fn func(key, value []byte) error) error {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := fn([]byte(key), s[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s mapStore) Set(_ context.Context, key, value []byte) error {
+	s[string(key)] = value
+	return nil
+}
+
+type bufCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *bufCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func mapSource(data map[string]string) Source {
+	return func(field string) (io.ReadCloser, error) {
+		rows, ok := data[field]
+		if !ok {
+			return nil, nil
+		}
+		return ioutil.NopCloser(strings.NewReader(rows)), nil
+	}
+}
+
+func mapTarget(data map[string]*bufCloser) Target {
+	return func(field string) (io.WriteCloser, error) {
+		w := &bufCloser{}
+		data[field] = w
+		return w, nil
+	}
+}
+
+const (
+	testBalances = `{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"100"}
+{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"atom","amount":"5"}
+`
+	testParams = `{"max_supply":"18446744073709551615","period":"1h30m0s","start":null,"fees":[{"denom":"stake","amount":"1"}],"limits":{"max_txs":10,"memo":null}}
+`
+)
+
+func newTestHandler(t *testing.T) (Handler, testModule, mapStore) {
+	t.Helper()
+
+	module := testModule{objects: map[string]schema.ObjectUpdate{}}
+	store := mapStore{}
+	h, err := NewHandler(module, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h, module, store
+}
+
+func TestHandler_InitExportGenesis(t *testing.T) {
+	h, module, store := newTestHandler(t)
+	ctx := context.Background()
+
+	err := h.InitGenesis(ctx, mapSource(map[string]string{"balances": testBalances, "params": testParams}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store) != 3 {
+		t.Fatalf("expected 3 key-value pairs, got %d", len(store))
+	}
+
+	params := module.objects["params/<nil>"].Value.([]interface{})
+	if params[0] != uint64(18446744073709551615) || params[1] != 90*time.Minute {
+		t.Fatalf("unexpected params %v", params)
+	}
+
+	exported := map[string]*bufCloser{}
+	if err := h.ExportGenesis(ctx, mapTarget(exported)); err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 2 || !exported["balances"].closed || !exported["params"].closed {
+		t.Fatalf("expected the fields of the object types to be written and closed, got %v", exported)
+	}
+
+	// the rows are exported in key order
+	expectedBalances := `{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"atom","amount":"5"}
+{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"100"}
+`
+	if balances := exported["balances"].String(); balances != expectedBalances {
+		t.Fatalf("expected balances:\n%s\ngot:\n%s", expectedBalances, balances)
+	}
+	if params := exported["params"].String(); params != testParams {
+		t.Fatalf("expected params:\n%s\ngot:\n%s", testParams, params)
+	}
+}
+
+func TestHandler_ValidateGenesis(t *testing.T) {
+	tests := []struct {
+		name        string
+		balances    string
+		errContains string
+	}{
+		{
+			name:     "valid",
+			balances: testBalances,
+		},
+		{
+			name:        "unexpected field",
+			balances:    `{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"100","foo":1}`,
+			errContains: `invalid row 0: unexpected field "foo"`,
+		},
+		{
+			name:        "invalid address",
+			balances:    testBalances + `{"address":"osmo18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"100"}`,
+			errContains: `invalid row 2: invalid value for field "address"`,
+		},
+		{
+			name:        "invalid value",
+			balances:    `{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"1.5"}`,
+			errContains: "invalid row 0",
+		},
+		{
+			name:        "missing field",
+			balances:    `{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","amount":"100"}`,
+			errContains: "invalid row 0",
+		},
+		{
+			name:        "invalid JSON",
+			balances:    `{"address":`,
+			errContains: "invalid row 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _, store := newTestHandler(t)
+			err := h.ValidateGenesis(mapSource(map[string]string{"balances": tt.balances}))
+			if tt.errContains == "" {
+				if err != nil {
+					t.Fatalf("expected valid genesis, got: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Fatalf("expected error to contain %q, got: %v", tt.errContains, err)
+			}
+			if len(store) != 0 {
+				t.Fatalf("expected the validation to not write to the store")
+			}
+		})
+	}
+}
+
+func TestHandler_DefaultGenesis(t *testing.T) {
+	h, _, _ := newTestHandler(t)
+	target := map[string]*bufCloser{}
+	if err := h.DefaultGenesis(mapTarget(target)); err != nil {
+		t.Fatal(err)
+	}
+	if len(target) != 2 || target["balances"].Len() != 0 || !target["balances"].closed {
+		t.Fatalf("expected empty fields for the object types, got %v", target)
+	}
+	if err := h.ValidateGenesis(mapSource(map[string]string{"balances": "", "params": ""})); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJSONHandler(t *testing.T) {
+	h, module, store := newTestHandler(t)
+	jh := NewJSONHandler(h)
+	ctx := context.Background()
+
+	defaultGenesis := jh.DefaultGenesis()
+	if expected := `{"balances":[],"params":[]}`; string(defaultGenesis) != expected {
+		t.Fatalf("expected %s, got %s", expected, defaultGenesis)
+	}
+	if err := jh.ValidateGenesis(defaultGenesis); err != nil {
+		t.Fatal(err)
+	}
+
+	genesis := `{"balances":[` + strings.Join(strings.Split(strings.TrimSpace(testBalances), "\n"), ",") + `],"params":[` + strings.TrimSpace(testParams) + `]}`
+	if err := jh.ValidateGenesis(json.RawMessage(genesis)); err != nil {
+		t.Fatal(err)
+	}
+	if len(store) != 0 {
+		t.Fatalf("expected the validation to not write to the store")
+	}
+	if err := jh.ValidateGenesis(json.RawMessage(`{"balances":{}}`)); err == nil || !strings.Contains(err.Error(), "invalid rows") {
+		t.Fatalf("expected an invalid rows error, got %v", err)
+	}
+
+	if err := jh.InitGenesis(ctx, json.RawMessage(genesis)); err != nil {
+		t.Fatal(err)
+	}
+	if len(store) != 3 || len(module.objects) != 3 {
+		t.Fatalf("expected 3 objects, got %d", len(store))
+	}
+
+	exported, err := jh.ExportGenesis(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the rows are exported in key order
+	expected := `{"balances":[{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"atom","amount":"5"},{"address":"cosmos18m2f032tcsczvxehdstfhznrwjx476uskgenvv","denom":"stake","amount":"100"}],"params":[` + strings.TrimSpace(testParams) + `]}`
+	if string(exported) != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, exported)
+	}
+}
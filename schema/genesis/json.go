@@ -0,0 +1,274 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// The rows are JSON objects of the fields of the object types, by name and in the
+// order of the object type. The values of the fields are encoded as follows:
+//   - the 64-bit integers as JSON strings, to be decoded without loss of precision,
+//     as the integer and decimal strings,
+//   - the bytes as base64 strings,
+//   - the times as RFC 3339 strings and the durations as Go duration strings,
+//   - the addresses as bech32 strings with the address prefix of their field,
+//   - the lists as JSON arrays and the structs as JSON objects of their fields,
+//   - the coins as JSON objects with denom and amount fields,
+//   - the JSON values as is and the other values as their JSON value.
+
+// writeRow writes the JSON object of the fields with the given values.
+func writeRow(buf *bytes.Buffer, fields []schema.Field, values []interface{}) error {
+	buf.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		if err := writeValue(buf, field, values[i]); err != nil {
+			return fmt.Errorf("invalid value for field %q: %v", field.Name, err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeValue(buf *bytes.Buffer, field schema.Field, value interface{}) error {
+	if value == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	if err := field.Kind.ValidateValueType(value); err != nil {
+		return err
+	}
+
+	var v interface{}
+	switch field.Kind {
+	case schema.Int64Kind:
+		v = strconv.FormatInt(value.(int64), 10)
+	case schema.Uint64Kind:
+		v = strconv.FormatUint(value.(uint64), 10)
+	case schema.TimeKind:
+		v = value.(time.Time).Format(time.RFC3339Nano)
+	case schema.DurationKind:
+		v = value.(time.Duration).String()
+	case schema.Bech32AddressKind:
+		addr, err := schema.EncodeBech32Address(field.AddressPrefix, value.([]byte))
+		if err != nil {
+			return err
+		}
+		v = addr
+	case schema.JSONKind:
+		v = value.(json.RawMessage)
+	case schema.ListKind:
+		elementField := field
+		elementField.Kind, elementField.ElementKind = field.ElementKind, schema.InvalidKind
+		buf.WriteByte('[')
+		for i, element := range value.([]interface{}) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeValue(buf, elementField, element); err != nil {
+				return fmt.Errorf("invalid element %d: %v", i, err)
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case schema.StructKind:
+		fields := field.StructDefinition.Fields
+		structValue := value.(map[string]interface{})
+		values := make([]interface{}, len(fields))
+		for i, structField := range fields {
+			values[i] = structValue[structField.Name]
+		}
+		return writeRow(buf, fields, values)
+	default:
+		v = value
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(bz)
+	return nil
+}
+
+// readRow decodes the values of the fields from the JSON object of a row, the
+// fields which are missing being nil.
+func readRow(fields []schema.Field, row map[string]json.RawMessage) ([]interface{}, error) {
+	for name := range row {
+		if !hasField(fields, name) {
+			return nil, fmt.Errorf("unexpected field %q", name)
+		}
+	}
+
+	values := make([]interface{}, len(fields))
+	for i, field := range fields {
+		value, err := readValue(field, row[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for field %q: %v", field.Name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// readValue decodes the value of the field, which is also checked against the format
+// of its kind, such as the format of the integer strings.
+func readValue(field schema.Field, bz json.RawMessage) (interface{}, error) {
+	if len(bz) == 0 || string(bz) == "null" {
+		return nil, nil
+	}
+
+	value, err := decodeValue(field, bz)
+	if err != nil {
+		return nil, err
+	}
+	if err := field.Kind.ValidateValue(value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func decodeValue(field schema.Field, bz json.RawMessage) (interface{}, error) {
+	switch field.Kind {
+	case schema.StringKind, schema.EnumKind, schema.IntegerStringKind, schema.DecimalStringKind:
+		var s string
+		err := json.Unmarshal(bz, &s)
+		return s, err
+	case schema.BytesKind:
+		var b []byte
+		err := json.Unmarshal(bz, &b)
+		return b, err
+	case schema.Int8Kind:
+		var i int8
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Uint8Kind:
+		var i uint8
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Int16Kind:
+		var i int16
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Uint16Kind:
+		var i uint16
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Int32Kind:
+		var i int32
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Uint32Kind:
+		var i uint32
+		err := json.Unmarshal(bz, &i)
+		return i, err
+	case schema.Int64Kind:
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return nil, err
+		}
+		return strconv.ParseInt(s, 10, 64)
+	case schema.Uint64Kind:
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return nil, err
+		}
+		return strconv.ParseUint(s, 10, 64)
+	case schema.BoolKind:
+		var b bool
+		err := json.Unmarshal(bz, &b)
+		return b, err
+	case schema.TimeKind:
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return nil, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case schema.DurationKind:
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return nil, err
+		}
+		return time.ParseDuration(s)
+	case schema.Float32Kind:
+		var f float32
+		err := json.Unmarshal(bz, &f)
+		return f, err
+	case schema.Float64Kind:
+		var f float64
+		err := json.Unmarshal(bz, &f)
+		return f, err
+	case schema.Bech32AddressKind:
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return nil, err
+		}
+		return schema.DecodeBech32Address(field.AddressPrefix, s)
+	case schema.JSONKind:
+		return json.RawMessage(append([]byte(nil), bz...)), nil
+	case schema.ListKind:
+		var elements []json.RawMessage
+		if err := json.Unmarshal(bz, &elements); err != nil {
+			return nil, err
+		}
+		elementField := field
+		elementField.Kind, elementField.ElementKind = field.ElementKind, schema.InvalidKind
+		values := make([]interface{}, len(elements))
+		for i, element := range elements {
+			value, err := readValue(elementField, element)
+			if err != nil {
+				return nil, fmt.Errorf("invalid element %d: %v", i, err)
+			}
+			values[i] = value
+		}
+		return values, nil
+	case schema.StructKind:
+		var row map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &row); err != nil {
+			return nil, err
+		}
+		fields := field.StructDefinition.Fields
+		values, err := readRow(fields, row)
+		if err != nil {
+			return nil, err
+		}
+		structValue := make(map[string]interface{}, len(fields))
+		for i, structField := range fields {
+			if values[i] != nil {
+				structValue[structField.Name] = values[i]
+			}
+		}
+		return structValue, nil
+	case schema.CoinKind:
+		var coin schema.Coin
+		err := json.Unmarshal(bz, &coin)
+		return coin, err
+	case schema.DecCoinKind:
+		var coin schema.DecCoin
+		err := json.Unmarshal(bz, &coin)
+		return coin, err
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", field.Kind)
+	}
+}
+
+func hasField(fields []schema.Field, name string) bool {
+	for _, field := range fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
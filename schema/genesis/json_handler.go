@@ -0,0 +1,136 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// JSONHandler imports and exports the genesis of a module as a single JSON object,
+// with a field per object type holding the JSON array of the rows of its objects.
+// Its methods match those of appmodule.HasGenesis, which modules can implement
+// by delegating to a JSONHandler.
+type JSONHandler struct {
+	handler Handler
+}
+
+// NewJSONHandler returns the JSON genesis handler of the module, see NewHandler.
+func NewJSONHandler(handler Handler) JSONHandler {
+	return JSONHandler{handler: handler}
+}
+
+// DefaultGenesis returns the default genesis of the module, which has no object.
+func (h JSONHandler) DefaultGenesis() json.RawMessage {
+	bz, err := h.exportRows(func(target Target) error { return h.handler.DefaultGenesis(target) })
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateGenesis validates the rows of the genesis of the module, and that their
+// objects can be encoded.
+func (h JSONHandler) ValidateGenesis(data json.RawMessage) error {
+	source, err := jsonSource(data)
+	if err != nil {
+		return err
+	}
+	return h.handler.ValidateGenesis(source)
+}
+
+// InitGenesis imports the rows of the genesis of the module into the store.
+func (h JSONHandler) InitGenesis(ctx context.Context, data json.RawMessage) error {
+	source, err := jsonSource(data)
+	if err != nil {
+		return err
+	}
+	return h.handler.InitGenesis(ctx, source)
+}
+
+// ExportGenesis exports the objects of the module in the store as the rows of
+// the genesis.
+func (h JSONHandler) ExportGenesis(ctx context.Context) (json.RawMessage, error) {
+	return h.exportRows(func(target Target) error { return h.handler.ExportGenesis(ctx, target) })
+}
+
+// exportRows returns the JSON object of the rows written by export to the target,
+// as JSON lines, with a field per object type of the module.
+func (h JSONHandler) exportRows(export func(Target) error) (json.RawMessage, error) {
+	fields := map[string]*bytes.Buffer{}
+	target := func(field string) (io.WriteCloser, error) {
+		buf := &bytes.Buffer{}
+		fields[field] = buf
+		return nopWriteCloser{buf}, nil
+	}
+	if err := export(target); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, typ := range h.handler.codec.Schema.ObjectTypes {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(typ.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteString(":[")
+		if rows := fields[typ.Name]; rows != nil {
+			// the rows are decoded as the values of JSON fields may span several lines
+			decoder := json.NewDecoder(rows)
+			for j := 0; ; j++ {
+				var row json.RawMessage
+				if err := decoder.Decode(&row); err == io.EOF {
+					break
+				} else if err != nil {
+					return nil, fmt.Errorf("invalid row %d of object type %s: %v", j, typ.Name, err)
+				}
+				if j > 0 {
+					buf.WriteByte(',')
+				}
+				buf.Write(row)
+			}
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonSource returns the source of the rows of the JSON object of a genesis, the
+// fields of the object types holding the JSON arrays of their rows.
+func jsonSource(data json.RawMessage) (Source, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %v", err)
+	}
+
+	return func(field string) (io.ReadCloser, error) {
+		data, ok := fields[field]
+		if !ok {
+			return nil, nil
+		}
+		var rows []json.RawMessage
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, fmt.Errorf("invalid rows: %v", err)
+		}
+		var buf bytes.Buffer
+		for _, row := range rows {
+			buf.Write(row)
+			buf.WriteByte('\n')
+		}
+		return ioutil.NopCloser(&buf), nil
+	}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
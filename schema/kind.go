@@ -85,10 +85,40 @@ const (
 	// JSONKind is a JSON type and values of this type should be of go type json.RawMessage and represent
 	// valid JSON.
 	JSONKind
+
+	// ListKind is a list type and values of this type must be of the go type []interface{}, each element
+	// being a non-null value of the kind set in the ElementKind field of the field definition.
+	ListKind
+
+	// StructKind is a struct type and values of this type must be of the go type map[string]interface{},
+	// mapping the names of the fields of the struct to their values.
+	// Fields of this type are expected to set the StructDefinition field in the field definition to the
+	// struct definition.
+	StructKind
+
+	// CoinKind is a coin type and values of this type must be of the go type Coin, the amount of which
+	// must match the IntegerFormat regex.
+	CoinKind
+
+	// DecCoinKind is a decimal coin type and values of this type must be of the go type DecCoin, the
+	// amount of which must match the DecimalFormat regex.
+	DecCoinKind
 )
 
 // MAX_VALID_KIND is the maximum valid kind value.
-const MAX_VALID_KIND = JSONKind
+const MAX_VALID_KIND = DecCoinKind
+
+// Coin is the value of CoinKind fields, an integer amount of a denomination.
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// DecCoin is the value of DecCoinKind fields, a decimal amount of a denomination.
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
 
 const (
 	// IntegerFormat is a regex that describes the format integer number strings must match. It specifies
@@ -107,7 +137,7 @@ func (t Kind) Validate() error {
 	if t <= InvalidKind {
 		return fmt.Errorf("unknown type: %d", t)
 	}
-	if t > JSONKind {
+	if t > MAX_VALID_KIND {
 		return fmt.Errorf("invalid type: %d", t)
 	}
 	return nil
@@ -156,6 +186,14 @@ func (t Kind) String() string {
 		return "enum"
 	case JSONKind:
 		return "json"
+	case ListKind:
+		return "list"
+	case StructKind:
+		return "struct"
+	case CoinKind:
+		return "coin"
+	case DecCoinKind:
+		return "deccoin"
 	default:
 		return fmt.Sprintf("invalid(%d)", t)
 	}
@@ -269,6 +307,26 @@ func (t Kind) ValidateValueType(value interface{}) error {
 		if !ok {
 			return fmt.Errorf("expected json.RawMessage, got %T", value)
 		}
+	case ListKind:
+		_, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected []interface{}, got %T", value)
+		}
+	case StructKind:
+		_, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected map[string]interface{}, got %T", value)
+		}
+	case CoinKind:
+		_, ok := value.(Coin)
+		if !ok {
+			return fmt.Errorf("expected Coin, got %T", value)
+		}
+	case DecCoinKind:
+		_, ok := value.(DecCoin)
+		if !ok {
+			return fmt.Errorf("expected DecCoin, got %T", value)
+		}
 	default:
 		return fmt.Errorf("invalid type: %d", t)
 	}
@@ -276,8 +334,9 @@ func (t Kind) ValidateValueType(value interface{}) error {
 }
 
 // ValidateValue returns an errContains if the value does not conform to the expected go type and format.
-// It is more thorough, but slower, than Kind.ValidateValueType and validates that Integer, Decimal and JSON
-// values are formatted correctly. It cannot validate enum values because Kind's do not have enum schemas.
+// It is more thorough, but slower, than Kind.ValidateValueType and validates that Integer, Decimal, JSON
+// and coin values are formatted correctly. It cannot validate enum values, list elements and struct fields
+// because Kind's do not have enum, list and struct schemas.
 func (t Kind) ValidateValue(value interface{}) error {
 	err := t.ValidateValueType(value)
 	if err != nil {
@@ -309,6 +368,22 @@ func (t Kind) ValidateValue(value interface{}) error {
 		if !json.Valid(value.(json.RawMessage)) {
 			return fmt.Errorf("expected valid JSON, got %s", value)
 		}
+	case CoinKind:
+		coin := value.(Coin)
+		if coin.Denom == "" {
+			return fmt.Errorf("expected coin denomination, got %v", value)
+		}
+		if !integerRegex.Match([]byte(coin.Amount)) {
+			return fmt.Errorf("expected base10 integer coin amount, got %s", coin.Amount)
+		}
+	case DecCoinKind:
+		coin := value.(DecCoin)
+		if coin.Denom == "" {
+			return fmt.Errorf("expected coin denomination, got %v", value)
+		}
+		if !decimalRegex.Match([]byte(coin.Amount)) {
+			return fmt.Errorf("expected decimal coin amount, got %s", coin.Amount)
+		}
 	default:
 		return nil
 	}
@@ -322,7 +397,7 @@ var (
 
 // KindForGoValue finds the simplest kind that can represent the given go value. It will not, however,
 // return kinds such as IntegerStringKind, DecimalStringKind, Bech32AddressKind, or EnumKind which all can be
-// represented as strings.
+// represented as strings, nor ListKind and StructKind which need the definition of their elements or fields.
 func KindForGoValue(value interface{}) Kind {
 	switch value.(type) {
 	case string:
@@ -357,6 +432,10 @@ func KindForGoValue(value interface{}) Kind {
 		return DurationKind
 	case json.RawMessage:
 		return JSONKind
+	case Coin:
+		return CoinKind
+	case DecCoin:
+		return DecCoinKind
 	default:
 		return InvalidKind
 	}
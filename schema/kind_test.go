@@ -76,6 +76,14 @@ func TestKind_ValidateValueType(t *testing.T) {
 		{kind: Float64Kind, value: float32(1.0), valid: false},
 		{kind: JSONKind, value: json.RawMessage("{}"), valid: true},
 		{kind: JSONKind, value: "hello", valid: false},
+		{kind: ListKind, value: []interface{}{"a", 1}, valid: true},
+		{kind: ListKind, value: []string{"a"}, valid: false},
+		{kind: StructKind, value: map[string]interface{}{"a": 1}, valid: true},
+		{kind: StructKind, value: map[string]string{"a": "b"}, valid: false},
+		{kind: CoinKind, value: Coin{Denom: "stake", Amount: "1"}, valid: true},
+		{kind: CoinKind, value: DecCoin{Denom: "stake", Amount: "1"}, valid: false},
+		{kind: DecCoinKind, value: DecCoin{Denom: "stake", Amount: "1.5"}, valid: true},
+		{kind: DecCoinKind, value: "1.5stake", valid: false},
 		{kind: InvalidKind, value: "hello", valid: false},
 	}
 
@@ -174,6 +182,12 @@ func TestKind_ValidateValue(t *testing.T) {
 		{JSONKind, json.RawMessage(`tru`), false},
 		{JSONKind, json.RawMessage(`[`), false},
 		{JSONKind, json.RawMessage(`{`), false},
+		{CoinKind, Coin{Denom: "stake", Amount: "100"}, true},
+		{CoinKind, Coin{Denom: "stake", Amount: "1.5"}, false},
+		{CoinKind, Coin{Amount: "100"}, false},
+		{DecCoinKind, DecCoin{Denom: "stake", Amount: "1.5"}, true},
+		{DecCoinKind, DecCoin{Denom: "stake", Amount: "abc"}, false},
+		{DecCoinKind, DecCoin{Amount: "1.5"}, false},
 	}
 
 	for i, tt := range tests {
@@ -214,6 +228,10 @@ func TestKind_String(t *testing.T) {
 		{JSONKind, "json"},
 		{EnumKind, "enum"},
 		{Bech32AddressKind, "bech32address"},
+		{ListKind, "list"},
+		{StructKind, "struct"},
+		{CoinKind, "coin"},
+		{DecCoinKind, "deccoin"},
 		{InvalidKind, "invalid(0)"},
 	}
 	for i, tt := range tests {
@@ -246,7 +264,10 @@ func TestKindForGoValue(t *testing.T) {
 		{time.Now(), TimeKind},
 		{time.Second, DurationKind},
 		{json.RawMessage("{}"), JSONKind},
+		{Coin{Denom: "stake", Amount: "1"}, CoinKind},
+		{DecCoin{Denom: "stake", Amount: "1"}, DecCoinKind},
 		{map[string]interface{}{"a": 1}, InvalidKind},
+		{[]interface{}{1}, InvalidKind},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("test %d", i), func(t *testing.T) {
@@ -11,8 +11,9 @@ type ModuleSchema struct {
 // Validate validates the module schema.
 func (s ModuleSchema) Validate() error {
 	enumValueMap := map[string]map[string]bool{}
+	structMap := map[string]StructDefinition{}
 	for _, objType := range s.ObjectTypes {
-		if err := objType.validate(enumValueMap); err != nil {
+		if err := objType.validate(enumValueMap, structMap); err != nil {
 			return err
 		}
 	}
@@ -143,6 +143,84 @@ func TestModuleSchema_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "same struct with different fields",
+			moduleSchema: ModuleSchema{
+				ObjectTypes: []ObjectType{
+					{
+						Name:      "object1",
+						KeyFields: []Field{{Name: "k", Kind: StringKind}},
+						ValueFields: []Field{{
+							Name:             "v",
+							Kind:             StructKind,
+							StructDefinition: StructDefinition{Name: "struct1", Fields: []Field{{Name: "a", Kind: StringKind}}},
+						}},
+					},
+					{
+						Name:      "object2",
+						KeyFields: []Field{{Name: "k", Kind: StringKind}},
+						ValueFields: []Field{{
+							Name:             "v",
+							Kind:             ListKind,
+							ElementKind:      StructKind,
+							StructDefinition: StructDefinition{Name: "struct1", Fields: []Field{{Name: "a", Kind: BytesKind}}},
+						}},
+					},
+				},
+			},
+			errContains: "struct \"struct1\" has different definitions",
+		},
+		{
+			name: "enum of a struct with different values",
+			moduleSchema: ModuleSchema{
+				ObjectTypes: []ObjectType{
+					{
+						Name: "object1",
+						KeyFields: []Field{{
+							Name:           "k",
+							Kind:           EnumKind,
+							EnumDefinition: EnumDefinition{Name: "enum1", Values: []string{"a", "b"}},
+						}},
+						ValueFields: []Field{{
+							Name: "v",
+							Kind: StructKind,
+							StructDefinition: StructDefinition{Name: "struct1", Fields: []Field{{
+								Name:           "e",
+								Kind:           EnumKind,
+								EnumDefinition: EnumDefinition{Name: "enum1", Values: []string{"a", "c"}},
+							}}},
+						}},
+					},
+				},
+			},
+			errContains: "enum \"enum1\" has different values",
+		},
+		{
+			name: "same struct",
+			moduleSchema: ModuleSchema{
+				ObjectTypes: []ObjectType{
+					{
+						Name:      "object1",
+						KeyFields: []Field{{Name: "k", Kind: StringKind}},
+						ValueFields: []Field{{
+							Name:             "v",
+							Kind:             StructKind,
+							StructDefinition: StructDefinition{Name: "struct1", Fields: []Field{{Name: "a", Kind: StringKind}}},
+						}},
+					},
+					{
+						Name:      "object2",
+						KeyFields: []Field{{Name: "k", Kind: StringKind}},
+						ValueFields: []Field{{
+							Name:             "v",
+							Kind:             ListKind,
+							ElementKind:      StructKind,
+							StructDefinition: StructDefinition{Name: "struct1", Fields: []Field{{Name: "a", Kind: StringKind}}},
+						}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
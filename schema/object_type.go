@@ -11,7 +11,8 @@ type ObjectType struct {
 	// KeyFields is a list of fields that make up the primary key of the object.
 	// It can be empty in which case indexers should assume that this object is
 	// a singleton and only has one value. Field names must be unique within the
-	// object between both key and value fields. Key fields CANNOT be nullable.
+	// object between both key and value fields. Key fields CANNOT be nullable,
+	// nor lists or structs.
 	KeyFields []Field
 
 	// ValueFields is a list of fields that are not part of the primary key of the object.
@@ -29,12 +30,12 @@ type ObjectType struct {
 
 // Validate validates the object type.
 func (o ObjectType) Validate() error {
-	return o.validate(map[string]map[string]bool{})
+	return o.validate(map[string]map[string]bool{}, map[string]StructDefinition{})
 }
 
-// validate validates the object type with an enumValueMap that can be
-// shared across a whole module schema.
-func (o ObjectType) validate(enumValueMap map[string]map[string]bool) error {
+// validate validates the object type with an enumValueMap and a structMap that
+// can be shared across a whole module schema.
+func (o ObjectType) validate(enumValueMap map[string]map[string]bool, structMap map[string]StructDefinition) error {
 	if !ValidateName(o.Name) {
 		return fmt.Errorf("invalid object type name %q", o.Name)
 	}
@@ -50,6 +51,10 @@ func (o ObjectType) validate(enumValueMap map[string]map[string]bool) error {
 			return fmt.Errorf("key field %q cannot be nullable", field.Name)
 		}
 
+		if field.Kind == ListKind || field.Kind == StructKind {
+			return fmt.Errorf("key field %q cannot be of kind %s", field.Name, field.Kind)
+		}
+
 		if fieldNames[field.Name] {
 			return fmt.Errorf("duplicate field name %q", field.Name)
 		}
@@ -58,6 +63,10 @@ func (o ObjectType) validate(enumValueMap map[string]map[string]bool) error {
 		if err := checkEnumCompatibility(enumValueMap, field); err != nil {
 			return err
 		}
+
+		if err := checkStructCompatibility(enumValueMap, structMap, field); err != nil {
+			return err
+		}
 	}
 
 	for _, field := range o.ValueFields {
@@ -73,6 +82,10 @@ func (o ObjectType) validate(enumValueMap map[string]map[string]bool) error {
 		if err := checkEnumCompatibility(enumValueMap, field); err != nil {
 			return err
 		}
+
+		if err := checkStructCompatibility(enumValueMap, structMap, field); err != nil {
+			return err
+		}
 	}
 
 	if len(o.KeyFields) == 0 && len(o.ValueFields) == 0 {
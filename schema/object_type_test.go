@@ -162,6 +162,20 @@ func TestObjectType_Validate(t *testing.T) {
 			},
 			errContains: "key field \"field1\" cannot be nullable",
 		},
+		{
+			name: "list key field",
+			objectType: ObjectType{
+				Name: "objectListKey",
+				KeyFields: []Field{
+					{
+						Name:        "field1",
+						Kind:        ListKind,
+						ElementKind: StringKind,
+					},
+				},
+			},
+			errContains: "key field \"field1\" cannot be of kind list",
+		},
 		{
 			name: "duplicate incompatible enum",
 			objectType: ObjectType{
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// StructDefinition represents the definition of a struct type, a nested object without key.
+type StructDefinition struct {
+	// Name is the name of the struct type. It must conform to the NameFormat regular expression.
+	// Like enum types, the same struct type can be used in multiple object types and fields as
+	// long as the definition is identical each time.
+	Name string
+
+	// Fields is the list of fields of the struct type. Field names must be unique within the struct.
+	Fields []Field
+}
+
+// Validate validates the struct definition.
+func (s StructDefinition) Validate() error {
+	if !ValidateName(s.Name) {
+		return fmt.Errorf("invalid struct definition name %q", s.Name)
+	}
+
+	if len(s.Fields) == 0 {
+		return fmt.Errorf("struct definition fields cannot be empty")
+	}
+	seen := make(map[string]bool, len(s.Fields))
+	for _, field := range s.Fields {
+		if err := field.Validate(); err != nil {
+			return fmt.Errorf("invalid field %q of struct %s: %v", field.Name, s.Name, err)
+		}
+
+		if seen[field.Name] {
+			return fmt.Errorf("duplicate field name %q for struct %s", field.Name, s.Name)
+		}
+		seen[field.Name] = true
+	}
+	return nil
+}
+
+// ValidateValue validates that the value conforms to the fields of the struct type.
+// The nullable fields can be omitted from the value.
+func (s StructDefinition) ValidateValue(value map[string]interface{}) error {
+	for _, field := range s.Fields {
+		if err := field.ValidateValue(value[field.Name]); err != nil {
+			return fmt.Errorf("invalid value for struct %s: %v", s.Name, err)
+		}
+	}
+
+	if len(value) > len(s.Fields) {
+		for name := range value {
+			if !s.hasField(name) {
+				return fmt.Errorf("unexpected field %q in value of struct %s", name, s.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (s StructDefinition) hasField(name string) bool {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStructCompatibility checks that the struct definitions are consistent across
+// object types and fields, as well as the enum and struct types of their fields.
+func checkStructCompatibility(enumValueMap map[string]map[string]bool, structMap map[string]StructDefinition, field Field) error {
+	if field.definitionKind() != StructKind {
+		return nil
+	}
+
+	def := field.StructDefinition
+	if existing, ok := structMap[def.Name]; ok {
+		if !reflect.DeepEqual(existing, def) {
+			return fmt.Errorf("struct %q has different definitions in different object types", def.Name)
+		}
+		return nil
+	}
+	structMap[def.Name] = def
+
+	for _, structField := range def.Fields {
+		if err := checkEnumCompatibility(enumValueMap, structField); err != nil {
+			return err
+		}
+		if err := checkStructCompatibility(enumValueMap, structMap, structField); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStructDefinition_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		def         StructDefinition
+		errContains string
+	}{
+		{
+			name: "valid struct",
+			def: StructDefinition{
+				Name: "test",
+				Fields: []Field{
+					{Name: "a", Kind: StringKind},
+					{Name: "b", Kind: StructKind, StructDefinition: StructDefinition{Name: "nested", Fields: []Field{{Name: "c", Kind: CoinKind}}}},
+				},
+			},
+			errContains: "",
+		},
+		{
+			name: "empty name",
+			def: StructDefinition{
+				Name:   "",
+				Fields: []Field{{Name: "a", Kind: StringKind}},
+			},
+			errContains: "invalid struct definition name",
+		},
+		{
+			name: "no fields",
+			def: StructDefinition{
+				Name: "test",
+			},
+			errContains: "struct definition fields cannot be empty",
+		},
+		{
+			name: "invalid field",
+			def: StructDefinition{
+				Name:   "test",
+				Fields: []Field{{Name: "a"}},
+			},
+			errContains: "invalid field \"a\" of struct test",
+		},
+		{
+			name: "invalid nested field",
+			def: StructDefinition{
+				Name: "test",
+				Fields: []Field{
+					{Name: "b", Kind: StructKind, StructDefinition: StructDefinition{Name: "nested", Fields: []Field{{Name: "c", Kind: Bech32AddressKind}}}},
+				},
+			},
+			errContains: "missing address prefix for field \"c\"",
+		},
+		{
+			name: "duplicate field",
+			def: StructDefinition{
+				Name:   "test",
+				Fields: []Field{{Name: "a", Kind: StringKind}, {Name: "a", Kind: BoolKind}},
+			},
+			errContains: "duplicate field name \"a\" for struct test",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("expected valid struct definition, got: %v", err)
+				}
+			} else {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error to contain %q, got: %v", tt.errContains, err)
+				}
+			}
+		})
+	}
+}
@@ -166,7 +166,8 @@ type MempoolConfig struct {
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI    ABCIListenerConfig    `mapstructure:"abci"`
+		AppData AppDataListenerConfig `mapstructure:"appdata"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -174,6 +175,12 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// AppDataListenerConfig defines application configuration for the appdata.Listener streaming service
+	AppDataListenerConfig struct {
+		Enable     bool     `mapstructure:"enable"`
+		Keys       []string `mapstructure:"keys"`
+		BufferSize int      `mapstructure:"buffer-size"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -251,6 +258,9 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			AppData: AppDataListenerConfig{
+				Keys: []string{"*"},
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
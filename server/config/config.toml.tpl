@@ -212,6 +212,22 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.appdata specifies the configuration for streaming blocks, transactions, events
+# and state changes to the appdata.Listener set by the app with baseapp.SetAppDataListener,
+# such as an indexer.
+[streaming.appdata]
+
+# enable specifies whether the data is streamed to the listener of the app.
+enable = {{ .Streaming.AppData.Enable }}
+
+# List of kv store keys whose state changes are streamed to the listener.
+# ["*"] to expose all keys.
+keys = [{{ range .Streaming.AppData.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# buffer-size is the number of packets buffered for the listener, which processes them
+# in its own goroutine until the commit of the block when it is greater than 0.
+buffer-size = {{ .Streaming.AppData.BufferSize }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
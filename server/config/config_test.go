@@ -52,6 +52,11 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			AppData: AppDataListenerConfig{
+				Enable:     true,
+				Keys:       []string{"bank"},
+				BufferSize: 100,
+			},
 		},
 	}
 
@@ -69,6 +74,9 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`enable = true`,
+		`keys = ["bank", ]`,
+		`buffer-size = 100`,
 	}
 
 	for _, line := range expectedLines {
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc/gogoreflection"
+	serverstore "cosmossdk.io/server/v2/store"
 )
 
 type GRPCServer[AppT serverv2.AppI[T], T transaction.Tx] struct {
@@ -47,6 +48,12 @@ func (s *GRPCServer[AppT, T]) Init(appI AppT, v *viper.Viper, logger log.Logger)
 
 	// appI.RegisterGRPCServer(grpcSrv)
 
+	// the progress of the migration of the application store from store/v1 can be
+	// queried while the node is running.
+	if ms, ok := appI.GetStore().(serverstore.MigrationStatusStore); ok {
+		serverstore.RegisterQueryServer(grpcSrv, serverstore.NewQueryServer(ms))
+	}
+
 	// Reflection allows external clients to see what services and methods the gRPC server exposes.
 	gogoreflection.Register(grpcSrv)
 
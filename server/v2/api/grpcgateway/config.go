@@ -3,14 +3,45 @@ package grpcgateway
 func DefaultConfig() *Config {
 	return &Config{
 		Enable: true,
+		// DefaultAddress defines the default address to bind the gRPC-gateway server to.
+		Address:          "localhost:1317",
+		Swagger:          false,
+		EnableUnsafeCORS: false,
+		// DefaultReadTimeout defines the default read timeout in seconds.
+		ReadTimeout: 10,
+		// DefaultWriteTimeout defines the default write timeout in seconds.
+		WriteTimeout: 10,
+		// DefaultMaxBodyBytes defines the default maximum size in bytes of a request body.
+		MaxBodyBytes: 1024 * 1024,
 	}
 }
 
+// Config defines configuration for the gRPC-gateway server.
 type Config struct {
 	// Enable defines if the gRPC-gateway should be enabled.
 	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the gRPC-gateway should be enabled."`
+
+	// Address defines the gRPC-gateway server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the gRPC-gateway server address to bind to."`
+
+	// Swagger defines if swagger documentation should automatically be registered.
+	Swagger bool `mapstructure:"swagger" toml:"swagger" comment:"Swagger defines if swagger documentation should automatically be registered."`
+
+	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors" toml:"enabled-unsafe-cors" comment:"EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)."`
+
+	// ReadTimeout defines the gRPC-gateway server read timeout (in seconds).
+	ReadTimeout uint `mapstructure:"read-timeout" toml:"read-timeout" comment:"ReadTimeout defines the gRPC-gateway server read timeout (in seconds)."`
+
+	// WriteTimeout defines the gRPC-gateway server write timeout (in seconds).
+	WriteTimeout uint `mapstructure:"write-timeout" toml:"write-timeout" comment:"WriteTimeout defines the gRPC-gateway server write timeout (in seconds)."`
+
+	// MaxBodyBytes defines the maximum size in bytes of a request body.
+	// Requests with a larger body are rejected.
+	MaxBodyBytes int64 `mapstructure:"max-body-bytes" toml:"max-body-bytes" comment:"MaxBodyBytes defines the maximum size in bytes of a request body."`
 }
 
+// CfgOption is a function that allows to overwrite the default server configuration.
 type CfgOption func(*Config)
 
 // OverwriteDefaultConfig overwrites the default config with the new config.
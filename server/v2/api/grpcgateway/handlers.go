@@ -0,0 +1,97 @@
+package grpcgateway
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// RegisterHandlersFn registers on mux the generated gRPC-gateway handlers of a
+// query service, the handlers running their queries through conn.
+type RegisterHandlersFn func(ctx context.Context, mux *runtime.ServeMux, conn gogogrpc.ClientConn) error
+
+// QueryHandlers returns the RegisterHandlersFn of the handlers generated in a
+// query.pb.gw.go file, for instance:
+//
+//	grpcgateway.QueryHandlers(banktypes.RegisterQueryHandlerClient, banktypes.NewQueryClient)
+func QueryHandlers[C any](
+	registerHandlerClient func(context.Context, *runtime.ServeMux, C) error,
+	newClient func(gogogrpc.ClientConn) C,
+) RegisterHandlersFn {
+	return func(ctx context.Context, mux *runtime.ServeMux, conn gogogrpc.ClientConn) error {
+		return registerHandlerClient(ctx, mux, newClient(conn))
+	}
+}
+
+// queryFunc executes a query against the application state at the given height.
+// A height of 0 means the latest available state.
+type queryFunc func(ctx context.Context, height uint64, req transaction.Msg) (transaction.Msg, error)
+
+var _ gogogrpc.ClientConn = queryConn{}
+
+// queryConn is the client connection of the gRPC-gateway handlers. It executes
+// the queries directly against the application state instead of going through
+// the gRPC server.
+type queryConn struct {
+	query queryFunc
+}
+
+// Invoke implements gogogrpc.ClientConn.
+func (c queryConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	req, ok := args.(gogoproto.Message)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "%s: invalid request type %T", method, args)
+	}
+
+	height, err := blockHeightFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.query(ctx, height, req)
+	if err != nil {
+		return err
+	}
+
+	// the response is copied into reply, gogoproto.Merge doesn't support the
+	// custom types (like math.Int) of the responses.
+	if reflect.TypeOf(resp) != reflect.TypeOf(reply) {
+		return status.Errorf(codes.Internal, "%s: expected response type %T, got %T", method, reply, resp)
+	}
+	reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(resp).Elem())
+
+	return nil
+}
+
+// NewStream implements gogogrpc.ClientConn.
+func (queryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming is not supported by the gRPC-gateway")
+}
+
+// blockHeightFromContext returns the height requested through the GRPCBlockHeightHeader
+// header, which the gRPC-gateway forwards as outgoing metadata, or 0 when the
+// header is not set.
+func blockHeightFromContext(ctx context.Context) (uint64, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	heights := md.Get(GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseUint(heights[0], 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid height header %q: %v", GRPCBlockHeightHeader, err)
+	}
+
+	return height, nil
+}
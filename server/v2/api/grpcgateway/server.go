@@ -2,9 +2,13 @@ package grpcgateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	gateway "github.com/cosmos/gogogateway"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -25,6 +29,9 @@ var _ serverv2.ServerComponent[
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// swaggerPath is the path under which the swagger documentation is served.
+	swaggerPath = "/swagger/"
 )
 
 type GRPCGatewayServer[AppT serverv2.AppI[T], T transaction.Tx] struct {
@@ -32,6 +39,10 @@ type GRPCGatewayServer[AppT serverv2.AppI[T], T transaction.Tx] struct {
 	config     *Config
 	cfgOptions []CfgOption
 
+	server    *http.Server
+	swaggerFS fs.FS
+	handlers  []RegisterHandlersFn
+
 	GRPCSrv           *grpc.Server
 	GRPCGatewayRouter *runtime.ServeMux
 }
@@ -91,21 +102,76 @@ func (s *GRPCGatewayServer[AppT, T]) Init(appI AppT, v *viper.Viper, logger log.
 		}
 	}
 
-	// Register the gRPC-Gateway server.
-	// appI.RegisterGRPCGatewayRoutes(s.GRPCGatewayRouter, s.GRPCSrv)
-
-	s.logger = logger
+	s.logger = logger.With(log.ModuleKey, s.Name())
 	s.config = cfg
 
+	// Register the gRPC-gateway handlers. Queries are executed directly against
+	// the application state instead of going through the gRPC server.
+	conn := queryConn{query: appI.GetAppManager().Query}
+	for _, register := range s.handlers {
+		if err := register(context.Background(), s.GRPCGatewayRouter, conn); err != nil {
+			return fmt.Errorf("failed to register gRPC-gateway handlers: %w", err)
+		}
+	}
+
+	router := http.NewServeMux()
+	if cfg.Swagger {
+		if s.swaggerFS == nil {
+			s.logger.Warn("swagger is enabled but no swagger documentation was provided")
+		} else {
+			router.Handle(swaggerPath, http.StripPrefix(swaggerPath, http.FileServer(http.FS(s.swaggerFS))))
+		}
+	}
+	router.Handle("/", s.GRPCGatewayRouter)
+
+	var handler http.Handler = router
+	if cfg.MaxBodyBytes > 0 {
+		handler = maxBodyBytesHandler(handler, cfg.MaxBodyBytes)
+	}
+	if cfg.EnableUnsafeCORS {
+		handler = corsHandler(handler)
+	}
+
+	s.server = &http.Server{
+		Addr:              cfg.Address,
+		Handler:           handler,
+		ReadHeaderTimeout: time.Duration(cfg.ReadTimeout) * time.Second,
+		ReadTimeout:       time.Duration(cfg.ReadTimeout) * time.Second,
+		WriteTimeout:      time.Duration(cfg.WriteTimeout) * time.Second,
+	}
+
 	return nil
 }
 
+// RegisterHandlers adds the gRPC-gateway handlers registered when the server is
+// initialized, usually the ones of the query services of the application modules.
+// It must be called before Init.
+func (s *GRPCGatewayServer[AppT, T]) RegisterHandlers(fns ...RegisterHandlersFn) {
+	s.handlers = append(s.handlers, fns...)
+}
+
+// SetSwaggerFS sets the file system holding the swagger documentation served
+// under /swagger/ when swagger is enabled in the configuration.
+// It must be called before Init.
+func (s *GRPCGatewayServer[AppT, T]) SetSwaggerFS(fsys fs.FS) {
+	s.swaggerFS = fsys
+}
+
 func (s *GRPCGatewayServer[AppT, T]) Start(ctx context.Context) error {
 	if !s.config.Enable {
 		return nil
 	}
 
-	// TODO start a normal Go http server (and do not leverage comet's like https://github.com/cosmos/cosmos-sdk/blob/9df6019de6ee7999fe9864bac836deb2f36dd44a/server/api/server.go#L98)
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting gRPC-gateway server...", "address", s.config.Address)
+	if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start gRPC-gateway server", "err", err)
+		return err
+	}
 
 	return nil
 }
@@ -115,7 +181,8 @@ func (s *GRPCGatewayServer[AppT, T]) Stop(ctx context.Context) error {
 		return nil
 	}
 
-	return nil
+	s.logger.Info("stopping gRPC-gateway server...", "address", s.config.Address)
+	return s.server.Shutdown(ctx)
 }
 
 // Register implements registers a grpc-gateway server
@@ -0,0 +1,117 @@
+package grpcgateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	serverv2 "cosmossdk.io/server/v2"
+	serverstore "cosmossdk.io/server/v2/store"
+)
+
+func TestQueryHandlers(t *testing.T) {
+	srv := New[serverv2.AppI[transaction.Tx], transaction.Tx](nil, nil)
+
+	var height uint64
+	conn := queryConn{query: func(_ context.Context, h uint64, req transaction.Msg) (transaction.Msg, error) {
+		if _, ok := req.(*serverstore.QueryMigrationStatusRequest); !ok {
+			return nil, fmt.Errorf("unexpected request %T", req)
+		}
+		height = h
+		return &serverstore.QueryMigrationStatusResponse{Migrating: true, Phase: "migrating", Height: 10}, nil
+	}}
+	register := QueryHandlers(serverstore.RegisterQueryHandlerClient, serverstore.NewQueryClient)
+	require.NoError(t, register(context.Background(), srv.GRPCGatewayRouter, conn))
+
+	handler := corsHandler(maxBodyBytesHandler(srv.GRPCGatewayRouter, 64))
+
+	const statusPath = "/cosmos/store/migration/v1/status"
+	expBody := `{"migrating":true,"phase":"migrating","height":"10","migrated_version":"0","stores_total":"0","stores_done":"0","keys_total":"0","keys_done":"0","percent":0,"eta":"0s","bytes_written":"0","stores":[],"started_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`
+
+	testCases := []struct {
+		name       string
+		req        *http.Request
+		height     string
+		expCode    int
+		expBody    string
+		expHeight  uint64
+		expHeaders map[string]string
+	}{
+		{
+			name:    "query",
+			req:     httptest.NewRequest(http.MethodGet, statusPath, nil),
+			expCode: http.StatusOK,
+			expBody: expBody,
+		},
+		{
+			name:      "height header",
+			req:       httptest.NewRequest(http.MethodGet, statusPath, nil),
+			height:    "12",
+			expCode:   http.StatusOK,
+			expBody:   expBody,
+			expHeight: 12,
+		},
+		{
+			name:    "invalid height header",
+			req:     httptest.NewRequest(http.MethodGet, statusPath, nil),
+			height:  "twelve",
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name:    "body too large",
+			req:     httptest.NewRequest(http.MethodPost, statusPath, strings.NewReader(strings.Repeat("a", 65))),
+			expCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "unknown route",
+			req:     httptest.NewRequest(http.MethodGet, "/test/v1/unknown", nil),
+			expCode: http.StatusNotImplemented,
+		},
+		{
+			name: "cors preflight",
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodOptions, statusPath, nil)
+				req.Header.Set("Origin", "http://example.com")
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				return req
+			}(),
+			expCode: http.StatusOK,
+			expHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "*",
+				"Access-Control-Allow-Headers": "Content-Type, " + GRPCBlockHeightHeader,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height = 0
+			if tc.height != "" {
+				tc.req.Header.Set(GRPCBlockHeightHeader, tc.height)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, tc.req)
+			resp := rec.Result()
+			defer resp.Body.Close()
+
+			require.Equal(t, tc.expCode, resp.StatusCode)
+			if tc.expBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.expBody, string(body))
+			}
+			require.Equal(t, tc.expHeight, height)
+			for k, v := range tc.expHeaders {
+				require.Equal(t, v, resp.Header.Get(k))
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
 	"cosmossdk.io/server/v2/cometbft/handlers"
@@ -37,6 +38,7 @@ type Consensus[T transaction.Tx] struct {
 	logger          log.Logger
 	txCodec         transaction.Codec[T]
 	streaming       streaming.Manager
+	appDataListener *appdata.Listener
 	snapshotManager *snapshots.Manager
 	mempool         mempool.Mempool[T]
 
@@ -44,6 +46,8 @@ type Consensus[T transaction.Tx] struct {
 	// otherwise it will be empty and we will need to query the app for the last
 	// committed block.
 	lastCommittedHeight atomic.Int64
+	// rechecking is set while the app-side mempool is re-checked after a commit.
+	rechecking atomic.Bool
 
 	prepareProposalHandler handlers.PrepareHandler[T]
 	processProposalHandler handlers.ProcessHandler[T]
@@ -75,6 +79,12 @@ func (c *Consensus[T]) SetStreamingManager(sm streaming.Manager) {
 	c.streaming = sm
 }
 
+// SetAppDataListener sets the listener receiving the blocks, transactions, events
+// and state changes of the finalized blocks.
+func (c *Consensus[T]) SetAppDataListener(listener appdata.Listener) {
+	c.appDataListener = &listener
+}
+
 // SetSnapshotManager sets the snapshot manager for the Consensus.
 // The snapshot manager is responsible for managing snapshots of the Consensus state.
 // It allows for creating, storing, and restoring snapshots of the Consensus state.
@@ -117,6 +127,20 @@ func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxReques
 	if resp.Error != nil {
 		cometResp.Code = 1
 		cometResp.Log = resp.Error.Error()
+		// a tx which is no longer valid must be evicted from the app-side mempool.
+		if req.Type == abciproto.CHECK_TX_TYPE_RECHECK {
+			if err := c.mempool.Remove([]T{decodedTx}); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return nil, fmt.Errorf("unable to remove tx: %w", err)
+			}
+		}
+		return cometResp, nil
+	}
+
+	if req.Type == abciproto.CHECK_TX_TYPE_CHECK {
+		if err := c.mempool.Insert(ctx, decodedTx); err != nil {
+			cometResp.Code = 1
+			cometResp.Log = fmt.Sprintf("unable to insert tx in the mempool: %s", err)
+		}
 	}
 	return cometResp, nil
 }
@@ -472,6 +496,12 @@ func (c *Consensus[T]) FinalizeBlock(
 		return nil, err
 	}
 
+	if c.appDataListener != nil {
+		if err := c.streamAppData(req, resp, stateChanges); err != nil {
+			c.logger.Error("appdata listener failed", "height", req.Height, "err", err)
+		}
+	}
+
 	// remove txs from the mempool
 	err = c.mempool.Remove(decodedTxs)
 	if err != nil {
@@ -495,6 +525,9 @@ func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (
 
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
 
+	// re-check the app-side mempool against the newly committed state.
+	c.recheckMempool(lastCommittedHeight)
+
 	cp, err := c.GetConsensusParams(ctx)
 	if err != nil {
 		return nil, err
@@ -505,6 +538,37 @@ func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (
 	}, nil
 }
 
+// recheckMempool re-checks in the background the app-side mempool against the
+// latest committed state, evicting the txs which became invalid, so that it doesn't
+// delay the commit. It returns a channel closed once the recheck is done, or nil if
+// the mempool doesn't support rechecks or a recheck is still running, in which case
+// the txs are re-checked after the next commit.
+// Failures are logged and don't fail the commit.
+func (c *Consensus[T]) recheckMempool(height int64) <-chan struct{} {
+	rechecker, ok := c.mempool.(mempool.Rechecker[T])
+	if !ok || !c.rechecking.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer c.rechecking.Store(false)
+
+		err := rechecker.Recheck(context.Background(), func(ctx context.Context, tx T) error {
+			resp, err := c.app.ValidateTx(ctx, tx)
+			if err != nil {
+				return err
+			}
+			return resp.Error
+		})
+		if err != nil {
+			c.logger.Error("failed to recheck mempool", "height", height, "err", err)
+		}
+	}()
+	return done
+}
+
 // Vote extensions
 // VerifyVoteExtension implements types.Application.
 func (c *Consensus[T]) VerifyVoteExtension(
@@ -0,0 +1,139 @@
+package cometbft
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+type testTx struct {
+	Sender string `json:"sender"`
+	Nonce  uint64 `json:"nonce"`
+}
+
+func (t testTx) Hash() [32]byte                              { return sha256.Sum256(t.Bytes()) }
+func (t testTx) GetMessages() ([]transaction.Msg, error)     { return nil, nil }
+func (t testTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (t testTx) GetGasLimit() (uint64, error)                { return 0, nil }
+
+func (t testTx) Bytes() []byte {
+	bz, err := json.Marshal(t)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+type testTxCodec struct{}
+
+func (testTxCodec) Decode(bz []byte) (testTx, error) {
+	var tx testTx
+	err := json.Unmarshal(bz, &tx)
+	return tx, err
+}
+
+func (c testTxCodec) DecodeJSON(bz []byte) (testTx, error) { return c.Decode(bz) }
+
+// testSTF validates the txs with the validate function, if set.
+type testSTF struct {
+	validate func(tx testTx) error
+}
+
+func (s *testSTF) DeliverBlock(context.Context, *coreappmgr.BlockRequest[testTx], store.ReaderMap) (*coreappmgr.BlockResponse, store.WriterMap, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (s *testSTF) ValidateTx(_ context.Context, _ store.ReaderMap, _ uint64, tx testTx) coreappmgr.TxResult {
+	if s.validate == nil {
+		return coreappmgr.TxResult{}
+	}
+	return coreappmgr.TxResult{Error: s.validate(tx)}
+}
+
+func (s *testSTF) Simulate(context.Context, store.ReaderMap, uint64, testTx) (coreappmgr.TxResult, store.WriterMap) {
+	return coreappmgr.TxResult{}, nil
+}
+
+func (s *testSTF) Query(context.Context, store.ReaderMap, uint64, transaction.Msg) (transaction.Msg, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *testSTF) RunWithCtx(context.Context, store.ReaderMap, func(ctx context.Context) error) (store.WriterMap, error) {
+	return nil, errors.New("not implemented")
+}
+
+type testStore struct{}
+
+func (testStore) StateLatest() (uint64, store.ReaderMap, error) { return 1, nil, nil }
+func (testStore) StateAt(uint64) (store.ReaderMap, error)       { return nil, nil }
+
+func newTestConsensus(t *testing.T, stf *testSTF, mpCfg mempool.Config) (*Consensus[testTx], *mempool.PriorityNonceMempool[testTx, int64]) {
+	t.Helper()
+	app, err := appmanager.Builder[testTx]{STF: stf, DB: testStore{}}.Build()
+	require.NoError(t, err)
+	mp, err := mempool.NewPriorityNonceMempool(mempool.PriorityNonceMempoolConfig[testTx, int64]{
+		Config: mpCfg,
+		TxPriority: mempool.TxPriority[testTx, int64]{
+			GetTxPriority: func(context.Context, testTx) (int64, error) { return 0, nil },
+			Compare:       func(a, b int64) int { return int(a - b) },
+		},
+		SenderNonce: func(tx testTx) ([]byte, uint64, error) { return []byte(tx.Sender), tx.Nonce, nil },
+	})
+	require.NoError(t, err)
+	return NewConsensus[testTx](app, mp, nil, Config{}, testTxCodec{}, log.NewNopLogger()), mp
+}
+
+func TestCheckTxMempoolDisabled(t *testing.T) {
+	c, mp := newTestConsensus(t, &testSTF{}, mempool.Config{MaxTxs: -1})
+
+	resp, err := c.CheckTx(context.Background(), &abciproto.CheckTxRequest{
+		Tx:   testTx{Sender: "a"}.Bytes(),
+		Type: abciproto.CHECK_TX_TYPE_CHECK,
+	})
+	require.NoError(t, err)
+	require.Zero(t, resp.Code, resp.Log)
+	require.Zero(t, mp.CountTx())
+}
+
+func TestRecheckMempool(t *testing.T) {
+	stf := &testSTF{}
+	c, mp := newTestConsensus(t, stf, mempool.Config{})
+	ctx := context.Background()
+
+	for _, tx := range []testTx{{Sender: "a", Nonce: 0}, {Sender: "a", Nonce: 1}, {Sender: "b", Nonce: 0}} {
+		resp, err := c.CheckTx(ctx, &abciproto.CheckTxRequest{Tx: tx.Bytes(), Type: abciproto.CHECK_TX_TYPE_CHECK})
+		require.NoError(t, err)
+		require.Zero(t, resp.Code, resp.Log)
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// simulate a committed block which included the first tx of a.
+	stf.validate = func(tx testTx) error {
+		if tx.Sender == "a" && tx.Nonce == 0 {
+			return errors.New("nonce too low")
+		}
+		return nil
+	}
+	done := c.recheckMempool(1)
+	require.NotNil(t, done)
+	<-done
+	require.Equal(t, 2, mp.CountTx())
+
+	// the mempool can be re-checked again once the previous recheck is done.
+	done = c.recheckMempool(2)
+	require.NotNil(t, done)
+	<-done
+	require.Equal(t, 2, mp.CountTx())
+}
@@ -56,3 +56,30 @@ func OverwriteDefaultCometConfig(newCfg *cmtcfg.Config) CmtCfgOption {
 		cfg = newCfg // nolint:ineffassign,staticcheck // We want to overwrite everything
 	}
 }
+
+// AppTomlConfig is the app.toml configuration of the CometBFT server.
+type AppTomlConfig struct {
+	AppData AppDataListenerConfig `mapstructure:"appdata" toml:"appdata" comment:"AppData defines the configuration of the appdata.Listener streaming the blocks, transactions, events and state changes to the app, such as an indexer."`
+}
+
+// AppDataListenerConfig defines the configuration of the appdata.Listener set in the server options.
+type AppDataListenerConfig struct {
+	// Enable specifies whether the data is streamed to the listener.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable specifies whether the data is streamed to the listener."`
+	// List of modules whose state changes are streamed to the listener.
+	// ["*"] to expose all modules.
+	Keys []string `mapstructure:"keys" toml:"keys" comment:"List of modules whose state changes are streamed to the listener. [\"*\"] to expose all modules."`
+	// BufferSize is the number of packets buffered for the listener, which processes
+	// them in its own goroutine until the commit of the block when it is greater than 0.
+	BufferSize int `mapstructure:"buffer-size" toml:"buffer-size" comment:"BufferSize is the number of packets buffered for the listener, which processes them in its own goroutine until the commit of the block when it is greater than 0."`
+}
+
+// DefaultAppTomlConfig returns the default app.toml configuration of the CometBFT server.
+func DefaultAppTomlConfig() *AppTomlConfig {
+	return &AppTomlConfig{
+		AppData: AppDataListenerConfig{
+			Enable: false,
+			Keys:   []string{"*"},
+		},
+	}
+}
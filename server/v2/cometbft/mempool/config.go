@@ -8,4 +8,7 @@ type Config struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+	// MaxTxsPerSender defines the maximum amount of txs a single sender may have
+	// in the mempool. Zero indicates that it is unbounded.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
 }
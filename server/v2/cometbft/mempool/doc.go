@@ -1,6 +1,12 @@
 /*
 The mempool package defines a few mempool services which can be used in conjunction with your consensus implementation
 
+  - NoOpMempool discards every transaction, leaving transaction ordering to CometBFT's mempool.
+  - PriorityNonceMempool orders transactions by priority (e.g. fee) while respecting the nonce
+    order of each sender. It supports per-sender limits, eviction by priority when full, and
+    re-checking its transactions after a block is committed.
+
+An app-side mempool is used by the DefaultProposalHandler in order to build block proposals.
 */
 
 package mempool
@@ -39,3 +39,11 @@ type Iterator[T transaction.Tx] interface {
 	// Tx returns the transaction at the current position of the iterator.
 	Tx() T
 }
+
+// Rechecker defines an app-side mempool which is able to validate again its
+// transactions after a block is committed, evicting the ones which became invalid.
+type Rechecker[T transaction.Tx] interface {
+	// Recheck validates the transactions of the mempool using the provided function,
+	// removing the ones for which it returns an error.
+	Recheck(ctx context.Context, validate func(context.Context, T) error) error
+}
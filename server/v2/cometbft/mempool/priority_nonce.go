@@ -0,0 +1,400 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/core/transaction"
+)
+
+var (
+	_ Mempool[transaction.Tx]   = (*PriorityNonceMempool[transaction.Tx, int64])(nil)
+	_ Rechecker[transaction.Tx] = (*PriorityNonceMempool[transaction.Tx, int64])(nil)
+)
+
+var (
+	ErrSenderTxLimit = errors.New("sender reached max tx capacity")
+	ErrTxReplacement = errors.New("tx doesn't fit the replacement rule")
+)
+
+type (
+	// TxPriority defines a type that is used to retrieve and compare transaction
+	// priorities. Priorities must be comparable.
+	TxPriority[T transaction.Tx, C comparable] struct {
+		// GetTxPriority returns the priority of the transaction, for instance its fee.
+		// A priority must be comparable via Compare.
+		GetTxPriority func(ctx context.Context, tx T) (C, error)
+
+		// Compare compares two transaction priorities. The result must be 0 if
+		// a == b, -1 if a < b, and +1 if a > b.
+		Compare func(a, b C) int
+	}
+
+	// SenderNonceExtractor returns the sender and the nonce (sequence number)
+	// of a transaction. Transactions of the same sender are ordered by nonce.
+	SenderNonceExtractor[T transaction.Tx] func(tx T) (sender []byte, nonce uint64, err error)
+
+	// PriorityNonceMempoolConfig defines the configuration used to configure the
+	// PriorityNonceMempool.
+	PriorityNonceMempoolConfig[T transaction.Tx, C comparable] struct {
+		Config
+
+		// TxPriority defines the transaction priority and comparator.
+		TxPriority TxPriority[T, C]
+
+		// SenderNonce retrieves the sender and nonce of a transaction.
+		SenderNonce SenderNonceExtractor[T]
+
+		// TxReplacement is a callback to be called when duplicated transaction nonce
+		// detected during mempool insert. An application can define a transaction
+		// replacement rule based on tx priority or certain transaction fields.
+		// When not provided, a transaction is replaced only by a transaction with a
+		// strictly higher priority.
+		TxReplacement func(op, np C, oTx, nTx T) bool
+	}
+
+	// PriorityNonceMempool is a mempool implementation that stores txs partially
+	// ordered by 2 dimensions: priority, and sender-nonce (sequence number).
+	// Transactions of a sender are always selected in nonce order, and amongst the
+	// next transactions of all senders, the one with the highest priority is selected
+	// first.
+	// When the mempool is full, the transaction with the lowest priority amongst the
+	// last transactions of all senders is evicted, if its priority is lower than the
+	// one of the inserted transaction.
+	PriorityNonceMempool[T transaction.Tx, C comparable] struct {
+		mtx     sync.RWMutex
+		senders map[string][]*mempoolTx[T, C] // sorted by nonce
+		count   int
+		cfg     PriorityNonceMempoolConfig[T, C]
+	}
+
+	// mempoolTx is a transaction alongside with its ordering information.
+	mempoolTx[T transaction.Tx, C comparable] struct {
+		tx       T
+		hash     [32]byte
+		sender   string
+		nonce    uint64
+		priority C
+	}
+)
+
+// NewPriorityNonceMempool returns a mempool which returns txs in a partial order
+// by 2 dimensions; priority, and sender-nonce.
+func NewPriorityNonceMempool[T transaction.Tx, C comparable](
+	cfg PriorityNonceMempoolConfig[T, C],
+) (*PriorityNonceMempool[T, C], error) {
+	if cfg.TxPriority.GetTxPriority == nil || cfg.TxPriority.Compare == nil {
+		return nil, errors.New("tx priority must be provided")
+	}
+	if cfg.SenderNonce == nil {
+		return nil, errors.New("sender nonce extractor must be provided")
+	}
+	if cfg.TxReplacement == nil {
+		cfg.TxReplacement = func(op, np C, _, _ T) bool {
+			return cfg.TxPriority.Compare(np, op) > 0
+		}
+	}
+
+	return &PriorityNonceMempool[T, C]{
+		senders: make(map[string][]*mempoolTx[T, C]),
+		cfg:     cfg,
+	}, nil
+}
+
+// Insert attempts to insert a Tx into the app-side mempool, returning an error
+// if unsuccessful.
+//
+// Transactions are unique by sender and nonce. Inserting a tx with the same
+// sender and nonce of an existing tx replaces it if it satisfies the
+// replacement rule. When the mempool is disabled, the tx is not inserted and
+// no error is returned.
+func (mp *PriorityNonceMempool[T, C]) Insert(ctx context.Context, tx T) error {
+	if mp.cfg.MaxTxs < 0 {
+		return nil
+	}
+
+	mtx, err := mp.newMempoolTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senderTxs := mp.senders[mtx.sender]
+	i, found := findNonce(senderTxs, mtx.nonce)
+	if found {
+		old := senderTxs[i]
+		if old.hash == mtx.hash {
+			return nil
+		}
+		if !mp.cfg.TxReplacement(old.priority, mtx.priority, old.tx, tx) {
+			return fmt.Errorf("%w, oldPriority: %v, newPriority: %v", ErrTxReplacement, old.priority, mtx.priority)
+		}
+		senderTxs[i] = mtx
+		return nil
+	}
+
+	if mp.cfg.MaxTxsPerSender > 0 && len(senderTxs) >= mp.cfg.MaxTxsPerSender {
+		return ErrSenderTxLimit
+	}
+
+	if mp.cfg.MaxTxs > 0 && mp.count >= mp.cfg.MaxTxs {
+		if !mp.evictFor(mtx) {
+			return ErrMempoolTxMaxCapacity
+		}
+		// eviction may have modified the sender txs.
+		senderTxs = mp.senders[mtx.sender]
+		i, _ = findNonce(senderTxs, mtx.nonce)
+	}
+
+	senderTxs = append(senderTxs, nil)
+	copy(senderTxs[i+1:], senderTxs[i:])
+	senderTxs[i] = mtx
+	mp.senders[mtx.sender] = senderTxs
+	mp.count++
+
+	return nil
+}
+
+// evictFor evicts the transaction with the lowest priority amongst the last
+// transactions of every sender, in order to make room for mtx.
+// It returns false if no transaction with a priority lower than mtx exists.
+func (mp *PriorityNonceMempool[T, C]) evictFor(mtx *mempoolTx[T, C]) bool {
+	var victim *mempoolTx[T, C]
+	for _, senderTxs := range mp.senders {
+		last := senderTxs[len(senderTxs)-1]
+		// evicting the last tx of the sender of mtx would create a nonce gap.
+		if last.sender == mtx.sender && last.nonce < mtx.nonce {
+			continue
+		}
+		if mp.cfg.TxPriority.Compare(last.priority, mtx.priority) >= 0 {
+			continue
+		}
+		if victim == nil || mp.less(last, victim) {
+			victim = last
+		}
+	}
+	if victim == nil {
+		return false
+	}
+
+	mp.remove(victim.sender, victim.nonce)
+	return true
+}
+
+// less reports whether a has a lower priority than b, using the sender and nonce
+// as tie breakers in order to keep eviction deterministic.
+func (mp *PriorityNonceMempool[T, C]) less(a, b *mempoolTx[T, C]) bool {
+	if cmp := mp.cfg.TxPriority.Compare(a.priority, b.priority); cmp != 0 {
+		return cmp < 0
+	}
+	if a.sender != b.sender {
+		return a.sender > b.sender
+	}
+	return a.nonce > b.nonce
+}
+
+// Select returns an iterator over the transactions of the mempool, ordered by
+// priority while respecting the nonce order of each sender. The provided txs
+// which are not part of the mempool are incorporated into the iterator.
+// The iterator works on a snapshot of the mempool, thus it is not affected by
+// subsequent insertions or removals.
+func (mp *PriorityNonceMempool[T, C]) Select(ctx context.Context, txs []T) Iterator[T] {
+	mp.mtx.RLock()
+	senders := make(map[string][]*mempoolTx[T, C], len(mp.senders))
+	for sender, senderTxs := range mp.senders {
+		senders[sender] = append([]*mempoolTx[T, C](nil), senderTxs...)
+	}
+	mp.mtx.RUnlock()
+
+	for _, tx := range txs {
+		mtx, err := mp.newMempoolTx(ctx, tx)
+		if err != nil {
+			continue
+		}
+		senderTxs := senders[mtx.sender]
+		i, found := findNonce(senderTxs, mtx.nonce)
+		if found {
+			continue
+		}
+		senderTxs = append(senderTxs, nil)
+		copy(senderTxs[i+1:], senderTxs[i:])
+		senderTxs[i] = mtx
+		senders[mtx.sender] = senderTxs
+	}
+
+	iter := &PriorityNonceIterator[T, C]{
+		senders: senders,
+		heads:   &senderHeap[T, C]{less: mp.less},
+	}
+	for _, senderTxs := range senders {
+		heap.Push(iter.heads, senderTxs[0])
+	}
+	return iter.next()
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool[T, C]) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return mp.count
+}
+
+// Remove removes the provided transactions from the mempool.
+// Transactions not present in the mempool are ignored.
+func (mp *PriorityNonceMempool[T, C]) Remove(txs []T) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, tx := range txs {
+		sender, nonce, err := mp.cfg.SenderNonce(tx)
+		if err != nil {
+			return err
+		}
+		senderTxs := mp.senders[string(sender)]
+		i, found := findNonce(senderTxs, nonce)
+		if !found || senderTxs[i].hash != tx.Hash() {
+			continue
+		}
+		mp.remove(string(sender), nonce)
+	}
+	return nil
+}
+
+// Recheck validates again the next transaction of every sender against the
+// latest state, removing it when validation fails. When a transaction is
+// removed, the next transaction of the same sender is validated in turn.
+// It is meant to be called after a block is committed, in order to evict the
+// transactions which were invalidated by it.
+func (mp *PriorityNonceMempool[T, C]) Recheck(ctx context.Context, validate func(context.Context, T) error) error {
+	mp.mtx.RLock()
+	heads := make([]*mempoolTx[T, C], 0, len(mp.senders))
+	for _, senderTxs := range mp.senders {
+		heads = append(heads, senderTxs[0])
+	}
+	mp.mtx.RUnlock()
+	// sort for determinism of the validation order.
+	sort.Slice(heads, func(i, j int) bool { return heads[i].sender < heads[j].sender })
+
+	for len(heads) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var next []*mempoolTx[T, C]
+		for _, head := range heads {
+			if validate(ctx, head.tx) == nil {
+				continue
+			}
+			mp.mtx.Lock()
+			senderTxs := mp.senders[head.sender]
+			if i, found := findNonce(senderTxs, head.nonce); found && senderTxs[i].hash == head.hash {
+				mp.remove(head.sender, head.nonce)
+				if senderTxs = mp.senders[head.sender]; len(senderTxs) > 0 {
+					next = append(next, senderTxs[0])
+				}
+			}
+			mp.mtx.Unlock()
+		}
+		heads = next
+	}
+	return nil
+}
+
+// remove removes the tx with the provided sender and nonce.
+// CONTRACT: the tx exists and the caller holds the write lock.
+func (mp *PriorityNonceMempool[T, C]) remove(sender string, nonce uint64) {
+	senderTxs := mp.senders[sender]
+	i, _ := findNonce(senderTxs, nonce)
+	senderTxs = append(senderTxs[:i], senderTxs[i+1:]...)
+	if len(senderTxs) == 0 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = senderTxs
+	}
+	mp.count--
+}
+
+func (mp *PriorityNonceMempool[T, C]) newMempoolTx(ctx context.Context, tx T) (*mempoolTx[T, C], error) {
+	sender, nonce, err := mp.cfg.SenderNonce(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sender) == 0 {
+		return nil, errors.New("tx must have a sender")
+	}
+	priority, err := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &mempoolTx[T, C]{
+		tx:       tx,
+		hash:     tx.Hash(),
+		sender:   string(sender),
+		nonce:    nonce,
+		priority: priority,
+	}, nil
+}
+
+// findNonce returns the position of nonce in the nonce sorted txs, and whether
+// a tx with such nonce exists.
+func findNonce[T transaction.Tx, C comparable](txs []*mempoolTx[T, C], nonce uint64) (int, bool) {
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	return i, i < len(txs) && txs[i].nonce == nonce
+}
+
+var _ Iterator[transaction.Tx] = (*PriorityNonceIterator[transaction.Tx, int64])(nil)
+
+// PriorityNonceIterator defines an iterator that is used for mempool iteration
+// on Select().
+type PriorityNonceIterator[T transaction.Tx, C comparable] struct {
+	senders map[string][]*mempoolTx[T, C]
+	heads   *senderHeap[T, C]
+	current *mempoolTx[T, C]
+}
+
+// Next returns the next transaction from the mempool. If there are no more
+// transactions, it returns nil.
+func (i *PriorityNonceIterator[T, C]) Next() Iterator[T] {
+	return i.next()
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (i *PriorityNonceIterator[T, C]) Tx() T {
+	return i.current.tx
+}
+
+func (i *PriorityNonceIterator[T, C]) next() Iterator[T] {
+	if i.heads.Len() == 0 {
+		return nil
+	}
+	i.current = heap.Pop(i.heads).(*mempoolTx[T, C])
+
+	senderTxs := i.senders[i.current.sender][1:]
+	i.senders[i.current.sender] = senderTxs
+	if len(senderTxs) > 0 {
+		heap.Push(i.heads, senderTxs[0])
+	}
+	return i
+}
+
+// senderHeap is a max heap of the next transaction of each sender, by priority.
+type senderHeap[T transaction.Tx, C comparable] struct {
+	txs  []*mempoolTx[T, C]
+	less func(a, b *mempoolTx[T, C]) bool
+}
+
+func (h *senderHeap[T, C]) Len() int           { return len(h.txs) }
+func (h *senderHeap[T, C]) Less(i, j int) bool { return h.less(h.txs[j], h.txs[i]) }
+func (h *senderHeap[T, C]) Swap(i, j int)      { h.txs[i], h.txs[j] = h.txs[j], h.txs[i] }
+func (h *senderHeap[T, C]) Push(x any)         { h.txs = append(h.txs, x.(*mempoolTx[T, C])) }
+func (h *senderHeap[T, C]) Pop() any {
+	last := h.txs[len(h.txs)-1]
+	h.txs = h.txs[:len(h.txs)-1]
+	return last
+}
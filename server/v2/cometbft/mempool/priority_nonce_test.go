@@ -0,0 +1,188 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+)
+
+type testTx struct {
+	sender   string
+	nonce    uint64
+	priority int64
+}
+
+func (t testTx) Hash() [32]byte {
+	return sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%d", t.sender, t.nonce, t.priority)))
+}
+func (t testTx) GetMessages() ([]transaction.Msg, error)     { return nil, nil }
+func (t testTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (t testTx) GetGasLimit() (uint64, error)                { return 0, nil }
+func (t testTx) Bytes() []byte                               { return nil }
+
+func newTestMempool(t *testing.T, cfg Config) *PriorityNonceMempool[testTx, int64] {
+	t.Helper()
+	mp, err := NewPriorityNonceMempool(PriorityNonceMempoolConfig[testTx, int64]{
+		Config: cfg,
+		TxPriority: TxPriority[testTx, int64]{
+			GetTxPriority: func(_ context.Context, tx testTx) (int64, error) { return tx.priority, nil },
+			Compare: func(a, b int64) int {
+				switch {
+				case a < b:
+					return -1
+				case a > b:
+					return 1
+				default:
+					return 0
+				}
+			},
+		},
+		SenderNonce: func(tx testTx) ([]byte, uint64, error) { return []byte(tx.sender), tx.nonce, nil },
+	})
+	require.NoError(t, err)
+	return mp
+}
+
+func selectAll(mp Mempool[testTx], txs ...testTx) []testTx {
+	var selected []testTx
+	for it := mp.Select(context.Background(), txs); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	return selected
+}
+
+func TestPriorityNonceMempoolOrdering(t *testing.T) {
+	mp := newTestMempool(t, Config{})
+	ctx := context.Background()
+
+	txs := []testTx{
+		{sender: "a", nonce: 1, priority: 20},
+		{sender: "a", nonce: 0, priority: 5},
+		{sender: "b", nonce: 0, priority: 10},
+		{sender: "b", nonce: 1, priority: 1},
+		{sender: "c", nonce: 3, priority: 10},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 5, mp.CountTx())
+
+	// the nonce order of each sender is respected, ties are broken by sender.
+	require.Equal(t, []testTx{
+		{sender: "b", nonce: 0, priority: 10},
+		{sender: "c", nonce: 3, priority: 10},
+		{sender: "a", nonce: 0, priority: 5},
+		{sender: "a", nonce: 1, priority: 20},
+		{sender: "b", nonce: 1, priority: 1},
+	}, selectAll(mp))
+
+	// txs provided to select are incorporated.
+	require.Equal(t, []testTx{
+		{sender: "d", nonce: 0, priority: 100},
+		{sender: "b", nonce: 0, priority: 10},
+		{sender: "c", nonce: 3, priority: 10},
+		{sender: "a", nonce: 0, priority: 5},
+		{sender: "a", nonce: 1, priority: 20},
+		{sender: "b", nonce: 1, priority: 1},
+	}, selectAll(mp, testTx{sender: "d", nonce: 0, priority: 100}))
+	require.Equal(t, 5, mp.CountTx())
+
+	require.NoError(t, mp.Remove([]testTx{txs[1], txs[2], {sender: "z"}}))
+	require.Equal(t, []testTx{
+		{sender: "a", nonce: 1, priority: 20},
+		{sender: "c", nonce: 3, priority: 10},
+		{sender: "b", nonce: 1, priority: 1},
+	}, selectAll(mp))
+
+	require.NoError(t, mp.Remove([]testTx{txs[0], txs[3], txs[4]}))
+	require.Nil(t, mp.Select(ctx, nil))
+	require.Zero(t, mp.CountTx())
+}
+
+func TestPriorityNonceMempoolReplacement(t *testing.T) {
+	mp := newTestMempool(t, Config{})
+	ctx := context.Background()
+
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 10}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 9}), ErrTxReplacement)
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 11}))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []testTx{{sender: "a", nonce: 0, priority: 11}}, selectAll(mp))
+}
+
+func TestPriorityNonceMempoolLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("per sender", func(t *testing.T) {
+		mp := newTestMempool(t, Config{MaxTxsPerSender: 2})
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 1}))
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 1, priority: 1}))
+		require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "a", nonce: 2, priority: 1}), ErrSenderTxLimit)
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "b", nonce: 0, priority: 1}))
+	})
+
+	t.Run("eviction by priority", func(t *testing.T) {
+		mp := newTestMempool(t, Config{MaxTxs: 3})
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 5}))
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 1, priority: 1}))
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "b", nonce: 0, priority: 3}))
+
+		// not enough priority to evict anything.
+		require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "c", nonce: 0, priority: 1}), ErrMempoolTxMaxCapacity)
+		// evicts the last tx of a, which has the lowest priority.
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "c", nonce: 0, priority: 4}))
+		require.Equal(t, 3, mp.CountTx())
+		require.Equal(t, []testTx{
+			{sender: "a", nonce: 0, priority: 5},
+			{sender: "c", nonce: 0, priority: 4},
+			{sender: "b", nonce: 0, priority: 3},
+		}, selectAll(mp))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		mp := newTestMempool(t, Config{MaxTxs: -1})
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "a"}))
+		require.Zero(t, mp.CountTx())
+	})
+}
+
+func TestPriorityNonceMempoolRecheck(t *testing.T) {
+	mp := newTestMempool(t, Config{})
+	ctx := context.Background()
+
+	for _, tx := range []testTx{
+		{sender: "a", nonce: 0, priority: 1},
+		{sender: "a", nonce: 1, priority: 1},
+		{sender: "a", nonce: 2, priority: 1},
+		{sender: "b", nonce: 4, priority: 1},
+	} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// simulate a committed block which included the txs of a up to nonce 1.
+	var validated []testTx
+	err := mp.Recheck(ctx, func(_ context.Context, tx testTx) error {
+		validated = append(validated, tx)
+		if tx.sender == "a" && tx.nonce < 2 {
+			return errors.New("nonce too low")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []testTx{
+		{sender: "a", nonce: 0, priority: 1},
+		{sender: "b", nonce: 4, priority: 1},
+		{sender: "a", nonce: 1, priority: 1},
+		{sender: "a", nonce: 2, priority: 1},
+	}, validated)
+	require.Equal(t, []testTx{
+		{sender: "a", nonce: 2, priority: 1},
+		{sender: "b", nonce: 4, priority: 1},
+	}, selectAll(mp))
+}
@@ -2,6 +2,7 @@ package cometbft
 
 import (
 	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/store/v2/snapshots"
@@ -16,6 +17,10 @@ type ServerOptions[T transaction.Tx] struct {
 	ExtendVoteHandler          handlers.ExtendVoteHandler
 
 	SnapshotOptions snapshots.SnapshotOptions
+
+	// AppDataListener receives the blocks, transactions, events and state changes
+	// when it is enabled in the app.toml configuration of the server.
+	AppDataListener *appdata.Listener
 }
 
 // DefaultServerOptions returns the default server options.
@@ -35,6 +35,7 @@ var (
 	] = (*CometBFTServer[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
 	_ serverv2.HasCLICommands = (*CometBFTServer[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
 	_ serverv2.HasStartFlags  = (*CometBFTServer[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
+	_ serverv2.HasConfig      = (*CometBFTServer[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
 )
 
 type CometBFTServer[AppT serverv2.AppI[T], T transaction.Tx] struct {
@@ -44,8 +45,12 @@ type CometBFTServer[AppT serverv2.AppI[T], T transaction.Tx] struct {
 	initTxCodec      transaction.Codec[T]
 	logger           log.Logger
 	config           Config
+	appTomlConfig    *AppTomlConfig
 	options          ServerOptions[T]
 	cmtConfigOptions []CmtCfgOption
+
+	// appDataDone stops the goroutine of the appdata.Listener, if any
+	appDataDone chan struct{}
 }
 
 func New[AppT serverv2.AppI[T], T transaction.Tx](txCodec transaction.Codec[T], options ServerOptions[T], cfgOptions ...CmtCfgOption) *CometBFTServer[AppT, T] {
@@ -60,6 +65,16 @@ func (s *CometBFTServer[AppT, T]) Init(appI AppT, v *viper.Viper, logger log.Log
 	s.config = Config{CmtConfig: GetConfigFromViper(v), ConsensusAuthority: appI.GetConsensusAuthority()}
 	s.logger = logger.With(log.ModuleKey, s.Name())
 
+	appTomlConfig := s.Config().(*AppTomlConfig)
+	if v != nil {
+		if sub := v.Sub(s.Name()); sub != nil {
+			if err := sub.Unmarshal(&appTomlConfig); err != nil {
+				return fmt.Errorf("failed to unmarshal config: %w", err)
+			}
+		}
+	}
+	s.appTomlConfig = appTomlConfig
+
 	// create consensus
 	store := appI.GetStore().(types.Store)
 	consensus := NewConsensus[T](appI.GetAppManager(), s.options.Mempool, store, s.config, s.initTxCodec, s.logger)
@@ -69,6 +84,13 @@ func (s *CometBFTServer[AppT, T]) Init(appI AppT, v *viper.Viper, logger log.Log
 	consensus.verifyVoteExt = s.options.VerifyVoteExtensionHandler
 	consensus.extendVote = s.options.ExtendVoteHandler
 
+	if s.options.AppDataListener != nil && appTomlConfig.AppData.Enable {
+		if appTomlConfig.AppData.BufferSize > 0 {
+			s.appDataDone = make(chan struct{})
+		}
+		consensus.SetAppDataListener(newAppDataListener(*s.options.AppDataListener, appTomlConfig.AppData, s.appDataDone))
+	}
+
 	// TODO: set these; what is the appropriate presence of the Store interface here?
 	var ss snapshots.StorageSnapshotter
 	var sc snapshots.CommitSnapshotter
@@ -89,6 +111,15 @@ func (s *CometBFTServer[AppT, T]) Name() string {
 	return "comet"
 }
 
+// Config returns the app.toml configuration of the server.
+func (s *CometBFTServer[AppT, T]) Config() any {
+	if s.appTomlConfig == nil {
+		return DefaultAppTomlConfig()
+	}
+
+	return s.appTomlConfig
+}
+
 func (s *CometBFTServer[AppT, T]) Start(ctx context.Context) error {
 	viper := ctx.Value(corectx.ViperContextKey).(*viper.Viper)
 	cometConfig := GetConfigFromViper(viper)
@@ -129,6 +160,11 @@ func (s *CometBFTServer[AppT, T]) Start(ctx context.Context) error {
 }
 
 func (s *CometBFTServer[AppT, T]) Stop(context.Context) error {
+	if s.appDataDone != nil {
+		close(s.appDataDone)
+		s.appDataDone = nil
+	}
+
 	if s.Node != nil && s.Node.IsRunning() {
 		return s.Node.Stop()
 	}
@@ -2,10 +2,17 @@ package cometbft
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 
 	coreappmgr "cosmossdk.io/core/app"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/streaming"
 )
 
@@ -75,3 +82,183 @@ func intoStreamingKVPairs(stateChanges []store.StateChanges) []*streaming.StoreK
 	}
 	return streamKvPairs
 }
+
+// newAppDataListener returns the listener passing the state changes of the modules
+// of the configuration to the given listener, in a goroutine stopped by the done
+// channel when the configuration has a buffer.
+func newAppDataListener(listener appdata.Listener, cfg AppDataListenerConfig, done <-chan struct{}) appdata.Listener {
+	exposeAll := false
+	exposed := make(map[string]struct{}, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		if key == "*" {
+			exposeAll = true
+		}
+		exposed[key] = struct{}{}
+	}
+	if !exposeAll {
+		listener = appdata.ModuleFilterListener(listener, func(moduleName string) bool {
+			_, ok := exposed[moduleName]
+			return ok
+		})
+	}
+
+	if cfg.BufferSize > 0 {
+		listener = appdata.AsyncListener(listener, appdata.AsyncListenerOptions{
+			BufferSize: cfg.BufferSize,
+			DoneChan:   done,
+		})
+	}
+	return listener
+}
+
+// appDataBlockHeader is the JSON representation of the block header passed to the appdata listener.
+type appDataBlockHeader struct {
+	Height          int64     `json:"height"`
+	Time            time.Time `json:"time"`
+	Hash            []byte    `json:"hash"`
+	ProposerAddress []byte    `json:"proposer_address"`
+}
+
+// appDataTxResult is the JSON representation of the transaction results passed to the appdata listener.
+type appDataTxResult struct {
+	Code      uint32 `json:"code"`
+	Data      []byte `json:"data,omitempty"`
+	Log       string `json:"log,omitempty"`
+	Info      string `json:"info,omitempty"`
+	GasWanted uint64 `json:"gas_wanted"`
+	GasUsed   uint64 `json:"gas_used"`
+	Codespace string `json:"codespace,omitempty"`
+}
+
+// streamAppData passes the block, transactions, events and state changes of a
+// finalized block to the appdata listener, and commits it.
+func (c *Consensus[T]) streamAppData(
+	req *abci.FinalizeBlockRequest,
+	resp *coreappmgr.BlockResponse,
+	stateChanges []store.StateChanges,
+) error {
+	listener := c.appDataListener
+
+	if listener.StartBlock != nil {
+		header := appDataBlockHeader{
+			Height:          req.Height,
+			Time:            req.Time,
+			Hash:            req.Hash,
+			ProposerAddress: req.ProposerAddress,
+		}
+		err := listener.StartBlock(appdata.StartBlockData{
+			Height:     uint64(req.Height),
+			HeaderJSON: func() (json.RawMessage, error) { return json.Marshal(header) },
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if listener.OnTx != nil {
+		for i, txBytes := range req.Txs {
+			txBytes := txBytes
+			var txResult appDataTxResult
+			if i < len(resp.TxResults) {
+				res := resp.TxResults[i]
+				txResult = appDataTxResult{
+					Code:      res.Code,
+					Data:      res.Data,
+					Log:       res.Log,
+					Info:      res.Info,
+					GasWanted: res.GasWanted,
+					GasUsed:   res.GasUsed,
+					Codespace: res.Codespace,
+				}
+			}
+			err := listener.OnTx(appdata.TxData{
+				TxIndex: int32(i),
+				Bytes:   func() ([]byte, error) { return txBytes, nil },
+				JSON:    func() (json.RawMessage, error) { return json.Marshal(txResult) },
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if listener.OnEvent != nil {
+		var beginBlockEvents []event.Event
+		beginBlockEvents = append(beginBlockEvents, resp.PreBlockEvents...)
+		beginBlockEvents = append(beginBlockEvents, resp.BeginBlockEvents...)
+		if err := streamAppDataEvents(listener, -1, beginBlockEvents); err != nil {
+			return err
+		}
+		for i, txResult := range resp.TxResults {
+			if err := streamAppDataEvents(listener, int32(i), txResult.Events); err != nil {
+				return err
+			}
+		}
+		if err := streamAppDataEvents(listener, -2, resp.EndBlockEvents); err != nil {
+			return err
+		}
+	}
+
+	if listener.OnKVPair != nil {
+		var updates []appdata.ModuleKVPairUpdate
+		for _, changes := range stateChanges {
+			moduleName := string(changes.Actor)
+			for _, kv := range changes.StateChanges {
+				if kv.RemoveRange {
+					// the range removals can't be expressed as key-value pair updates
+					c.logger.Error("unable to stream a range removal to the appdata listener",
+						"height", req.Height, "module", moduleName, "start", kv.Key, "end", kv.End)
+					continue
+				}
+				updates = append(updates, appdata.ModuleKVPairUpdate{
+					ModuleName: moduleName,
+					Update: schema.KVPairUpdate{
+						Key:    kv.Key,
+						Value:  kv.Value,
+						Delete: kv.Remove,
+					},
+				})
+			}
+		}
+		if len(updates) > 0 {
+			if err := listener.OnKVPair(appdata.KVPairData{Updates: updates}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if listener.Commit != nil {
+		return listener.Commit(appdata.CommitData{})
+	}
+	return nil
+}
+
+// streamAppDataEvents passes the events of a transaction, or of the begin or end
+// block processing, to the appdata listener. The events are indexed in the message
+// which emitted them, the events without a message index in the first one.
+func streamAppDataEvents(listener *appdata.Listener, txIndex int32, events []event.Event) error {
+	eventIndexes := map[uint32]uint32{}
+	for _, e := range events {
+		attrs := make(map[string]string, len(e.Attributes))
+		for _, attr := range e.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+
+		var msgIndex uint32
+		if idx, err := strconv.ParseUint(attrs["msg_index"], 10, 32); err == nil {
+			msgIndex = uint32(idx)
+		}
+		err := listener.OnEvent(appdata.EventData{
+			TxIndex:    txIndex,
+			MsgIndex:   msgIndex,
+			EventIndex: eventIndexes[msgIndex],
+			Type:       e.Type,
+			Data:       func() (json.RawMessage, error) { return json.Marshal(attrs) },
+		})
+		if err != nil {
+			return err
+		}
+		eventIndexes[msgIndex]++
+	}
+	return nil
+}
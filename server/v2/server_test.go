@@ -37,6 +37,10 @@ func (*mockApp[T]) InterfaceRegistry() coreapp.InterfaceRegistry {
 	return &mockInterfaceRegistry{}
 }
 
+func (*mockApp[T]) GetStore() any {
+	return nil
+}
+
 // TODO split this test into multiple tests
 // test read config
 // test write config
@@ -103,6 +103,72 @@ func TestBranch(t *testing.T) {
 	// test reverse iter
 }
 
+func TestBranch_DeleteRange(t *testing.T) {
+	parent := newMemState()
+	for _, key := range []string{"1", "2", "3", "4", "5"} {
+		require.NoError(t, parent.Set([]byte(key), []byte(key)))
+	}
+
+	branch := NewStore(parent)
+	require.NoError(t, branch.Set([]byte("25"), []byte("x")))
+	require.NoError(t, branch.DeleteRange([]byte("2"), []byte("4")))
+	// the keys written after the range removal are kept
+	require.NoError(t, branch.Set([]byte("3"), []byte("y")))
+
+	for key, want := range map[string]string{"1": "1", "2": "", "25": "", "3": "y", "4": "4"} {
+		value, err := branch.Get([]byte(key))
+		require.NoError(t, err)
+		has, err := branch.Has([]byte(key))
+		require.NoError(t, err)
+		if want == "" {
+			require.Nil(t, value, key)
+			require.False(t, has, key)
+		} else {
+			require.Equal(t, want, string(value), key)
+			require.True(t, has, key)
+		}
+	}
+
+	keys := func(s store.Reader, ascending bool) []string {
+		t.Helper()
+		var (
+			iter store.Iterator
+			err  error
+		)
+		if ascending {
+			iter, err = s.Iterator(nil, nil)
+		} else {
+			iter, err = s.ReverseIterator(nil, nil)
+		}
+		require.NoError(t, err)
+		defer iter.Close()
+		var keys []string
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, string(iter.Key()))
+		}
+		return keys
+	}
+	require.Equal(t, []string{"1", "3", "4", "5"}, keys(branch, true))
+	require.Equal(t, []string{"5", "4", "3", "1"}, keys(branch, false))
+
+	// the range removal is a single change, followed by the writes done after it
+	changes, err := branch.ChangeSets()
+	require.NoError(t, err)
+	require.Equal(t, []store.KVPair{
+		{Key: []byte("2"), End: []byte("4"), RemoveRange: true},
+		{Key: []byte("3"), Value: []byte("y")},
+	}, changes)
+
+	// applying the changes to another branch gives the same state
+	other := NewStore(parent)
+	require.NoError(t, other.ApplyChangeSets(changes))
+	require.Equal(t, keys(branch, true), keys(other, true))
+
+	// an unbounded range removes all the keys
+	require.NoError(t, branch.DeleteRange(nil, nil))
+	require.Empty(t, keys(branch, true))
+}
+
 func newMemState() memStore {
 	return memStore{btree.NewBTreeGOptions(byKeys, btree.Options{Degree: bTreeDegree, NoLocks: true})}
 }
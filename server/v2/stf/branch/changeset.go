@@ -24,6 +24,10 @@ var errKeyEmpty = errors.New("key cannot be empty")
 // We choose tidwall/btree over google/btree here because it provides API to implement step iterator directly.
 type changeSet struct {
 	tree *btree.BTreeG[item]
+	// removedRanges are the ranges of keys removed from the parent, in the order
+	// they were removed. The items of the tree are always written after the ranges
+	// containing them, so they shadow them.
+	removedRanges *[]keyRange
 }
 
 // newChangeSet creates a wrapper around `btree.BTreeG`.
@@ -33,6 +37,7 @@ func newChangeSet() changeSet {
 			Degree:  bTreeDegree,
 			NoLocks: true,
 		}),
+		removedRanges: new([]keyRange),
 	}
 }
 
@@ -53,6 +58,49 @@ func (bt changeSet) delete(key []byte) {
 	bt.set(key, nil)
 }
 
+// deleteRange removes all the keys in the range [start, end), a nil start or end
+// meaning the range is unbounded on that side. The keys of the change set in the
+// range are dropped and the range is recorded, so it shadows the parent.
+func (bt changeSet) deleteRange(start, end []byte) {
+	r := keyRange{start: start, end: end}
+
+	var keys [][]byte
+	bt.tree.Ascend(item{key: start}, func(it item) bool {
+		if !r.contains(it.key) {
+			return false
+		}
+		keys = append(keys, it.key)
+		return true
+	})
+	for _, key := range keys {
+		bt.tree.Delete(item{key: key})
+	}
+
+	*bt.removedRanges = append(*bt.removedRanges, r)
+}
+
+// removed returns true if the key is in a removed range.
+func (bt changeSet) removed(key []byte) bool {
+	for _, r := range *bt.removedRanges {
+		if r.contains(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterRemoved returns an iterator skipping the keys of the parent iterator
+// which are in a removed range.
+func (bt changeSet) filterRemoved(parent store.Iterator) store.Iterator {
+	if len(*bt.removedRanges) == 0 {
+		return parent
+	}
+
+	iter := &removedRangesIterator{Iterator: parent, changeSet: bt}
+	iter.skipRemoved()
+	return iter
+}
+
 // iterator returns a new iterator over the key-value pairs in the changeSet
 // that have keys greater than or equal to the start key and less than the end key.
 func (bt changeSet) iterator(start, end []byte) (store.Iterator, error) {
@@ -72,6 +120,36 @@ func (bt changeSet) reverseIterator(start, end []byte) (store.Iterator, error) {
 	return newMemIterator(start, end, bt.tree, false), nil
 }
 
+// keyRange is a range of keys [start, end), a nil start or end meaning the range
+// is unbounded on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the key is in the range.
+func (r keyRange) contains(key []byte) bool {
+	return bytes.Compare(key, r.start) >= 0 && (r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// removedRangesIterator skips the keys of an iterator which are in the removed
+// ranges of a change set.
+type removedRangesIterator struct {
+	store.Iterator
+	changeSet changeSet
+}
+
+// Next implements Iterator.
+func (iter *removedRangesIterator) Next() {
+	iter.Iterator.Next()
+	iter.skipRemoved()
+}
+
+func (iter *removedRangesIterator) skipRemoved() {
+	for iter.Iterator.Valid() && iter.changeSet.removed(iter.Iterator.Key()) {
+		iter.Iterator.Next()
+	}
+}
+
 // item is a btree item with byte slices as keys and values
 type item struct {
 	key   []byte
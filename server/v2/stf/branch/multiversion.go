@@ -0,0 +1,302 @@
+package branch
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	"cosmossdk.io/core/store"
+)
+
+// MultiVersionStore holds, for every actor and key, the values written by each
+// transaction of a block, indexed by the position of the transaction in the block.
+// It allows transactions executing concurrently to observe the writes of the
+// transactions which precede them, as required by optimistic (Block-STM style)
+// parallel execution.
+// It is safe for concurrent use.
+type MultiVersionStore struct {
+	mu     sync.RWMutex
+	actors map[string]*btree.BTreeG[versionedItem]
+	// ranges holds, for every actor, the ranges of keys removed by each tx index,
+	// sorted by tx index. The writes of a tx are done after its range removals.
+	ranges map[string][]versionedRange
+	// written tracks the keys written by each tx index, so that they can be
+	// cleared when the tx is re-executed.
+	written map[int][]store.StateChanges
+}
+
+// NewMultiVersionStore creates an empty MultiVersionStore.
+func NewMultiVersionStore() *MultiVersionStore {
+	return &MultiVersionStore{
+		actors:  make(map[string]*btree.BTreeG[versionedItem]),
+		ranges:  make(map[string][]versionedRange),
+		written: make(map[int][]store.StateChanges),
+	}
+}
+
+// Record replaces the writes of the transaction at txIndex with the provided state changes.
+func (mv *MultiVersionStore) Record(txIndex int, changes []store.StateChanges) {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
+
+	for _, sc := range mv.written[txIndex] {
+		tree := mv.actors[string(sc.Actor)]
+		for _, kv := range sc.StateChanges {
+			if kv.RemoveRange {
+				continue
+			}
+			tree.Delete(versionedItem{key: kv.Key, txIndex: txIndex})
+		}
+		mv.ranges[string(sc.Actor)] = slices.DeleteFunc(mv.ranges[string(sc.Actor)], func(r versionedRange) bool {
+			return r.txIndex == txIndex
+		})
+	}
+
+	for _, sc := range changes {
+		tree, ok := mv.actors[string(sc.Actor)]
+		if !ok {
+			tree = btree.NewBTreeGOptions(byKeyAndVersion, btree.Options{Degree: bTreeDegree, NoLocks: true})
+			mv.actors[string(sc.Actor)] = tree
+		}
+		for _, kv := range sc.StateChanges {
+			if kv.RemoveRange {
+				mv.addRange(sc.Actor, kv, txIndex)
+				continue
+			}
+			value := kv.Value
+			if kv.Remove {
+				value = nil
+			}
+			tree.Set(versionedItem{key: kv.Key, txIndex: txIndex, value: value})
+		}
+	}
+	mv.written[txIndex] = changes
+}
+
+// addRange records the range removal of the transaction at txIndex, keeping the
+// ranges of the actor sorted by tx index.
+func (mv *MultiVersionStore) addRange(actor []byte, kv store.KVPair, txIndex int) {
+	r := versionedRange{keyRange: keyRange{start: kv.Key, end: kv.End}, txIndex: txIndex}
+	if len(r.start) == 0 {
+		r.start = nil
+	}
+	if len(r.end) == 0 {
+		r.end = nil
+	}
+
+	ranges := mv.ranges[string(actor)]
+	i := len(ranges)
+	for i > 0 && ranges[i-1].txIndex > txIndex {
+		i--
+	}
+	mv.ranges[string(actor)] = slices.Insert(ranges, i, r)
+}
+
+// removedBy returns the highest index of the transactions preceding txIndex which
+// removed the key with a range removal, or -1.
+func (mv *MultiVersionStore) removedBy(actor, key []byte, txIndex int) int {
+	ranges := mv.ranges[unsafeString(actor)]
+	for i := len(ranges) - 1; i >= 0; i-- {
+		if ranges[i].txIndex < txIndex && ranges[i].contains(key) {
+			return ranges[i].txIndex
+		}
+	}
+	return -1
+}
+
+// read returns the value written to key by the closest transaction preceding txIndex.
+// If no preceding transaction wrote to the key, found is false. A nil value with found
+// equal to true signals that the key was deleted.
+func (mv *MultiVersionStore) read(actor, key []byte, txIndex int) (value []byte, found bool) {
+	if txIndex == 0 {
+		return nil, false
+	}
+
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
+
+	writtenBy := -1
+	if tree, ok := mv.actors[unsafeString(actor)]; ok {
+		tree.Descend(versionedItem{key: key, txIndex: txIndex - 1}, func(it versionedItem) bool {
+			if bytes.Equal(it.key, key) {
+				value, found, writtenBy = it.value, true, it.txIndex
+			}
+			return false
+		})
+	}
+	// the writes of a transaction are done after its range removals
+	if mv.removedBy(actor, key, txIndex) > writtenBy {
+		return nil, true
+	}
+	return value, found
+}
+
+// snapshot returns a changeSet containing, for each key in [start, end), the value
+// visible to the transaction at txIndex.
+func (mv *MultiVersionStore) snapshot(actor, start, end []byte, txIndex int) changeSet {
+	cs := newChangeSet()
+	if txIndex == 0 {
+		return cs
+	}
+
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
+
+	// the removed ranges shadow the base state, the writes being applied on top
+	for _, r := range mv.ranges[unsafeString(actor)] {
+		if r.txIndex < txIndex {
+			*cs.removedRanges = append(*cs.removedRanges, r.keyRange)
+		}
+	}
+
+	tree, ok := mv.actors[unsafeString(actor)]
+	if !ok {
+		return cs
+	}
+	tree.Ascend(versionedItem{key: start}, func(it versionedItem) bool {
+		if end != nil && bytes.Compare(it.key, end) >= 0 {
+			return false
+		}
+		// items are sorted by version for the same key, so the last
+		// visible version overwrites the previous ones, unless a later
+		// transaction removed the key with a range removal.
+		if it.txIndex < txIndex {
+			if mv.removedBy(actor, it.key, txIndex) > it.txIndex {
+				cs.tree.Delete(item{key: it.key})
+			} else {
+				cs.tree.Set(item{key: it.key, value: it.value})
+			}
+		}
+		return true
+	})
+	return cs
+}
+
+// versionedItem is a value written to key by the transaction at txIndex.
+type versionedItem struct {
+	key     []byte
+	txIndex int
+	value   []byte
+}
+
+// versionedRange is a range of keys removed by the transaction at txIndex.
+type versionedRange struct {
+	keyRange
+	txIndex int
+}
+
+// byKeyAndVersion orders versioned items by key and then by tx index.
+func byKeyAndVersion(a, b versionedItem) bool {
+	switch bytes.Compare(a.key, b.key) {
+	case -1:
+		return true
+	case 1:
+		return false
+	default:
+		return a.txIndex < b.txIndex
+	}
+}
+
+// NewVersionedReaderMap returns a store.ReaderMap which exposes the state as seen by the
+// transaction at txIndex: the writes of the preceding transactions recorded in mv, on top of
+// the provided base state. Every read done through the returned ReaderMap is recorded in reads,
+// unless reads is nil. The base state must be safe for concurrent reads.
+func NewVersionedReaderMap(base store.ReaderMap, mv *MultiVersionStore, txIndex int, reads *ReadSet) store.ReaderMap {
+	return versionedReaderMap{
+		base:    base,
+		mv:      mv,
+		txIndex: txIndex,
+		reads:   reads,
+	}
+}
+
+type versionedReaderMap struct {
+	base    store.ReaderMap
+	mv      *MultiVersionStore
+	txIndex int
+	reads   *ReadSet
+}
+
+func (v versionedReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	base, err := v.base.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return &versionedReader{
+		actor:   bytes.Clone(actor),
+		base:    base,
+		mv:      v.mv,
+		txIndex: v.txIndex,
+		reads:   v.reads,
+	}, nil
+}
+
+// versionedReader is the store.Reader of a single actor returned by versionedReaderMap.
+type versionedReader struct {
+	actor   []byte
+	base    store.Reader
+	mv      *MultiVersionStore
+	txIndex int
+	reads   *ReadSet
+}
+
+func (v *versionedReader) Has(key []byte) (bool, error) {
+	value, err := v.get(key)
+	if err != nil {
+		return false, err
+	}
+	v.reads.recordHas(v.actor, key, value != nil)
+	return value != nil, nil
+}
+
+func (v *versionedReader) Get(key []byte) ([]byte, error) {
+	value, err := v.get(key)
+	if err != nil {
+		return nil, err
+	}
+	v.reads.recordGet(v.actor, key, value)
+	return value, nil
+}
+
+func (v *versionedReader) get(key []byte) ([]byte, error) {
+	if value, found := v.mv.read(v.actor, key, v.txIndex); found {
+		return value, nil
+	}
+	return v.base.Get(key)
+}
+
+func (v *versionedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	return v.iterator(start, end, true)
+}
+
+func (v *versionedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return v.iterator(start, end, false)
+}
+
+func (v *versionedReader) iterator(start, end []byte, ascending bool) (store.Iterator, error) {
+	var (
+		parent, cache store.Iterator
+		err           error
+	)
+	snapshot := v.mv.snapshot(v.actor, start, end, v.txIndex)
+	if ascending {
+		parent, err = v.base.Iterator(start, end)
+		if err != nil {
+			return nil, err
+		}
+		cache, err = snapshot.iterator(start, end)
+	} else {
+		parent, err = v.base.ReverseIterator(start, end)
+		if err != nil {
+			return nil, err
+		}
+		cache, err = snapshot.reverseIterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	merged := mergeIterators(snapshot.filterRemoved(parent), cache, ascending)
+	return v.reads.trackIterator(v.actor, start, end, ascending, merged), nil
+}
@@ -0,0 +1,170 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+)
+
+func TestMultiVersionStore(t *testing.T) {
+	actor := []byte("actor")
+	parent := newMemState()
+	require.NoError(t, parent.Set([]byte("1"), []byte("a")))
+	require.NoError(t, parent.Set([]byte("2"), []byte("b")))
+	base := memReaderMap{string(actor): parent}
+
+	mv := NewMultiVersionStore()
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{
+		{Key: []byte("1"), Value: []byte("x")},
+		{Key: []byte("3"), Value: []byte("c")},
+	}}})
+	mv.Record(2, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{
+		{Key: []byte("1"), Remove: true},
+	}}})
+
+	get := func(txIndex int, key, want string) {
+		t.Helper()
+		reader, err := NewVersionedReaderMap(base, mv, txIndex, nil).GetReader(actor)
+		require.NoError(t, err)
+		value, err := reader.Get([]byte(key))
+		require.NoError(t, err)
+		if want == "" {
+			require.Nil(t, value)
+		} else {
+			require.Equal(t, want, string(value))
+		}
+	}
+	get(0, "1", "a") // tx 0 sees only the base state
+	get(1, "1", "x") // tx 1 sees the writes of tx 0
+	get(2, "1", "x")
+	get(3, "1", "") // tx 3 sees the removal done by tx 2
+	get(1, "3", "c")
+	get(0, "3", "")
+
+	// iteration merges the base state with the visible versions.
+	reader, err := NewVersionedReaderMap(base, mv, 3, nil).GetReader(actor)
+	require.NoError(t, err)
+	iter, err := reader.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"2", "3"}, keys)
+
+	// re-recording a tx replaces its previous writes.
+	mv.Record(2, nil)
+	get(3, "1", "x")
+}
+
+func TestMultiVersionStore_RemoveRange(t *testing.T) {
+	actor := []byte("actor")
+	parent := newMemState()
+	for _, key := range []string{"1", "2", "3"} {
+		require.NoError(t, parent.Set([]byte(key), []byte(key)))
+	}
+	base := memReaderMap{string(actor): parent}
+
+	mv := NewMultiVersionStore()
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{
+		{Key: []byte("25"), Value: []byte("x")},
+	}}})
+	// the writes of a tx are done after its range removals
+	mv.Record(1, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{
+		{Key: []byte("2"), End: []byte("3"), RemoveRange: true},
+		{Key: []byte("2"), Value: []byte("y")},
+	}}})
+
+	state := func(txIndex int) []string {
+		t.Helper()
+		reader, err := NewVersionedReaderMap(base, mv, txIndex, nil).GetReader(actor)
+		require.NoError(t, err)
+		iter, err := reader.Iterator(nil, nil)
+		require.NoError(t, err)
+		var pairs []string
+		for ; iter.Valid(); iter.Next() {
+			pairs = append(pairs, string(iter.Key())+"="+string(iter.Value()))
+			value, err := reader.Get(iter.Key())
+			require.NoError(t, err)
+			require.Equal(t, iter.Value(), value)
+		}
+		require.NoError(t, iter.Close())
+		for _, key := range []string{"2", "25"} {
+			value, err := reader.Get([]byte(key))
+			require.NoError(t, err)
+			if value != nil {
+				require.Contains(t, pairs, key+"="+string(value))
+			}
+		}
+		return pairs
+	}
+	require.Equal(t, []string{"1=1", "2=2", "25=x", "3=3"}, state(1))
+	require.Equal(t, []string{"1=1", "2=y", "3=3"}, state(2))
+
+	// re-recording a tx replaces its range removals
+	mv.Record(1, nil)
+	require.Equal(t, []string{"1=1", "2=2", "25=x", "3=3"}, state(2))
+}
+
+func TestReadSetValidate(t *testing.T) {
+	actor := []byte("actor")
+	parent := newMemState()
+	require.NoError(t, parent.Set([]byte("1"), []byte("a")))
+	require.NoError(t, parent.Set([]byte("2"), []byte("b")))
+	base := memReaderMap{string(actor): parent}
+
+	mv := NewMultiVersionStore()
+	reads := NewReadSet()
+	reader, err := NewVersionedReaderMap(base, mv, 1, reads).GetReader(actor)
+	require.NoError(t, err)
+
+	_, err = reader.Get([]byte("1"))
+	require.NoError(t, err)
+	_, err = reader.Has([]byte("5"))
+	require.NoError(t, err)
+	iter, err := reader.Iterator([]byte("2"), []byte("4"))
+	require.NoError(t, err)
+	for ; iter.Valid(); iter.Next() {
+		_ = iter.Value()
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, 3, reads.Len())
+
+	validate := func() bool {
+		t.Helper()
+		valid, err := reads.Validate(NewVersionedReaderMap(base, mv, 1, nil))
+		require.NoError(t, err)
+		return valid
+	}
+	require.True(t, validate())
+
+	// writes outside the read set do not invalidate it.
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{{Key: []byte("9"), Value: []byte("z")}}}})
+	require.True(t, validate())
+
+	// writing a key within an iterated range invalidates the read set.
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{{Key: []byte("3"), Value: []byte("c")}}}})
+	require.False(t, validate())
+
+	// writing a read key invalidates the read set.
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{{Key: []byte("1"), Value: []byte("z")}}}})
+	require.False(t, validate())
+
+	// creating a key whose existence was checked invalidates the read set.
+	mv.Record(0, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{{Key: []byte("5"), Value: []byte("e")}}}})
+	require.False(t, validate())
+
+	// writes of subsequent txs are not visible.
+	mv.Record(0, nil)
+	mv.Record(2, []store.StateChanges{{Actor: actor, StateChanges: []store.KVPair{{Key: []byte("1"), Value: []byte("z")}}}})
+	require.True(t, validate())
+}
+
+type memReaderMap map[string]memStore
+
+func (m memReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	return m[string(actor)], nil
+}
@@ -0,0 +1,182 @@
+package branch
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/store"
+)
+
+// readKind identifies the kind of read operation recorded in a ReadSet.
+type readKind uint8
+
+const (
+	readGet readKind = iota
+	readHas
+	readIterate
+)
+
+// ReadSet records the reads done by a transaction during its execution,
+// alongside with the values which were observed.
+// A ReadSet is not safe for concurrent use, it is expected to be owned
+// by the goroutine executing the transaction.
+type ReadSet struct {
+	reads []read
+}
+
+// NewReadSet creates an empty ReadSet.
+func NewReadSet() *ReadSet {
+	return &ReadSet{}
+}
+
+// read is a single read operation.
+type read struct {
+	kind  readKind
+	actor []byte
+
+	// key and value are populated for get and has reads.
+	key    []byte
+	value  []byte
+	exists bool
+
+	// iterator describes an iteration.
+	iterator *iteratorRead
+}
+
+// iteratorRead describes the domain of an iteration and the key-value pairs
+// which were observed during it.
+type iteratorRead struct {
+	start, end []byte
+	ascending  bool
+	pairs      []store.KVPair
+	// exhausted is true if the iterator was observed to be invalid after the last pair.
+	exhausted bool
+}
+
+// Len returns the number of recorded reads.
+func (rs *ReadSet) Len() int {
+	if rs == nil {
+		return 0
+	}
+	return len(rs.reads)
+}
+
+func (rs *ReadSet) recordGet(actor, key, value []byte) {
+	if rs == nil {
+		return
+	}
+	rs.reads = append(rs.reads, read{kind: readGet, actor: actor, key: bytes.Clone(key), value: value, exists: value != nil})
+}
+
+func (rs *ReadSet) recordHas(actor, key []byte, exists bool) {
+	if rs == nil {
+		return
+	}
+	rs.reads = append(rs.reads, read{kind: readHas, actor: actor, key: bytes.Clone(key), exists: exists})
+}
+
+func (rs *ReadSet) trackIterator(actor, start, end []byte, ascending bool, parent store.Iterator) store.Iterator {
+	if rs == nil {
+		return parent
+	}
+	ir := &iteratorRead{start: bytes.Clone(start), end: bytes.Clone(end), ascending: ascending}
+	rs.reads = append(rs.reads, read{kind: readIterate, actor: actor, iterator: ir})
+	it := &trackedIterator{Iterator: parent, read: ir}
+	it.observe()
+	return it
+}
+
+// Validate reports whether all the recorded reads observe the same values in the provided state.
+// If Validate returns true, then executing the transaction against state would have
+// produced the exact same result as the recorded execution.
+func (rs *ReadSet) Validate(state store.ReaderMap) (bool, error) {
+	readers := make(map[string]store.Reader)
+	for _, r := range rs.reads {
+		reader, ok := readers[string(r.actor)]
+		if !ok {
+			var err error
+			reader, err = state.GetReader(r.actor)
+			if err != nil {
+				return false, err
+			}
+			readers[string(r.actor)] = reader
+		}
+
+		valid, err := r.validate(reader)
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (r read) validate(reader store.Reader) (bool, error) {
+	switch r.kind {
+	case readGet:
+		value, err := reader.Get(r.key)
+		if err != nil {
+			return false, err
+		}
+		return (value != nil) == r.exists && bytes.Equal(value, r.value), nil
+	case readHas:
+		exists, err := reader.Has(r.key)
+		if err != nil {
+			return false, err
+		}
+		return exists == r.exists, nil
+	case readIterate:
+		return r.iterator.validate(reader)
+	default:
+		panic("unknown read kind")
+	}
+}
+
+func (ir *iteratorRead) validate(reader store.Reader) (valid bool, err error) {
+	var iter store.Iterator
+	if ir.ascending {
+		iter, err = reader.Iterator(ir.start, ir.end)
+	} else {
+		iter, err = reader.ReverseIterator(ir.start, ir.end)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	for _, pair := range ir.pairs {
+		if !iter.Valid() {
+			return false, nil
+		}
+		if !bytes.Equal(iter.Key(), pair.Key) || !bytes.Equal(iter.Value(), pair.Value) {
+			return false, nil
+		}
+		iter.Next()
+	}
+	if ir.exhausted && iter.Valid() {
+		return false, nil
+	}
+	return true, nil
+}
+
+// trackedIterator records every position reached by the wrapped iterator.
+// Positions are recorded as soon as the iterator moves on them, regardless of
+// whether they are read by the caller, which may only cause spurious conflicts.
+type trackedIterator struct {
+	store.Iterator
+	read *iteratorRead
+}
+
+func (t *trackedIterator) Next() {
+	t.Iterator.Next()
+	t.observe()
+}
+
+func (t *trackedIterator) observe() {
+	if !t.Iterator.Valid() {
+		t.read.exhausted = true
+		return
+	}
+	t.read.pairs = append(t.read.pairs, store.KVPair{
+		Key:   bytes.Clone(t.Iterator.Key()),
+		Value: bytes.Clone(t.Iterator.Value()),
+	})
+}
@@ -1,12 +1,16 @@
 package branch
 
 import (
+	"bytes"
 	"errors"
 
 	"cosmossdk.io/core/store"
 )
 
-var _ store.Writer = (*Store[store.Reader])(nil)
+var (
+	_ store.Writer       = (*Store[store.Reader])(nil)
+	_ store.RangeDeleter = (*Store[store.Reader])(nil)
+)
 
 // Store wraps an in-memory cache around an underlying types.KVStore.
 type Store[T store.Reader] struct {
@@ -28,6 +32,9 @@ func (s Store[T]) Get(key []byte) (value []byte, err error) {
 	if found {
 		return
 	}
+	if s.changeSet.removed(key) {
+		return nil, nil
+	}
 	return s.parent.Get(key)
 }
 
@@ -47,6 +54,9 @@ func (s Store[T]) Has(key []byte) (bool, error) {
 	if found {
 		return tmpValue != nil, nil
 	}
+	if s.changeSet.removed(key) {
+		return false, nil
+	}
 	return s.parent.Has(key)
 }
 
@@ -56,6 +66,23 @@ func (s Store[T]) Delete(key []byte) error {
 	return nil
 }
 
+// DeleteRange implements store.RangeDeleter. The range is recorded as a single
+// range removal in the change sets.
+func (s Store[T]) DeleteRange(start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return errors.New("start key is after the end key")
+	}
+
+	s.changeSet.deleteRange(start, end)
+	return nil
+}
+
 // ----------------------------------------
 // Iteration
 
@@ -84,7 +111,7 @@ func (s Store[T]) iterator(start, end []byte, ascending bool) (store.Iterator, e
 		if err != nil {
 			return nil, err
 		}
-		return mergeIterators(parent, cache, ascending), nil
+		return mergeIterators(s.changeSet.filterRemoved(parent), cache, ascending), nil
 	} else {
 		parent, err = s.parent.ReverseIterator(start, end)
 		if err != nil {
@@ -94,13 +121,17 @@ func (s Store[T]) iterator(start, end []byte, ascending bool) (store.Iterator, e
 		if err != nil {
 			return nil, err
 		}
-		return mergeIterators(parent, cache, ascending), nil
+		return mergeIterators(s.changeSet.filterRemoved(parent), cache, ascending), nil
 	}
 }
 
 func (s Store[T]) ApplyChangeSets(changes []store.KVPair) error {
 	for _, c := range changes {
-		if c.Remove {
+		if c.RemoveRange {
+			if err := s.DeleteRange(c.Key, c.End); err != nil {
+				return err
+			}
+		} else if c.Remove {
 			err := s.Delete(c.Key)
 			if err != nil {
 				return err
@@ -115,7 +146,13 @@ func (s Store[T]) ApplyChangeSets(changes []store.KVPair) error {
 	return nil
 }
 
+// ChangeSets returns the range removals first, in the order they were done, as
+// the writes of the change set are always done after the ranges containing them.
 func (s Store[T]) ChangeSets() (cs []store.KVPair, err error) {
+	for _, r := range *s.changeSet.removedRanges {
+		cs = append(cs, store.KVPair{Key: r.start, End: r.end, RemoveRange: true})
+	}
+
 	iter, err := s.changeSet.iterator(nil, nil)
 	if err != nil {
 		return nil, err
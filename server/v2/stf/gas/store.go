@@ -83,6 +83,47 @@ func (s *Store) Delete(key []byte) error {
 	return s.parent.Delete(key)
 }
 
+// DeleteRange implements store.RangeDeleter. The gas consumed is the one of
+// deleting every key of the range, the range being recorded as a single range
+// removal if the parent supports it.
+func (s *Store) DeleteRange(start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+
+	rd, isRangeDeleter := s.parent.(store.RangeDeleter)
+	itr, err := s.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		if err := s.gasMeter.Consume(s.gasConfig.DeleteCostFlat, DescDelete); err != nil {
+			_ = itr.Close()
+			return err
+		}
+		if !isRangeDeleter {
+			keys = append(keys, itr.Key())
+		}
+	}
+	if err := itr.Close(); err != nil {
+		return err
+	}
+
+	if isRangeDeleter {
+		return rd.DeleteRange(start, end)
+	}
+	for _, key := range keys {
+		if err := s.parent.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Store) ApplyChangeSets(changes []store.KVPair) error {
 	return s.parent.ApplyChangeSets(changes)
 }
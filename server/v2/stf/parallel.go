@@ -0,0 +1,178 @@
+package stf
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	appmanager "cosmossdk.io/core/app"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+)
+
+// Option is a function that customizes the STF.
+type Option func(o *options)
+
+// options contains the optional features of the STF.
+type options struct {
+	// parallelism is the number of goroutines used to execute the transactions of a block,
+	// a value lower than 2 means that transactions are executed sequentially.
+	parallelism int
+}
+
+// WithParallelExecution enables the optimistic parallel execution of block transactions
+// using the provided number of workers.
+// Transactions are speculatively executed in parallel over a multi-version view of the state,
+// and then validated in block order: a transaction whose reads were invalidated by the writes
+// of a preceding transaction is re-executed. The results are identical to sequential execution.
+func WithParallelExecution(workers int) Option {
+	return func(o *options) {
+		o.parallelism = workers
+	}
+}
+
+// speculativeResult is the outcome of the execution of a transaction over a
+// multi-version view of the state.
+type speculativeResult struct {
+	result  appmanager.TxResult
+	changes []store.StateChanges
+	reads   *branch.ReadSet
+	err     error
+}
+
+// deliverTxsParallel executes the provided transactions optimistically on multiple goroutines
+// and applies their state changes to state, in block order.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	var (
+		wg      sync.WaitGroup
+		mv      = branch.NewMultiVersionStore()
+		base    = &lockedReaderMap{state: state}
+		results = make([]speculativeResult, len(txs))
+		done    = make([]chan struct{}, len(txs))
+		jobs    = make(chan int)
+	)
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer wg.Wait()
+	defer cancel()
+
+	// dispatch speculative executions
+	go func() {
+		defer close(jobs)
+		for i := range txs {
+			select {
+			case jobs <- i:
+			case <-workerCtx.Done():
+				return
+			}
+		}
+	}()
+	for w := 0; w < s.options.parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if isCtxCancelled(workerCtx) == nil {
+					results[i] = s.executeSpeculatively(workerCtx, base, mv, i, txs[i], hi)
+				}
+				close(done[i])
+			}
+		}()
+	}
+
+	// validate and commit in block order
+	txResults := make([]appmanager.TxResult, len(txs))
+	changes := make([][]store.StateChanges, len(txs))
+	for i := range txs {
+		select {
+		case <-done[i]:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+
+		res := results[i]
+		valid := false
+		if res.err == nil {
+			// all the preceding transactions are committed, hence the versioned view
+			// of the state at index i is the same state sequential execution would see.
+			var err error
+			valid, err = res.reads.Validate(branch.NewVersionedReaderMap(base, mv, i, nil))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !valid {
+			res = s.executeSpeculatively(ctx, base, mv, i, txs[i], hi)
+			if res.err != nil {
+				return nil, res.err
+			}
+		}
+		txResults[i] = res.result
+		changes[i] = res.changes
+	}
+
+	for i := range changes {
+		if err := state.ApplyStateChanges(changes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return txResults, nil
+}
+
+// executeSpeculatively executes the transaction at txIndex over the state seen through the
+// multi-version store, recording its reads and publishing its writes to mv.
+func (s STF[T]) executeSpeculatively(
+	ctx context.Context,
+	base store.ReaderMap,
+	mv *branch.MultiVersionStore,
+	txIndex int,
+	tx T,
+	hi header.Info,
+) (res speculativeResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = speculativeResult{err: fmt.Errorf("panic during speculative transaction execution: %s", r)}
+		}
+	}()
+
+	reads := branch.NewReadSet()
+	txState := s.branchFn(branch.NewVersionedReaderMap(base, mv, txIndex, reads))
+	result := s.deliverTx(ctx, txState, tx, transaction.ExecModeFinalize, hi)
+	changes, err := txState.GetStateChanges()
+	if err != nil {
+		return speculativeResult{err: err}
+	}
+	mv.Record(txIndex, changes)
+
+	return speculativeResult{
+		result:  result,
+		changes: changes,
+		reads:   reads,
+	}
+}
+
+// lockedReaderMap serializes the access to the readers of the underlying ReaderMap,
+// as branched state memoizes them, making GetReader unsafe for concurrent use.
+type lockedReaderMap struct {
+	mu    sync.Mutex
+	state store.ReaderMap
+}
+
+func (l *lockedReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state.GetReader(actor)
+}
@@ -0,0 +1,122 @@
+package stf
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	appmanager "cosmossdk.io/core/app"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+func TestSTFParallelExecution(t *testing.T) {
+	sum := sha256.Sum256([]byte("test-hash"))
+
+	newSTF := func(workers int) *STF[mock.Tx] {
+		s := &STF[mock.Tx]{
+			doPreBlock:        func(ctx context.Context, txs []mock.Tx) error { return nil },
+			doBeginBlock:      func(ctx context.Context) error { return nil },
+			doEndBlock:        func(ctx context.Context) error { return nil },
+			doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+			doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+				// every tx increments the nonce of its sender.
+				increment(t, ctx, "nonce/"+string(tx.Sender))
+				return nil
+			},
+			postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+			branchFn:            branch.DefaultNewWriterMap,
+			makeGasMeter:        gas.DefaultGasMeter,
+			makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+			options:             options{parallelism: workers},
+		}
+		addMsgHandlerToSTF(t, s, func(ctx context.Context, msg *gogotypes.StringValue) (*gogotypes.UInt64Value, error) {
+			if msg.Value == "fail" {
+				return nil, fmt.Errorf("failure")
+			}
+			return &gogotypes.UInt64Value{Value: increment(t, ctx, msg.Value)}, nil
+		})
+		return s
+	}
+
+	// build a block made of mostly independent txs, with some conflicting ones.
+	var txs []mock.Tx
+	for i := 0; i < 200; i++ {
+		key := fmt.Sprintf("account/%d", i)
+		switch {
+		case i%7 == 0:
+			key = "hot-key"
+		case i%13 == 0:
+			key = "fail"
+		}
+		txs = append(txs, mock.Tx{
+			Sender:   []byte(fmt.Sprintf("sender/%d", i%10)),
+			Msg:      &gogotypes.StringValue{Value: key},
+			GasLimit: 100_000,
+		})
+	}
+	block := &appmanager.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+
+	wantResult, wantState, err := newSTF(0).DeliverBlock(context.Background(), block, mock.DB())
+	require.NoError(t, err)
+
+	for _, workers := range []int{2, 4, 16} {
+		t.Run(fmt.Sprintf("workers %d", workers), func(t *testing.T) {
+			gotResult, gotState, err := newSTF(workers).DeliverBlock(context.Background(), block, mock.DB())
+			require.NoError(t, err)
+			require.Equal(t, wantResult, gotResult)
+			require.Equal(t, sortedStateChanges(t, wantState), sortedStateChanges(t, gotState))
+		})
+	}
+
+	// sanity check that the hot key was incremented by all the txs touching it.
+	value, err := readState(t, wantState, "hot-key")
+	require.NoError(t, err)
+	require.Equal(t, uint64(29), binary.BigEndian.Uint64(value))
+}
+
+func increment(t *testing.T, ctx context.Context, key string) uint64 {
+	t.Helper()
+	state, err := ctx.(*executionContext).state.GetWriter(actorName)
+	require.NoError(t, err)
+	value, err := state.Get([]byte(key))
+	require.NoError(t, err)
+	var counter uint64
+	if value != nil {
+		counter = binary.BigEndian.Uint64(value)
+	}
+	counter++
+	require.NoError(t, state.Set([]byte(key), binary.BigEndian.AppendUint64(nil, counter)))
+	return counter
+}
+
+func readState(t *testing.T, state store.ReaderMap, key string) ([]byte, error) {
+	t.Helper()
+	reader, err := state.GetReader(actorName)
+	require.NoError(t, err)
+	return reader.Get([]byte(key))
+}
+
+func sortedStateChanges(t *testing.T, state store.WriterMap) []store.StateChanges {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	require.NoError(t, err)
+	sort.Slice(changes, func(i, j int) bool { return string(changes[i].Actor) < string(changes[j].Actor) })
+	return changes
+}
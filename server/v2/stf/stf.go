@@ -40,6 +40,8 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	options options
 }
 
 // NewSTF returns a new STF instance.
@@ -54,6 +56,7 @@ func NewSTF[T transaction.Tx](
 	doValidatorUpdate func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error),
 	postTxExec func(ctx context.Context, tx T, success bool) error,
 	branch func(store store.ReaderMap) store.WriterMap,
+	opts ...Option,
 ) (*STF[T], error) {
 	msgRouter, err := msgRouterBuilder.Build()
 	if err != nil {
@@ -64,6 +67,11 @@ func NewSTF[T transaction.Tx](
 		return nil, fmt.Errorf("build query router: %w", err)
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &STF[T]{
 		logger:              logger,
 		msgRouter:           msgRouter,
@@ -77,6 +85,7 @@ func NewSTF[T transaction.Tx](
 		branchFn:            branch,
 		makeGasMeter:        stfgas.DefaultGasMeter,
 		makeGasMeteredState: stfgas.DefaultWrapWithGasMeter,
+		options:             o,
 	}, nil
 }
 
@@ -142,14 +151,9 @@ func (s STF[T]) DeliverBlock(
 	}
 
 	// execute txs
-	txResults := make([]appmanager.TxResult, len(block.Txs))
-	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
-			return nil, nil, err
-		}
-		txResults[i] = s.deliverTx(ctx, newState, txBytes, transaction.ExecModeFinalize, hi)
+	txResults, err := s.deliverTxs(ctx, newState, block.Txs, hi)
+	if err != nil {
+		return nil, nil, err
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -170,6 +174,30 @@ func (s STF[T]) DeliverBlock(
 	}, newState, nil
 }
 
+// deliverTxs executes the block transactions over the provided state and returns their results.
+// Transactions are executed in parallel if the STF was configured to do so, sequentially otherwise.
+func (s STF[T]) deliverTxs(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	if s.options.parallelism > 1 && len(txs) > 1 {
+		return s.deliverTxsParallel(ctx, state, txs, hi)
+	}
+
+	txResults := make([]appmanager.TxResult, len(txs))
+	// TODO: skip first tx if vote extensions are enabled (marko)
+	for i, tx := range txs {
+		// check if we need to return early or continue delivering txs
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txResults[i] = s.deliverTx(ctx, state, tx, transaction.ExecModeFinalize, hi)
+	}
+	return txResults, nil
+}
+
 // deliverTx executes a TX and returns the result.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
@@ -501,6 +529,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		options:             s.options,
 	}
 }
 
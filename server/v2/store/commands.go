@@ -0,0 +1,294 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/migration"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	FlagKeepRecent = "keep-recent"
+	FlagVersions   = "versions"
+	FlagCheckpoint = "checkpoint"
+
+	pruningOptionDefault    = "default"
+	pruningOptionEverything = "everything"
+	pruningOptionNothing    = "nothing"
+	pruningOptionCustom     = "custom"
+)
+
+// PrunesCmd implements the prune command, pruning the SS and SC backends of the
+// application store.
+func (s *StoreComponent[AppT, T]) PrunesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune [pruning-method]",
+		Short: "Prune app history states by keeping the recent heights and deleting old heights",
+		Long: `Prune app history states by keeping the recent heights and deleting old heights.
+The pruning option is provided via the 'pruning' argument or alternatively with '--keep-recent'
+
+- default: the last 362880 states are kept
+- nothing: all historic states will be saved, nothing will be deleted
+- everything: only the last 2 states are kept
+- custom: allow pruning options to be manually specified through '--keep-recent'
+
+The node must be stopped while pruning.`,
+		Example: "<appd> store prune custom --keep-recent 100",
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			strategy := pruningOptionDefault
+			if len(args) > 0 {
+				strategy = strings.ToLower(args[0])
+			}
+
+			pruningOption, err := pruningOptionFromCmd(cmd, strategy)
+			if err != nil {
+				return err
+			}
+			if pruningOption.KeepRecent == 0 {
+				cmd.Println("pruning strategy keeps all the states, nothing to prune")
+				return nil
+			}
+
+			rs, err := s.rootStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, rs.Close())
+			}()
+
+			latestVersion, err := rs.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+
+			scPruner, ok := rs.GetStateCommitment().(storev2.Pruner)
+			if !ok {
+				return fmt.Errorf("pruning is not supported by the SC backend %T", rs.GetStateCommitment())
+			}
+			ssPruner, ok := rs.GetStateStorage().(storev2.Pruner)
+			if !ok {
+				return fmt.Errorf("pruning is not supported by the SS backend %T", rs.GetStateStorage())
+			}
+
+			// the interval is irrelevant when pruning offline, the latest version is always pruned to.
+			pruningOption.Interval = 1
+			if err := pruning.NewManager(scPruner, ssPruner, pruningOption, pruningOption).Prune(latestVersion); err != nil {
+				return err
+			}
+
+			cmd.Printf("successfully pruned the application store, keeping the %d most recent versions\n", pruningOption.KeepRecent)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+
+	return cmd
+}
+
+// pruningOptionFromCmd returns the pruning option of the given strategy.
+func pruningOptionFromCmd(cmd *cobra.Command, strategy string) (*storev2.PruningOption, error) {
+	switch strategy {
+	case pruningOptionDefault:
+		return storev2.NewPruningOption(storev2.PruningDefault), nil
+	case pruningOptionEverything:
+		return storev2.NewPruningOption(storev2.PruningEverything), nil
+	case pruningOptionNothing:
+		return storev2.NewPruningOption(storev2.PruningNothing), nil
+	case pruningOptionCustom:
+		keepRecent, err := cmd.Flags().GetUint64(FlagKeepRecent)
+		if err != nil {
+			return nil, err
+		}
+		return storev2.NewPruningOptionWithCustom(keepRecent, 1), nil
+	default:
+		return nil, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+}
+
+// CompactCmd implements the compact command, compacting the versions of the SS
+// backend of the application store.
+func (s *StoreComponent[AppT, T]) CompactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the state storage by merging the consecutive versions of the keys holding identical values",
+		Long: `Compact the state storage by merging the consecutive versions of the keys holding identical values,
+so the unchanged values don't cost space per version. The queries at any height are unaffected.
+The versions up to the given height are compacted, the latest height being used by default.
+
+The node must be stopped while compacting.`,
+		Example: "<appd> store compact --height 1000",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			height, err := cmd.Flags().GetUint64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			rs, err := s.rootStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, rs.Close())
+			}()
+
+			compactor, ok := rs.GetStateStorage().(storev2.Compactor)
+			if !ok {
+				return fmt.Errorf("compaction is not supported by the SS backend %T", rs.GetStateStorage())
+			}
+
+			if height == 0 {
+				height, err = rs.GetLatestVersion()
+				if err != nil {
+					return err
+				}
+			}
+
+			stats, err := storage.Compact(cmd.Context(), compactor, height, serverv2.GetLoggerFromCmd(cmd), nil)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("successfully compacted the state storage up to height %d, removed %d of %d versions (%d bytes)\n",
+				height, stats.EntriesRemoved, stats.EntriesScanned, stats.BytesRemoved)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "Height up to which the state storage is compacted, the latest height if 0")
+
+	return cmd
+}
+
+// RollbackCmd implements the rollback command, rolling back the SS and SC backends
+// of the application store by the given number of versions.
+func (s *StoreComponent[AppT, T]) RollbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the application state by the given number of versions",
+		Long: `A state rollback is performed to recover from an incorrect application state transition,
+when the consensus engine has persisted an incorrect app hash and is thus unable to make progress.
+Rollback discards the latest versions of both the state storage and the state commitment,
+the latest version n becomes n - versions. No blocks are removed, so the consensus engine state
+must be rolled back to the same height for the blocks to be re-executed against the application.`,
+		Example: "<appd> store rollback --versions 2",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			versions, err := cmd.Flags().GetUint64(FlagVersions)
+			if err != nil {
+				return err
+			}
+			if versions == 0 {
+				return errors.New("the number of versions to rollback must be greater than 0")
+			}
+
+			rs, err := s.rootStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, rs.Close())
+			}()
+
+			rollbacker, ok := rs.(storev2.Rollbacker)
+			if !ok {
+				return fmt.Errorf("rollback is not supported by the store %T", rs)
+			}
+
+			latestVersion, err := rs.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			if versions >= latestVersion {
+				return fmt.Errorf("cannot rollback %d versions, the latest version is %d", versions, latestVersion)
+			}
+
+			target := latestVersion - versions
+			if err := rollbacker.Rollback(target); err != nil {
+				return fmt.Errorf("failed to rollback to version %d: %w", target, err)
+			}
+
+			commitID, err := rs.LastCommitID()
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Rolled back state to height %d and hash %X\n", commitID.Version, commitID.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagVersions, 1, "Number of versions to rollback")
+
+	return cmd
+}
+
+// MigrationStatusCmd implements the migration-status command, reporting the progress
+// of the migration of the application store from store/v1.
+func (s *StoreComponent[AppT, T]) MigrationStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration-status",
+		Short: "Show the progress of the migration of the application store from store/v1",
+		Long: `Show the progress of the migration of the application store from store/v1, read from
+the migration checkpoint: the percentage of the keys migrated, or of the stores if the number
+of keys to migrate is unknown, the estimated remaining time and the keys and bytes written per
+store. The node can be running, its gRPC server also serves the status with the
+cosmos.store.migration.v1.Query/MigrationStatus query.`,
+		Example: "<appd> store migration-status",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := cmd.Flags().GetString(FlagCheckpoint)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				home := serverv2.GetViperFromCmd(cmd).GetString(serverv2.FlagHome)
+				path = filepath.Join(home, "data", "migration", migration.DefaultCheckpointFile)
+			}
+
+			status, err := migration.LoadStatus(path)
+			if err != nil {
+				return err
+			}
+			if status == nil {
+				cmd.Printf("no migration checkpoint found at %s\n", path)
+				return nil
+			}
+
+			cmd.Printf("phase: %s height: %d migrated version: %d\n", status.Phase, status.Height, status.MigratedVersion)
+			switch {
+			case status.KeysTotal > 0:
+				cmd.Printf("keys: %d/%d stores: %d/%d (%.1f%%) eta: %s\n", status.KeysDone, status.KeysTotal, status.StoresDone, status.StoresTotal, status.Percent, status.ETA.Round(time.Second))
+			case status.StoresTotal > 0:
+				cmd.Printf("stores: %d/%d (%.1f%%) eta: %s\n", status.StoresDone, status.StoresTotal, status.Percent, status.ETA.Round(time.Second))
+			default:
+				cmd.Printf("stores: %d\n", status.StoresDone)
+			}
+			cmd.Printf("bytes written: %d updated at: %s\n", status.BytesWritten, status.UpdatedAt.Format(time.RFC3339))
+			for _, store := range status.Stores {
+				keys := fmt.Sprintf("%d", store.KeysWritten)
+				if store.KeysTotal > 0 {
+					keys = fmt.Sprintf("%d/%d", store.KeysWritten, store.KeysTotal)
+				}
+				cmd.Printf("store: %s done: %t keys: %s bytes: %d\n", store.Name, store.Done, keys, store.BytesWritten)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagCheckpoint, "", "Path of the migration checkpoint, <home>/data/migration/checkpoint.json by default")
+
+	return cmd
+}
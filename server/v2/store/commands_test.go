@@ -0,0 +1,242 @@
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	corectx "cosmossdk.io/core/context"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/store"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/migration"
+	"cosmossdk.io/store/v2/root"
+)
+
+const testStoreKey = "test"
+
+type mockApp struct {
+	serverv2.AppI[transaction.Tx]
+
+	store storev2.RootStore
+}
+
+func (m *mockApp) GetStore() any {
+	return m.store
+}
+
+type testNode struct {
+	home  string
+	scDB  corestore.KVStoreWithBatch
+	hashs map[uint64][]byte
+}
+
+func newTestNode(t *testing.T, versions uint64) *testNode {
+	t.Helper()
+
+	n := &testNode{home: t.TempDir(), scDB: dbm.NewMemDB(), hashs: make(map[uint64][]byte)}
+	rs := n.openStore(t)
+	for v := uint64(1); v <= versions; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte(testStoreKey), []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		hash, err := rs.Commit(cs)
+		require.NoError(t, err)
+		n.hashs[v] = hash
+	}
+	require.NoError(t, rs.Close())
+
+	return n
+}
+
+func (n *testNode) openStore(t *testing.T) storev2.RootStore {
+	t.Helper()
+
+	rs, err := root.CreateRootStore(&root.FactoryOptions{
+		Logger:     log.NewNopLogger(),
+		RootDir:    n.home,
+		SSType:     root.SSTypePebble,
+		SCType:     root.SCTypeIavl,
+		IavlConfig: iavl.DefaultConfig(),
+		StoreKeys:  []string{testStoreKey},
+		SCRawDB:    n.scDB,
+	})
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return rs
+}
+
+func (n *testNode) execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	component := store.New[serverv2.AppI[transaction.Tx], transaction.Tx](
+		func(log.Logger, *viper.Viper) serverv2.AppI[transaction.Tx] {
+			return &mockApp{store: n.openStore(t)}
+		},
+	)
+
+	v := viper.New()
+	v.Set(serverv2.FlagHome, n.home)
+	ctx := context.WithValue(context.Background(), corectx.ViperContextKey, v)
+	ctx = context.WithValue(ctx, corectx.LoggerContextKey, log.NewNopLogger())
+
+	cmd := &cobra.Command{Use: "root"}
+	cmd.AddCommand(component.CLICommands().Commands...)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(args)
+
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestRollbackCmd(t *testing.T) {
+	n := newTestNode(t, 10)
+
+	_, err := n.execute(t, "rollback", "--versions", "10")
+	require.Error(t, err)
+
+	out, err := n.execute(t, "rollback", "--versions", "3")
+	require.NoError(t, err)
+	require.Contains(t, out, fmt.Sprintf("Rolled back state to height 7 and hash %X", n.hashs[7]))
+
+	rs := n.openStore(t)
+	defer rs.Close()
+
+	latest, err := rs.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), latest)
+
+	val, err := rs.GetStateStorage().Get([]byte(testStoreKey), 10, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("val007"), val)
+}
+
+func TestPruneCmd(t *testing.T) {
+	n := newTestNode(t, 10)
+
+	_, err := n.execute(t, "prune", "unknown")
+	require.Error(t, err)
+
+	_, err = n.execute(t, "prune", "custom", "--keep-recent", "2")
+	require.NoError(t, err)
+
+	rs := n.openStore(t)
+	defer rs.Close()
+
+	// versions up to latest - keep-recent - 1 are pruned
+	_, err = rs.GetStateStorage().Get([]byte(testStoreKey), 7, []byte("key"))
+	require.Error(t, err)
+
+	val, err := rs.GetStateStorage().Get([]byte(testStoreKey), 8, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("val008"), val)
+}
+
+func TestCompactCmd(t *testing.T) {
+	n := newTestNode(t, 0)
+
+	// the key "key" is unchanged in the 5 versions
+	rs := n.openStore(t)
+	for v := uint64(1); v <= 5; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte(testStoreKey), []byte("key"), []byte("val"), false)
+		_, err := rs.Commit(cs)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rs.Close())
+
+	out, err := n.execute(t, "compact", "--height", "4")
+	require.NoError(t, err)
+	require.Contains(t, out, "removed 3 of 4 versions")
+
+	out, err = n.execute(t, "compact")
+	require.NoError(t, err)
+	require.Contains(t, out, "removed 1 of 2 versions")
+
+	rs = n.openStore(t)
+	defer rs.Close()
+
+	for v := uint64(1); v <= 5; v++ {
+		val, err := rs.GetStateStorage().Get([]byte(testStoreKey), v, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("val"), val)
+	}
+}
+
+func TestSnapshotsCmd(t *testing.T) {
+	n := newTestNode(t, 5)
+
+	out, err := n.execute(t, "snapshots", "export")
+	require.NoError(t, err)
+	require.Contains(t, out, "Snapshot created at height 5")
+
+	out, err = n.execute(t, "snapshots", "list")
+	require.NoError(t, err)
+	require.Contains(t, out, "height: 5 format: 3")
+
+	_, err = n.execute(t, "snapshots", "delete", "5", "3")
+	require.NoError(t, err)
+
+	// the parallel format is opt-in
+	_, err = n.execute(t, "snapshots", "export", "--format", "4")
+	require.NoError(t, err)
+
+	out, err = n.execute(t, "snapshots", "list")
+	require.NoError(t, err)
+	require.Contains(t, out, "height: 5 format: 4")
+
+	_, err = n.execute(t, "snapshots", "delete", "5", "4")
+	require.NoError(t, err)
+
+	out, err = n.execute(t, "snapshots", "list")
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func TestMigrationStatusCmd(t *testing.T) {
+	n := newTestNode(t, 0)
+
+	out, err := n.execute(t, "migration-status")
+	require.NoError(t, err)
+	require.Contains(t, out, "no migration checkpoint found")
+
+	checkpoint := filepath.Join(n.home, "data", "migration", migration.DefaultCheckpointFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(checkpoint), 0o750))
+	bz, err := json.Marshal(migration.Status{
+		Phase:       migration.PhaseMigrating,
+		Height:      10,
+		StoresTotal: 2,
+		StoresDone:  1,
+		Percent:     50,
+		ETA:         time.Minute,
+		Stores: []migration.StoreStatus{
+			{Name: "bank", Done: true, KeysWritten: 3, BytesWritten: 42},
+			{Name: "staking", KeysWritten: 1, BytesWritten: 10},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(checkpoint, bz, 0o600))
+
+	out, err = n.execute(t, "migration-status")
+	require.NoError(t, err)
+	require.Contains(t, out, "phase: migrating height: 10")
+	require.Contains(t, out, "stores: 1/2 (50.0%) eta: 1m0s")
+	require.Contains(t, out, "store: bank done: true keys: 3 bytes: 42")
+	require.Contains(t, out, "store: staking done: false keys: 1 bytes: 10")
+}
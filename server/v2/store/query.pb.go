@@ -0,0 +1,1422 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/store/migration/v1/query.proto
+
+package store
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMigrationStatusRequest is the request type for the Query/MigrationStatus RPC method.
+type QueryMigrationStatusRequest struct {
+}
+
+func (m *QueryMigrationStatusRequest) Reset()         { *m = QueryMigrationStatusRequest{} }
+func (m *QueryMigrationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationStatusRequest) ProtoMessage()    {}
+func (*QueryMigrationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b9c646292a3bf, []int{0}
+}
+func (m *QueryMigrationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationStatusRequest.Merge(m, src)
+}
+func (m *QueryMigrationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationStatusRequest proto.InternalMessageInfo
+
+// QueryMigrationStatusResponse is the response type for the Query/MigrationStatus RPC method.
+type QueryMigrationStatusResponse struct {
+	// migrating is false if the store isn't migrated from store/v1, the other
+	// fields are then unset.
+	Migrating bool `protobuf:"varint,1,opt,name=migrating,proto3" json:"migrating,omitempty"`
+	// phase is the phase of the migration: migrating, syncing or done.
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// height is the height at which the state is migrated.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// migrated_version is the latest version migrated, including the changesets
+	// caught up, 0 until the state at the migration height is migrated.
+	MigratedVersion uint64 `protobuf:"varint,4,opt,name=migrated_version,json=migratedVersion,proto3" json:"migrated_version,omitempty"`
+	// stores_total is the number of stores to migrate, 0 if unknown.
+	StoresTotal int64 `protobuf:"varint,5,opt,name=stores_total,json=storesTotal,proto3" json:"stores_total,omitempty"`
+	StoresDone  int64 `protobuf:"varint,6,opt,name=stores_done,json=storesDone,proto3" json:"stores_done,omitempty"`
+	// keys_total is the number of keys to migrate, 0 if unknown.
+	KeysTotal uint64 `protobuf:"varint,7,opt,name=keys_total,json=keysTotal,proto3" json:"keys_total,omitempty"`
+	KeysDone  uint64 `protobuf:"varint,8,opt,name=keys_done,json=keysDone,proto3" json:"keys_done,omitempty"`
+	// percent is the percentage of the keys migrated at the migration height, or
+	// of the stores if the number of keys is unknown.
+	Percent float64 `protobuf:"fixed64,9,opt,name=percent,proto3" json:"percent,omitempty"`
+	// eta is the estimated remaining time of the migration at the migration height.
+	Eta          time.Duration          `protobuf:"bytes,10,opt,name=eta,proto3,stdduration" json:"eta"`
+	BytesWritten uint64                 `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Stores       []StoreMigrationStatus `protobuf:"bytes,12,rep,name=stores,proto3" json:"stores"`
+	StartedAt    time.Time              `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	UpdatedAt    time.Time              `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+}
+
+func (m *QueryMigrationStatusResponse) Reset()         { *m = QueryMigrationStatusResponse{} }
+func (m *QueryMigrationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationStatusResponse) ProtoMessage()    {}
+func (*QueryMigrationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b9c646292a3bf, []int{1}
+}
+func (m *QueryMigrationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationStatusResponse.Merge(m, src)
+}
+func (m *QueryMigrationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationStatusResponse proto.InternalMessageInfo
+
+func (m *QueryMigrationStatusResponse) GetMigrating() bool {
+	if m != nil {
+		return m.Migrating
+	}
+	return false
+}
+
+func (m *QueryMigrationStatusResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *QueryMigrationStatusResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetMigratedVersion() uint64 {
+	if m != nil {
+		return m.MigratedVersion
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetStoresTotal() int64 {
+	if m != nil {
+		return m.StoresTotal
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetStoresDone() int64 {
+	if m != nil {
+		return m.StoresDone
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetKeysTotal() uint64 {
+	if m != nil {
+		return m.KeysTotal
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetKeysDone() uint64 {
+	if m != nil {
+		return m.KeysDone
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetEta() time.Duration {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetBytesWritten() uint64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func (m *QueryMigrationStatusResponse) GetStores() []StoreMigrationStatus {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+func (m *QueryMigrationStatusResponse) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
+}
+
+func (m *QueryMigrationStatusResponse) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// StoreMigrationStatus is the migration progress of a store.
+type StoreMigrationStatus struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// done is true once the store is fully migrated at the migration height.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// keys_total is the number of keys of the store at the migration height, 0 if
+	// unknown.
+	KeysTotal uint64 `protobuf:"varint,3,opt,name=keys_total,json=keysTotal,proto3" json:"keys_total,omitempty"`
+	// keys_written and bytes_written are the number of keys and bytes (keys and
+	// values) written to the state storage.
+	KeysWritten  uint64 `protobuf:"varint,4,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	BytesWritten uint64 `protobuf:"varint,5,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+}
+
+func (m *StoreMigrationStatus) Reset()         { *m = StoreMigrationStatus{} }
+func (m *StoreMigrationStatus) String() string { return proto.CompactTextString(m) }
+func (*StoreMigrationStatus) ProtoMessage()    {}
+func (*StoreMigrationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b9c646292a3bf, []int{2}
+}
+func (m *StoreMigrationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreMigrationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreMigrationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreMigrationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreMigrationStatus.Merge(m, src)
+}
+func (m *StoreMigrationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreMigrationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreMigrationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreMigrationStatus proto.InternalMessageInfo
+
+func (m *StoreMigrationStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoreMigrationStatus) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *StoreMigrationStatus) GetKeysTotal() uint64 {
+	if m != nil {
+		return m.KeysTotal
+	}
+	return 0
+}
+
+func (m *StoreMigrationStatus) GetKeysWritten() uint64 {
+	if m != nil {
+		return m.KeysWritten
+	}
+	return 0
+}
+
+func (m *StoreMigrationStatus) GetBytesWritten() uint64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryMigrationStatusRequest)(nil), "cosmos.store.migration.v1.QueryMigrationStatusRequest")
+	proto.RegisterType((*QueryMigrationStatusResponse)(nil), "cosmos.store.migration.v1.QueryMigrationStatusResponse")
+	proto.RegisterType((*StoreMigrationStatus)(nil), "cosmos.store.migration.v1.StoreMigrationStatus")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/store/migration/v1/query.proto", fileDescriptor_480b9c646292a3bf)
+}
+
+var fileDescriptor_480b9c646292a3bf = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xce, 0x92, 0x1f, 0x92, 0x49, 0x28, 0xd5, 0x0a, 0x55, 0x4b, 0x08, 0x8e, 0x09, 0xaa, 0x64,
+	0x2e, 0x5e, 0x91, 0xaa, 0xf4, 0x0c, 0xe5, 0xca, 0xa1, 0x06, 0xb5, 0x52, 0x2f, 0xd1, 0x82, 0xb7,
+	0xc6, 0x82, 0x78, 0x8d, 0x77, 0x9d, 0x8a, 0x6b, 0x9f, 0x00, 0xa9, 0x97, 0xbe, 0x40, 0x8f, 0x3d,
+	0xf7, 0x15, 0x38, 0x22, 0xf5, 0xd2, 0x53, 0x5b, 0x01, 0x0f, 0x52, 0xed, 0xae, 0xdd, 0x4a, 0x09,
+	0x20, 0x71, 0xdb, 0xf9, 0x66, 0xbe, 0xd9, 0xcf, 0xf3, 0xcd, 0x1a, 0x9e, 0x1f, 0x09, 0x39, 0x16,
+	0x92, 0x4a, 0x25, 0x32, 0x4e, 0xc7, 0x71, 0x94, 0x31, 0x15, 0x8b, 0x84, 0x4e, 0x36, 0xe9, 0x59,
+	0xce, 0xb3, 0x73, 0x3f, 0xcd, 0x84, 0x12, 0x78, 0xd9, 0x96, 0xf9, 0xa6, 0xcc, 0xff, 0x57, 0xe6,
+	0x4f, 0x36, 0xbb, 0xbd, 0x48, 0x88, 0xe8, 0x94, 0x53, 0x96, 0xc6, 0x94, 0x25, 0x89, 0x50, 0x26,
+	0x23, 0x2d, 0xb1, 0xeb, 0x14, 0x59, 0x13, 0x1d, 0xe6, 0x1f, 0x68, 0x98, 0x17, 0x54, 0x9b, 0xef,
+	0x4f, 0xe7, 0x55, 0x3c, 0xe6, 0x52, 0xb1, 0x71, 0x5a, 0x14, 0x2c, 0x45, 0x22, 0x12, 0xe6, 0x48,
+	0xf5, 0xc9, 0xa2, 0x83, 0x55, 0x58, 0x79, 0xa3, 0xe5, 0xed, 0x95, 0x4a, 0xf6, 0x15, 0x53, 0xb9,
+	0x0c, 0xf8, 0x59, 0xce, 0xa5, 0x1a, 0xdc, 0xd6, 0xa0, 0x77, 0x77, 0x5e, 0xa6, 0x22, 0x91, 0x1c,
+	0xf7, 0xa0, 0x55, 0x7c, 0x44, 0x12, 0x11, 0xe4, 0x22, 0xaf, 0x19, 0xfc, 0x07, 0xf0, 0x12, 0xd4,
+	0xd3, 0x63, 0x26, 0x39, 0x99, 0x73, 0x91, 0xd7, 0x0a, 0x6c, 0x80, 0x9f, 0x41, 0xe3, 0x98, 0xc7,
+	0xd1, 0xb1, 0x22, 0x55, 0x17, 0x79, 0xb5, 0xa0, 0x88, 0xf0, 0x06, 0x3c, 0xb5, 0x54, 0x1e, 0x8e,
+	0x26, 0x3c, 0x93, 0xb1, 0x48, 0x48, 0xcd, 0x54, 0x2c, 0x96, 0xf8, 0x5b, 0x0b, 0xe3, 0x35, 0xe8,
+	0x98, 0x09, 0xca, 0x91, 0x12, 0x8a, 0x9d, 0x92, 0xba, 0x8b, 0xbc, 0x6a, 0xd0, 0xb6, 0xd8, 0x81,
+	0x86, 0x70, 0x1f, 0x8a, 0x70, 0x14, 0x8a, 0x84, 0x93, 0x86, 0xa9, 0x00, 0x0b, 0xed, 0x8a, 0x84,
+	0xe3, 0x55, 0x80, 0x13, 0x7e, 0x5e, 0x76, 0x98, 0x37, 0x17, 0xb5, 0x34, 0x62, 0xf9, 0x2b, 0x60,
+	0x02, 0xcb, 0x6e, 0x9a, 0x6c, 0x53, 0x03, 0x86, 0x4b, 0x60, 0x3e, 0xe5, 0xd9, 0x11, 0x4f, 0x14,
+	0x69, 0xb9, 0xc8, 0x43, 0x41, 0x19, 0xe2, 0x97, 0x50, 0xe5, 0x8a, 0x11, 0x70, 0x91, 0xd7, 0x1e,
+	0x2e, 0xfb, 0xd6, 0x15, 0xbf, 0x74, 0xc5, 0xdf, 0x2d, 0x5c, 0xdb, 0x69, 0x5e, 0xfe, 0xea, 0x57,
+	0xbe, 0xfc, 0xee, 0xa3, 0x40, 0xd7, 0xe3, 0x75, 0x58, 0x38, 0x3c, 0x57, 0x5c, 0x8e, 0x3e, 0x66,
+	0xb1, 0x52, 0x3c, 0x21, 0x6d, 0x73, 0x63, 0xc7, 0x80, 0xef, 0x2c, 0x86, 0xf7, 0xa0, 0x61, 0xf5,
+	0x93, 0x8e, 0x5b, 0xf5, 0xda, 0x43, 0xea, 0xdf, 0xbb, 0x4d, 0xfe, 0xbe, 0x86, 0xa6, 0x5c, 0xdb,
+	0xa9, 0xe9, 0x4b, 0x83, 0xa2, 0x09, 0x7e, 0x0d, 0x20, 0x15, 0xcb, 0xf4, 0xb8, 0x99, 0x22, 0x0b,
+	0x46, 0x71, 0x77, 0x46, 0xf1, 0x41, 0xb9, 0x47, 0x56, 0xf2, 0x85, 0x96, 0xdc, 0x2a, 0x78, 0xdb,
+	0x4a, 0x37, 0xc9, 0xd3, 0x90, 0x15, 0x4d, 0x9e, 0x3c, 0xa6, 0x49, 0xc1, 0xdb, 0x56, 0x83, 0xaf,
+	0x08, 0x96, 0xee, 0x12, 0x8c, 0x31, 0xd4, 0x12, 0x36, 0xe6, 0x66, 0xb3, 0x5a, 0x81, 0x39, 0x6b,
+	0xcc, 0x78, 0x32, 0x67, 0xb6, 0xad, 0x16, 0xce, 0x7a, 0x59, 0x9d, 0xf6, 0x72, 0x0d, 0x3a, 0x26,
+	0x5d, 0x0e, 0xd7, 0x6e, 0x55, 0x5b, 0x63, 0xe5, 0x6c, 0x67, 0x0c, 0xa8, 0xcf, 0x1a, 0x30, 0xfc,
+	0x8e, 0xa0, 0x6e, 0x9e, 0x03, 0xfe, 0x86, 0x60, 0x71, 0x5a, 0xec, 0xd6, 0x03, 0x76, 0x3c, 0xf0,
+	0xc8, 0xba, 0xaf, 0x1e, 0xcd, 0xb3, 0x8f, 0x6f, 0xb0, 0xf1, 0xe9, 0xc7, 0xed, 0xe7, 0xb9, 0x75,
+	0xbc, 0x46, 0xef, 0xff, 0xf9, 0x48, 0xeb, 0xfc, 0xd6, 0xe5, 0xb5, 0x83, 0xae, 0xae, 0x1d, 0xf4,
+	0xe7, 0xda, 0x41, 0x17, 0x37, 0x4e, 0xe5, 0xea, 0xc6, 0xa9, 0xfc, 0xbc, 0x71, 0x2a, 0xef, 0x7b,
+	0x96, 0x2b, 0xc3, 0x13, 0x3f, 0x16, 0x54, 0xf2, 0x6c, 0xc2, 0x33, 0x3a, 0x19, 0xda, 0x5e, 0x87,
+	0x0d, 0x63, 0xe1, 0x8b, 0xbf, 0x03, 0x00, 0x83, 0x30, 0x28, 0xc7, 0xdf, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MigrationStatus queries the progress of the migration of the running node.
+	MigrationStatus(ctx context.Context, in *QueryMigrationStatusRequest, opts ...grpc.CallOption) (*QueryMigrationStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MigrationStatus(ctx context.Context, in *QueryMigrationStatusRequest, opts ...grpc.CallOption) (*QueryMigrationStatusResponse, error) {
+	out := new(QueryMigrationStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.store.migration.v1.Query/MigrationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MigrationStatus queries the progress of the migration of the running node.
+	MigrationStatus(context.Context, *QueryMigrationStatusRequest) (*QueryMigrationStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MigrationStatus(ctx context.Context, req *QueryMigrationStatusRequest) (*QueryMigrationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.store.migration.v1.Query/MigrationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationStatus(ctx, req.(*QueryMigrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.store.migration.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MigrationStatus",
+			Handler:    _Query_MigrationStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/store/migration/v1/query.proto",
+}
+
+func (m *QueryMigrationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BytesWritten != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BytesWritten))
+		i--
+		dAtA[i] = 0x58
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Eta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Eta):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	if m.Percent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Percent))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.KeysDone != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeysDone))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.KeysTotal != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeysTotal))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StoresDone != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StoresDone))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StoresTotal != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StoresTotal))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MigratedVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MigratedVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Migrating {
+		i--
+		if m.Migrating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreMigrationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreMigrationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreMigrationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BytesWritten != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BytesWritten))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeysWritten != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeysWritten))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeysTotal != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeysTotal))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMigrationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMigrationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Migrating {
+		n += 2
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.MigratedVersion != 0 {
+		n += 1 + sovQuery(uint64(m.MigratedVersion))
+	}
+	if m.StoresTotal != 0 {
+		n += 1 + sovQuery(uint64(m.StoresTotal))
+	}
+	if m.StoresDone != 0 {
+		n += 1 + sovQuery(uint64(m.StoresDone))
+	}
+	if m.KeysTotal != 0 {
+		n += 1 + sovQuery(uint64(m.KeysTotal))
+	}
+	if m.KeysDone != 0 {
+		n += 1 + sovQuery(uint64(m.KeysDone))
+	}
+	if m.Percent != 0 {
+		n += 9
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Eta)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BytesWritten != 0 {
+		n += 1 + sovQuery(uint64(m.BytesWritten))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StoreMigrationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.KeysTotal != 0 {
+		n += 1 + sovQuery(uint64(m.KeysTotal))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovQuery(uint64(m.KeysWritten))
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovQuery(uint64(m.BytesWritten))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMigrationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Migrating = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedVersion", wireType)
+			}
+			m.MigratedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoresTotal", wireType)
+			}
+			m.StoresTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoresTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoresDone", wireType)
+			}
+			m.StoresDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoresDone |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysTotal", wireType)
+			}
+			m.KeysTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysDone", wireType)
+			}
+			m.KeysDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysDone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Percent = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Eta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreMigrationStatus{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreMigrationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreMigrationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreMigrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysTotal", wireType)
+			}
+			m.KeysTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			}
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
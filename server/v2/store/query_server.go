@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"cosmossdk.io/store/v2/migration"
+)
+
+var _ QueryServer = queryServer{}
+
+// MigrationStatusStore is a store reporting the status of its migration from
+// store/v1, like the root store.
+type MigrationStatusStore interface {
+	// MigrationStatus returns the status of the migration, false if the store
+	// isn't migrated.
+	MigrationStatus() (migration.Status, bool)
+}
+
+type queryServer struct {
+	store MigrationStatusStore
+}
+
+// NewQueryServer returns a query server reporting the progress of the migration
+// of the store while the node is running.
+func NewQueryServer(store MigrationStatusStore) QueryServer {
+	return queryServer{store: store}
+}
+
+// MigrationStatus implements QueryServer.
+func (q queryServer) MigrationStatus(context.Context, *QueryMigrationStatusRequest) (*QueryMigrationStatusResponse, error) {
+	status, ok := q.store.MigrationStatus()
+	if !ok {
+		return &QueryMigrationStatusResponse{}, nil
+	}
+
+	stores := make([]StoreMigrationStatus, 0, len(status.Stores))
+	for _, store := range status.Stores {
+		stores = append(stores, StoreMigrationStatus{
+			Name:         store.Name,
+			Done:         store.Done,
+			KeysTotal:    store.KeysTotal,
+			KeysWritten:  store.KeysWritten,
+			BytesWritten: store.BytesWritten,
+		})
+	}
+
+	return &QueryMigrationStatusResponse{
+		Migrating:       true,
+		Phase:           string(status.Phase),
+		Height:          status.Height,
+		MigratedVersion: status.MigratedVersion,
+		StoresTotal:     int64(status.StoresTotal),
+		StoresDone:      int64(status.StoresDone),
+		KeysTotal:       status.KeysTotal,
+		KeysDone:        status.KeysDone,
+		Percent:         status.Percent,
+		Eta:             status.ETA,
+		BytesWritten:    status.BytesWritten,
+		Stores:          stores,
+		StartedAt:       status.StartedAt,
+		UpdatedAt:       status.UpdatedAt,
+	}, nil
+}
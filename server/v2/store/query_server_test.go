@@ -0,0 +1,70 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/server/v2/store"
+	"cosmossdk.io/store/v2/migration"
+)
+
+type mockMigrationStatusStore struct {
+	status    migration.Status
+	migrating bool
+}
+
+func (m mockMigrationStatusStore) MigrationStatus() (migration.Status, bool) {
+	return m.status, m.migrating
+}
+
+func TestQueryMigrationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	resp, err := store.NewQueryServer(mockMigrationStatusStore{}).MigrationStatus(ctx, &store.QueryMigrationStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &store.QueryMigrationStatusResponse{}, resp)
+
+	now := time.Now().UTC()
+	resp, err = store.NewQueryServer(mockMigrationStatusStore{
+		status: migration.Status{
+			Phase:        migration.PhaseMigrating,
+			Height:       10,
+			StoresTotal:  2,
+			StoresDone:   1,
+			KeysTotal:    4,
+			KeysDone:     3,
+			Percent:      75,
+			ETA:          time.Minute,
+			BytesWritten: 30,
+			Stores: []migration.StoreStatus{
+				{Name: "bank", Done: true, KeysTotal: 2, KeysWritten: 2, BytesWritten: 20},
+				{Name: "staking", KeysTotal: 2, KeysWritten: 1, BytesWritten: 10},
+			},
+			StartedAt: now.Add(-time.Minute),
+			UpdatedAt: now,
+		},
+		migrating: true,
+	}).MigrationStatus(ctx, &store.QueryMigrationStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &store.QueryMigrationStatusResponse{
+		Migrating:    true,
+		Phase:        "migrating",
+		Height:       10,
+		StoresTotal:  2,
+		StoresDone:   1,
+		KeysTotal:    4,
+		KeysDone:     3,
+		Percent:      75,
+		Eta:          time.Minute,
+		BytesWritten: 30,
+		Stores: []store.StoreMigrationStatus{
+			{Name: "bank", Done: true, KeysTotal: 2, KeysWritten: 2, BytesWritten: 20},
+			{Name: "staking", KeysTotal: 2, KeysWritten: 1, BytesWritten: 10},
+		},
+		StartedAt: now.Add(-time.Minute),
+		UpdatedAt: now,
+	}, resp)
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+var (
+	_ serverv2.ServerComponent[
+		serverv2.AppI[transaction.Tx], transaction.Tx,
+	] = (*StoreComponent[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
+	_ serverv2.HasCLICommands = (*StoreComponent[serverv2.AppI[transaction.Tx], transaction.Tx])(nil)
+)
+
+// StoreComponent is a server component exposing the commands to manage the
+// application store of a stopped node: pruning, compaction, rollback and snapshots.
+type StoreComponent[AppT serverv2.AppI[T], T transaction.Tx] struct {
+	// appCreator creates the application whose store the commands operate on.
+	appCreator serverv2.AppCreator[AppT, T]
+}
+
+// New creates a new store component.
+func New[AppT serverv2.AppI[T], T transaction.Tx](appCreator serverv2.AppCreator[AppT, T]) *StoreComponent[AppT, T] {
+	return &StoreComponent[AppT, T]{appCreator: appCreator}
+}
+
+func (s *StoreComponent[AppT, T]) Init(appI AppT, v *viper.Viper, logger log.Logger) error {
+	return nil
+}
+
+func (s *StoreComponent[AppT, T]) Name() string {
+	return "store"
+}
+
+func (s *StoreComponent[AppT, T]) Start(ctx context.Context) error {
+	return nil
+}
+
+func (s *StoreComponent[AppT, T]) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *StoreComponent[AppT, T]) CLICommands() serverv2.CLIConfig {
+	return serverv2.CLIConfig{
+		Commands: []*cobra.Command{
+			s.PrunesCmd(),
+			s.CompactCmd(),
+			s.RollbackCmd(),
+			s.SnapshotsCmd(),
+			s.MigrationStatusCmd(),
+		},
+	}
+}
+
+// rootStore creates the application and returns its root store.
+func (s *StoreComponent[AppT, T]) rootStore(cmd *cobra.Command) (storev2.RootStore, error) {
+	v := serverv2.GetViperFromCmd(cmd)
+	logger := serverv2.GetLoggerFromCmd(cmd)
+
+	app := s.appCreator(logger, v)
+	rs, ok := app.GetStore().(storev2.RootStore)
+	if !ok {
+		return nil, fmt.Errorf("expected the application store to be a RootStore, got %T", app.GetStore())
+	}
+
+	return rs, nil
+}
+
+// snapshotStore returns the snapshot store located in the data directory of the node home.
+func snapshotStore(cmd *cobra.Command) (*snapshots.Store, error) {
+	home := serverv2.GetViperFromCmd(cmd).GetString(serverv2.FlagHome)
+
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	return snapshots.NewStore(snapshotDir)
+}
+
+// snapshotManager returns a snapshot manager over the given root store.
+func snapshotManager(cmd *cobra.Command, rs storev2.RootStore, opts snapshots.SnapshotOptions) (*snapshots.Manager, error) {
+	store, err := snapshotStore(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, ok := rs.GetStateCommitment().(snapshots.CommitSnapshotter)
+	if !ok {
+		return nil, fmt.Errorf("snapshots are not supported by the SC backend %T", rs.GetStateCommitment())
+	}
+	ss, ok := rs.GetStateStorage().(snapshots.StorageSnapshotter)
+	if !ok {
+		return nil, fmt.Errorf("snapshots are not supported by the SS backend %T", rs.GetStateStorage())
+	}
+
+	return snapshots.NewManager(store, opts, sc, ss, nil, serverv2.GetLoggerFromCmd(cmd)), nil
+}
@@ -0,0 +1,398 @@
+package store
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+const (
+	// SnapshotFileName is the name of the file holding the snapshot metadata in a snapshot archive.
+	SnapshotFileName = "_snapshot"
+
+	FlagHeight = "height"
+	FlagOutput = "output"
+	FlagFormat = "format"
+)
+
+// SnapshotsCmd returns the snapshots group command.
+func (s *StoreComponent[AppT, T]) SnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local snapshots",
+	}
+	cmd.AddCommand(
+		s.ExportSnapshotCmd(),
+		s.RestoreSnapshotCmd(),
+		ListSnapshotsCmd(),
+		DeleteSnapshotCmd(),
+		DumpArchiveCmd(),
+		LoadArchiveCmd(),
+	)
+	return cmd
+}
+
+// ExportSnapshotCmd returns a command to take a snapshot of the application state.
+func (s *StoreComponent[AppT, T]) ExportSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export app state to snapshot store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			height, err := cmd.Flags().GetUint64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetUint32(FlagFormat)
+			if err != nil {
+				return err
+			}
+
+			rs, err := s.rootStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, rs.Close())
+			}()
+
+			if height == 0 {
+				height, err = rs.GetLatestVersion()
+				if err != nil {
+					return err
+				}
+			}
+
+			sm, err := snapshotManager(cmd, rs, snapshots.SnapshotOptions{Format: format})
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Exporting snapshot for height %d\n", height)
+
+			snapshot, err := sm.Create(height)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "Height to export, default to latest state height")
+	cmd.Flags().Uint32(FlagFormat, types.CurrentFormat, "Snapshot format, the parallel format 4 can only be restored by nodes supporting it")
+
+	return cmd
+}
+
+// RestoreSnapshotCmd returns a command to restore the application state from a local snapshot.
+func (s *StoreComponent[AppT, T]) RestoreSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore app state from local snapshot",
+		Long:  "Restore app state from local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			rs, err := s.rootStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, rs.Close())
+			}()
+
+			sm, err := snapshotManager(cmd, rs, snapshots.SnapshotOptions{})
+			if err != nil {
+				return err
+			}
+
+			return sm.RestoreLocalSnapshot(height, format)
+		},
+	}
+}
+
+// ListSnapshotsCmd returns the command to list local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, err := snapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
+			}
+
+			return nil
+		},
+	}
+}
+
+// DeleteSnapshotCmd returns the command to delete a local snapshot.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			snapshotStore, err := snapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			return snapshotStore.Delete(height, format)
+		},
+	}
+}
+
+// DumpArchiveCmd returns a command to dump the snapshot as portable archive format.
+func DumpArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump the snapshot as portable archive format",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, err := snapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			snapshot, err := snapshotStore.Get(height, format)
+			if err != nil {
+				return err
+			}
+
+			if snapshot == nil {
+				return errors.New("snapshot doesn't exist")
+			}
+
+			bz, err := snapshot.Marshal()
+			if err != nil {
+				return err
+			}
+
+			fp, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer fp.Close()
+
+			// since the chunk files are already compressed, we just use fastest compression here
+			gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
+			if err != nil {
+				return err
+			}
+			tarWriter := tar.NewWriter(gzipWriter)
+			if err := tarWriter.WriteHeader(&tar.Header{
+				Name: SnapshotFileName,
+				Mode: 0o644,
+				Size: int64(len(bz)),
+			}); err != nil {
+				return fmt.Errorf("failed to write snapshot header to tar: %w", err)
+			}
+			if _, err := tarWriter.Write(bz); err != nil {
+				return fmt.Errorf("failed to write snapshot to tar: %w", err)
+			}
+
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				path := snapshotStore.PathChunk(height, format, i)
+				tarName := strconv.FormatUint(uint64(i), 10)
+				if err := processChunk(tarWriter, path, tarName); err != nil {
+					return err
+				}
+			}
+
+			if err := tarWriter.Close(); err != nil {
+				return fmt.Errorf("failed to close tar writer: %w", err)
+			}
+
+			if err := gzipWriter.Close(); err != nil {
+				return fmt.Errorf("failed to close gzip writer: %w", err)
+			}
+
+			return fp.Close()
+		},
+	}
+
+	cmd.Flags().StringP(FlagOutput, "o", "", "output file")
+
+	return cmd
+}
+
+func processChunk(tarWriter *tar.Writer, path, tarName string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open chunk file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	st, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat chunk file %s: %w", path, err)
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: tarName,
+		Mode: 0o644,
+		Size: st.Size(),
+	}); err != nil {
+		return fmt.Errorf("failed to write chunk header to tar: %w", err)
+	}
+
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return fmt.Errorf("failed to write chunk to tar: %w", err)
+	}
+
+	return nil
+}
+
+// LoadArchiveCmd loads a portable archive format snapshot into the snapshot store.
+func LoadArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, err := snapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			fp, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open archive file: %w", err)
+			}
+			defer fp.Close()
+
+			reader, err := gzip.NewReader(fp)
+			if err != nil {
+				return fmt.Errorf("failed to create gzip reader: %w", err)
+			}
+
+			var snapshot types.Snapshot
+			tr := tar.NewReader(reader)
+
+			hdr, err := tr.Next()
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot file header: %w", err)
+			}
+			if hdr.Name != SnapshotFileName {
+				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+			}
+			bz, err := io.ReadAll(tr)
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot file: %w", err)
+			}
+			if err := snapshot.Unmarshal(bz); err != nil {
+				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+			}
+
+			// make sure the channel is unbuffered, because the tar reader can't do concurrency
+			chunks := make(chan io.ReadCloser)
+			quitChan := make(chan *types.Snapshot)
+			go func() {
+				defer close(quitChan)
+
+				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				if err != nil {
+					cmd.Println("failed to save snapshot", err)
+					return
+				}
+				quitChan <- savedSnapshot
+			}()
+
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				hdr, err = tr.Next()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					close(chunks)
+					return err
+				}
+
+				if hdr.Name != strconv.FormatInt(int64(i), 10) {
+					close(chunks)
+					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+				}
+
+				bz, err := io.ReadAll(tr)
+				if err != nil {
+					close(chunks)
+					return fmt.Errorf("failed to read chunk file: %w", err)
+				}
+				chunks <- io.NopCloser(bytes.NewReader(bz))
+			}
+			close(chunks)
+
+			savedSnapshot := <-quitChan
+			if savedSnapshot == nil {
+				return errors.New("failed to save snapshot")
+			}
+
+			if !reflect.DeepEqual(&snapshot, savedSnapshot) {
+				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+				return errors.New("invalid archive, the saved snapshot is not equal to the original one")
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseHeightAndFormat parses the <height> <format> arguments of the snapshot commands.
+func parseHeightAndFormat(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return height, uint32(format), nil
+}
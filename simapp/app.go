@@ -20,13 +20,17 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/core/log"
+	"cosmossdk.io/schema/appdata"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
+	"cosmossdk.io/x/accounts/defaults/recovery"
+	"cosmossdk.io/x/accounts/defaults/webauthn"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
+	"cosmossdk.io/x/accounts/testing/paymaster"
 	"cosmossdk.io/x/auth"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
@@ -292,13 +296,16 @@ func NewSimApp(
 		// TESTING: do not add
 		accountstd.AddAccount("counter", counter.NewAccount),
 		accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount),
+		accountstd.AddAccount("paymaster", paymaster.NewAccount),
 		// Lockup account
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		// PRODUCTION: add
-		baseaccount.NewAccount("base", txConfig.SignModeHandler()),
+		baseaccount.NewAccount("base", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey(), baseaccount.WithSecp256R1PubKey()),
+		webauthn.NewAccount("webauthn", txConfig.SignModeHandler()),
+		recovery.NewAccount("recovery", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey(), baseaccount.WithSecp256R1PubKey()),
 	)
 	if err != nil {
 		panic(err)
@@ -505,6 +512,21 @@ func NewSimApp(
 	// Uncomment if you want to set a custom migration order here.
 	// app.ModuleManager.SetOrderMigrations(custom order)
 
+	// register the appdata.Listener set with baseapp.SetAppDataListener, if it is
+	// enabled in the streaming.appdata section of app.toml, it receives the object
+	// updates of the modules
+	if listener := bApp.AppDataListener(); listener != nil && baseapp.AppDataListenerEnabled(appOpts) {
+		modules := make(map[string]any, len(app.ModuleManager.Modules))
+		for name, mod := range app.ModuleManager.Modules {
+			modules[name] = mod
+		}
+		decodingListener, err := appdata.DecodingMiddleware(*listener, modules, appdata.DecodingOptions{})
+		if err != nil {
+			panic(err)
+		}
+		bApp.RegisterAppDataListener(appOpts, keys, decodingListener)
+	}
+
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
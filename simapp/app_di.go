@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
@@ -247,6 +248,21 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// register the appdata.Listener set with baseapp.SetAppDataListener, if it is
+	// enabled in the streaming.appdata section of app.toml, it receives the object
+	// updates of the modules
+	if listener := app.AppDataListener(); listener != nil && baseapp.AppDataListenerEnabled(appOpts) {
+		modules := make(map[string]any, len(app.ModuleManager.Modules))
+		for name, mod := range app.ModuleManager.Modules {
+			modules[name] = mod
+		}
+		decodingListener, err := appdata.DecodingMiddleware(*listener, modules, appdata.DecodingOptions{})
+		if err != nil {
+			panic(err)
+		}
+		app.RegisterAppDataListener(appOpts, app.kvStoreKeys(), decodingListener)
+	}
+
 	/****  Module Options ****/
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
@@ -14,6 +14,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
 	"cosmossdk.io/x/auth/vesting"
@@ -33,6 +34,7 @@ import (
 	"cosmossdk.io/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -296,3 +298,34 @@ func TestProtoAnnotations(t *testing.T) {
 	err = msgservice.ValidateProtoAnnotations(r)
 	require.NoError(t, err)
 }
+
+func TestAppDataListener(t *testing.T) {
+	var blocks, kvPairs, commits int
+	listener := appdata.Listener{
+		StartBlock: func(appdata.StartBlockData) error { blocks++; return nil },
+		OnKVPair:   func(appdata.KVPairData) error { kvPairs++; return nil },
+		Commit:     func(appdata.CommitData) error { commits++; return nil },
+	}
+	appOpts := simtestutil.AppOptionsMap{
+		flags.FlagHome:             t.TempDir(),
+		"streaming.appdata.enable": true,
+		"streaming.appdata.keys":   []string{"*"},
+	}
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts, baseapp.SetAppDataListener(listener))
+
+	stateBytes, err := json.Marshal(GenesisStateWithSingleValidator(t, app))
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.InitChainRequest{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	require.Equal(t, 1, blocks)
+	require.NotZero(t, kvPairs)
+	require.Equal(t, 1, commits)
+}
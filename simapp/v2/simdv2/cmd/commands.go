@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
@@ -15,15 +16,35 @@ import (
 	runtimev2 "cosmossdk.io/runtime/v2"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/api/grpcgateway"
 	"cosmossdk.io/server/v2/cometbft"
+	"cosmossdk.io/server/v2/store"
 	"cosmossdk.io/simapp/v2"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	authcmd "cosmossdk.io/x/auth/client/cli"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	consensustypes "cosmossdk.io/x/consensus/types"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"cosmossdk.io/x/feegrant"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	"cosmossdk.io/x/group"
+	minttypes "cosmossdk.io/x/mint/types"
+	"cosmossdk.io/x/nft"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/docs"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -81,6 +102,7 @@ func newApp[AppT serverv2.AppI[T], T transaction.Tx](
 func initRootCmd[AppT serverv2.AppI[T], T transaction.Tx](
 	rootCmd *cobra.Command,
 	txConfig client.TxConfig,
+	interfaceRegistry codectypes.InterfaceRegistry,
 	moduleManager *runtimev2.MM[T],
 ) {
 	cfg := sdk.GetConfig()
@@ -109,6 +131,11 @@ func initRootCmd[AppT serverv2.AppI[T], T transaction.Tx](
 		offchain.OffChain(),
 	)
 
+	grpcGatewayServer, err := newGRPCGatewayServer[AppT, T](interfaceRegistry)
+	if err != nil {
+		panic(err)
+	}
+
 	// Add empty server struct here for writing default config
 	if err = serverv2.AddCommands(
 		rootCmd,
@@ -116,11 +143,47 @@ func initRootCmd[AppT serverv2.AppI[T], T transaction.Tx](
 		logger,
 		cometbft.New[AppT, T](&temporaryTxDecoder[T]{txConfig}, cometbft.DefaultServerOptions[T]()),
 		grpc.New[AppT, T](),
+		grpcGatewayServer,
+		store.New[AppT, T](newApp),
 	); err != nil {
 		panic(err)
 	}
 }
 
+// newGRPCGatewayServer returns the gRPC-gateway server serving the REST routes of
+// the query services of the simapp modules, and the swagger documentation.
+func newGRPCGatewayServer[AppT serverv2.AppI[T], T transaction.Tx](
+	interfaceRegistry codectypes.InterfaceRegistry,
+) (*grpcgateway.GRPCGatewayServer[AppT, T], error) {
+	server := grpcgateway.New[AppT, T](nil, interfaceRegistry)
+	server.RegisterHandlers(
+		grpcgateway.QueryHandlers(authtypes.RegisterQueryHandlerClient, authtypes.NewQueryClient),
+		grpcgateway.QueryHandlers(authz.RegisterQueryHandlerClient, authz.NewQueryClient),
+		grpcgateway.QueryHandlers(banktypes.RegisterQueryHandlerClient, banktypes.NewQueryClient),
+		grpcgateway.QueryHandlers(circuittypes.RegisterQueryHandlerClient, circuittypes.NewQueryClient),
+		grpcgateway.QueryHandlers(consensustypes.RegisterQueryHandlerClient, consensustypes.NewQueryClient),
+		grpcgateway.QueryHandlers(distrtypes.RegisterQueryHandlerClient, distrtypes.NewQueryClient),
+		grpcgateway.QueryHandlers(evidencetypes.RegisterQueryHandlerClient, evidencetypes.NewQueryClient),
+		grpcgateway.QueryHandlers(feegrant.RegisterQueryHandlerClient, feegrant.NewQueryClient),
+		grpcgateway.QueryHandlers(govv1.RegisterQueryHandlerClient, govv1.NewQueryClient),
+		grpcgateway.QueryHandlers(group.RegisterQueryHandlerClient, group.NewQueryClient),
+		grpcgateway.QueryHandlers(minttypes.RegisterQueryHandlerClient, minttypes.NewQueryClient),
+		grpcgateway.QueryHandlers(nft.RegisterQueryHandlerClient, nft.NewQueryClient),
+		grpcgateway.QueryHandlers(pooltypes.RegisterQueryHandlerClient, pooltypes.NewQueryClient),
+		grpcgateway.QueryHandlers(slashingtypes.RegisterQueryHandlerClient, slashingtypes.NewQueryClient),
+		grpcgateway.QueryHandlers(stakingtypes.RegisterQueryHandlerClient, stakingtypes.NewQueryClient),
+		grpcgateway.QueryHandlers(upgradetypes.RegisterQueryHandlerClient, upgradetypes.NewQueryClient),
+	)
+
+	swaggerFS, err := fs.Sub(docs.SwaggerUI, "swagger-ui")
+	if err != nil {
+		return nil, err
+	}
+	server.SetSwaggerFS(swaggerFS)
+
+	return server, nil
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand[T transaction.Tx](
 	txConfig client.TxConfig,
@@ -81,7 +81,7 @@ func NewRootCmd[AppT serverv2.AppI[T], T transaction.Tx]() *cobra.Command {
 		},
 	}
 
-	initRootCmd[AppT, T](rootCmd, clientCtx.TxConfig, moduleManager)
+	initRootCmd[AppT, T](rootCmd, clientCtx.TxConfig, clientCtx.InterfaceRegistry, moduleManager)
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
 	}
@@ -21,6 +21,7 @@ import (
 	runtimev2 "cosmossdk.io/runtime/v2"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/api/grpcgateway"
 	"cosmossdk.io/server/v2/cometbft"
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
@@ -199,6 +200,7 @@ func initTestnetFiles[T transaction.Tx](
 	for i := 0; i < args.numValidators; i++ {
 		var portOffset int
 		var grpcConfig *grpc.Config
+		grpcGatewayConfig := grpcgateway.DefaultConfig()
 		if args.singleMachine {
 			portOffset = i
 			p2pPortStart = 16656 // use different start point to not conflict with rpc port
@@ -212,6 +214,7 @@ func initTestnetFiles[T transaction.Tx](
 				MaxRecvMsgSize: grpc.DefaultConfig().MaxRecvMsgSize,
 				MaxSendMsgSize: grpc.DefaultConfig().MaxSendMsgSize,
 			}
+			grpcGatewayConfig.Address = fmt.Sprintf("127.0.0.1:%d", apiPort+portOffset)
 		}
 
 		nodeDirName := fmt.Sprintf("%s%d", args.nodeDirPrefix, i)
@@ -338,7 +341,8 @@ func initTestnetFiles[T transaction.Tx](
 		// Write server config
 		cometServer := cometbft.New[serverv2.AppI[T], T](&temporaryTxDecoder[T]{clientCtx.TxConfig}, cometbft.ServerOptions[T]{}, cometbft.OverwriteDefaultCometConfig(nodeConfig))
 		grpcServer := grpc.New[serverv2.AppI[T], T](grpc.OverwriteDefaultConfig(grpcConfig))
-		server := serverv2.NewServer(log.NewNopLogger(), cometServer, grpcServer)
+		grpcGatewayServer := grpcgateway.New[serverv2.AppI[T], T](nil, clientCtx.InterfaceRegistry, grpcgateway.OverwriteDefaultConfig(grpcGatewayConfig))
+		server := serverv2.NewServer(log.NewNopLogger(), cometServer, grpcServer, grpcGatewayServer)
 		err = server.WriteConfig(filepath.Join(nodeDir, "config"))
 		if err != nil {
 			return err
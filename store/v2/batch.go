@@ -13,6 +13,13 @@ type Batch interface {
 	// Note: <key> is safe to modify and read after calling Delete.
 	Delete(storeKey, key []byte) error
 
+	// DeleteRange removes all the keys in the range [start, end) from the backing
+	// key-value data store. An empty start or end means the range is unbounded on
+	// that side. Only the keys written by previous batches are removed.
+	//
+	// Note: <start, end> are safe to modify and read after calling DeleteRange.
+	DeleteRange(storeKey, start, end []byte) error
+
 	// Size retrieves the amount of data queued up for writing, this includes
 	// the keys, values, and deleted keys.
 	Size() int
@@ -0,0 +1,28 @@
+package flat
+
+// Config is the configuration for the flat-file tree.
+type Config struct {
+	// SyncWrites makes each commit fsync the node file before the version is
+	// recorded in the metadata database. Disabling it is only safe if the node
+	// can be resynced: after an OS crash the recorded versions may point past the
+	// durable end of the node file, which the tree then refuses to open.
+	SyncWrites bool `mapstructure:"sync_writes"`
+
+	// RotationRatio is the growth of the node file, relative to its size after
+	// the last rotation, from which the pruning rewrites the retained versions to a
+	// new file, 0 disables the rotations.
+	RotationRatio float64 `mapstructure:"rotation_ratio"`
+
+	// HashParallelism is the maximum number of subtrees hashed concurrently on
+	// commit, 0 defaults to GOMAXPROCS.
+	HashParallelism int `mapstructure:"hash_parallelism"`
+}
+
+// DefaultConfig returns the default configuration for the flat-file tree.
+func DefaultConfig() *Config {
+	return &Config{
+		SyncWrites:      true,
+		RotationRatio:   2,
+		HashParallelism: 0,
+	}
+}
@@ -0,0 +1,216 @@
+package flat
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var (
+	_ commitment.Exporter = (*Exporter)(nil)
+	_ commitment.Importer = (*Importer)(nil)
+)
+
+var errExportClosed = errors.New("exporter is closed")
+
+// Exporter exports the nodes of a version in post-order, the same way as the
+// IAVL exporter, so that the snapshots can be imported in an IAVL tree.
+type Exporter struct {
+	tree  *Tree
+	ch    chan *snapshotstypes.SnapshotIAVLItem
+	done  chan struct{}
+	err   error
+	wg    sync.WaitGroup
+	close sync.Once
+}
+
+func newExporter(tree *Tree, root *node) *Exporter {
+	e := &Exporter{
+		tree: tree,
+		ch:   make(chan *snapshotstypes.SnapshotIAVLItem, 64),
+		done: make(chan struct{}),
+	}
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer close(e.ch)
+
+		if root != nil {
+			e.err = e.export(root)
+		}
+	}()
+
+	return e
+}
+
+// export sends the nodes of the subtree to the channel, children first.
+func (e *Exporter) export(n *node) error {
+	if !n.isLeaf() {
+		// the node file is only read under the tree lock as a commit may remap it
+		e.tree.mtx.RLock()
+		left, err := e.tree.loadNode(n.leftAddr)
+		var right *node
+		if err == nil {
+			right, err = e.tree.loadNode(n.rightAddr)
+		}
+		e.tree.mtx.RUnlock()
+		if err != nil {
+			return err
+		}
+
+		if err := e.export(left); err != nil {
+			return err
+		}
+		if err := e.export(right); err != nil {
+			return err
+		}
+	}
+
+	item := &snapshotstypes.SnapshotIAVLItem{
+		Key:     n.key,
+		Value:   n.value,
+		Version: n.version,
+		Height:  int32(n.height),
+	}
+	select {
+	case e.ch <- item:
+		return nil
+	case <-e.done:
+		return errExportClosed
+	}
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	item, ok := <-e.ch
+	if !ok {
+		if e.err != nil {
+			return nil, e.err
+		}
+		return nil, commitment.ErrorExportDone
+	}
+	return item, nil
+}
+
+// Close stops the exporter.
+func (e *Exporter) Close() error {
+	e.close.Do(func() {
+		close(e.done)
+		e.wg.Wait()
+
+		e.tree.mtx.Lock()
+		e.tree.exporters--
+		e.tree.mtx.Unlock()
+	})
+	return nil
+}
+
+// Importer imports the nodes exported in post-order, see Exporter, writing them
+// to the node file as they are added.
+type Importer struct {
+	tree    *Tree
+	version uint64
+	writer  *nodeWriter
+	// start is the size of the node file before the import.
+	start     uint64
+	committed bool
+
+	// stack holds the imported subtrees not yet attached to their parent.
+	stack []*node
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item == nil {
+		return errors.New("node cannot be nil")
+	}
+	if item.Version > int64(i.version) {
+		return fmt.Errorf("node version %d can't be greater than the import version %d", item.Version, i.version)
+	}
+
+	n := &node{
+		key:     item.Key,
+		version: item.Version,
+		height:  int8(item.Height),
+		size:    1,
+	}
+	if n.isLeaf() {
+		n.value = item.Value
+		n.hash = n.computeHash(n.version, nil, nil)
+	} else {
+		if len(i.stack) < 2 {
+			return fmt.Errorf("missing the children of the node at height %d", n.height)
+		}
+		left, right := i.stack[len(i.stack)-2], i.stack[len(i.stack)-1]
+		i.stack = i.stack[:len(i.stack)-2]
+		if n.height != max(left.height, right.height)+1 {
+			return fmt.Errorf("invalid node height %d for children of heights %d and %d", n.height, left.height, right.height)
+		}
+		n.size = left.size + right.size
+		n.leftAddr, n.rightAddr = left.addr, right.addr
+		n.hash = n.computeHash(n.version, left.hash, right.hash)
+	}
+
+	i.tree.mtx.Lock()
+	defer i.tree.mtx.Unlock()
+
+	addr, err := i.writer.write(n)
+	if err != nil {
+		return err
+	}
+
+	// only the fields needed by the parent are kept
+	i.stack = append(i.stack, &node{
+		hash:   n.hash,
+		size:   n.size,
+		height: n.height,
+		addr:   addr,
+	})
+	return nil
+}
+
+// Commit saves the imported tree as the import version.
+func (i *Importer) Commit() error {
+	if len(i.stack) > 1 {
+		return fmt.Errorf("invalid import: %d subtrees are not attached to a root", len(i.stack))
+	}
+
+	t := i.tree
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if err := i.writer.flush(); err != nil {
+		return err
+	}
+	record := rootRecord{hash: emptyHash, end: t.file.size}
+	if len(i.stack) == 1 {
+		record.addr, record.hash = i.stack[0].addr, i.stack[0].hash
+	}
+	if err := t.file.sync(); err != nil {
+		return err
+	}
+	if err := t.db.Set(rootKey(i.version), encodeRootRecord(record)); err != nil {
+		return err
+	}
+
+	i.committed = true
+	return t.loadRoot(i.version, record)
+}
+
+// Close closes the importer, discarding the nodes of an uncommitted import.
+func (i *Importer) Close() error {
+	i.stack = nil
+	if i.committed {
+		return nil
+	}
+
+	i.tree.mtx.Lock()
+	defer i.tree.mtx.Unlock()
+
+	i.writer.buf = nil
+	return i.tree.file.truncate(i.start)
+}
@@ -0,0 +1,198 @@
+package flat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	// fileMagic identifies a node file, it is followed by the format version.
+	fileMagic = "FLATNODE"
+	// fileFormat is the version of the node encoding.
+	fileFormat uint32 = 1
+	// headerSize is the size of the node file header, the address 0 is never the
+	// one of a node.
+	headerSize = 16
+
+	// minMapSize is the minimum size of the mapping of a node file, it is grown
+	// by doubling the file size so that the remaps are infrequent.
+	minMapSize = 64 << 20
+	// flushSize is the size from which the buffered nodes are written to the file.
+	flushSize = 4 << 20
+)
+
+// nodeFile is an append-only file of nodes, addressed by their offset in the
+// file and read through a memory mapping.
+type nodeFile struct {
+	f    *os.File
+	path string
+
+	// size is the size of the written nodes, the data past it is not valid.
+	size uint64
+	// data is the mapping of the file, its length is the mapping capacity.
+	data []byte
+}
+
+// createNodeFile creates a new node file, truncating any existing one.
+func createNodeFile(path string) (*nodeFile, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, fileMagic)
+	binary.BigEndian.PutUint32(header[len(fileMagic):], fileFormat)
+	if _, err := f.WriteAt(header, 0); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+
+	nf := &nodeFile{f: f, path: path, size: headerSize}
+	if err := nf.remap(); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return nf, nil
+}
+
+// openNodeFile opens the node file at the given path, truncating it to the given
+// size to discard the nodes of an interrupted commit.
+func openNodeFile(path string, size uint64) (*nodeFile, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read the header of %s: %w", path, err), f.Close())
+	}
+	if !bytes.Equal(header[:len(fileMagic)], []byte(fileMagic)) {
+		return nil, errors.Join(fmt.Errorf("%s is not a node file", path), f.Close())
+	}
+	if format := binary.BigEndian.Uint32(header[len(fileMagic):]); format != fileFormat {
+		return nil, errors.Join(fmt.Errorf("unsupported node file format %d", format), f.Close())
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	if uint64(info.Size()) < size {
+		return nil, errors.Join(fmt.Errorf("node file %s is truncated: size %d, expected %d", path, info.Size(), size), f.Close())
+	}
+	if size < headerSize {
+		size = headerSize
+	}
+	if uint64(info.Size()) > size {
+		if err := f.Truncate(int64(size)); err != nil {
+			return nil, errors.Join(err, f.Close())
+		}
+	}
+
+	nf := &nodeFile{f: f, path: path, size: size}
+	if err := nf.remap(); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return nf, nil
+}
+
+// remap maps the file with a capacity of at least twice its size.
+func (nf *nodeFile) remap() error {
+	capacity := 2 * nf.size
+	if capacity < minMapSize {
+		capacity = minMapSize
+	}
+
+	data, err := mmap(nf.f, int(nf.size), int(capacity))
+	if err != nil {
+		return fmt.Errorf("failed to map %s: %w", nf.path, err)
+	}
+	if nf.data != nil {
+		if err := munmap(nf.data); err != nil {
+			return errors.Join(err, munmap(data))
+		}
+	}
+	nf.data = data
+	return nil
+}
+
+// append writes the given encoded nodes at the end of the file.
+func (nf *nodeFile) append(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	if _, err := nf.f.WriteAt(b, int64(nf.size)); err != nil {
+		return err
+	}
+
+	end := nf.size + uint64(len(b))
+	if end > uint64(len(nf.data)) {
+		nf.size = end
+		return nf.remap()
+	}
+	if !mmapSupported {
+		copy(nf.data[nf.size:end], b)
+	}
+	nf.size = end
+	return nil
+}
+
+// truncate discards the nodes past the given size.
+func (nf *nodeFile) truncate(size uint64) error {
+	if size >= nf.size {
+		return nil
+	}
+	if err := nf.f.Truncate(int64(size)); err != nil {
+		return err
+	}
+	nf.size = size
+	return nil
+}
+
+// read returns the encoded node at the given address, the returned bytes are
+// only valid until the next remap.
+func (nf *nodeFile) read(addr uint64) ([]byte, error) {
+	if addr < headerSize || addr >= nf.size {
+		return nil, fmt.Errorf("node address %d out of the bounds of %s", addr, nf.path)
+	}
+	return nf.data[addr:nf.size], nil
+}
+
+// sync flushes the file to the disk.
+func (nf *nodeFile) sync() error {
+	return nf.f.Sync()
+}
+
+// close unmaps and closes the file.
+func (nf *nodeFile) close() error {
+	err := munmap(nf.data)
+	nf.data = nil
+	return errors.Join(err, nf.f.Close())
+}
+
+// nodeWriter buffers the encoded nodes appended to a node file, assigning them
+// their address in the file.
+type nodeWriter struct {
+	file *nodeFile
+	buf  []byte
+}
+
+// write encodes the given node and returns its address.
+func (w *nodeWriter) write(n *node) (uint64, error) {
+	addr := w.file.size + uint64(len(w.buf))
+	w.buf = n.encode(w.buf)
+	if len(w.buf) >= flushSize {
+		return addr, w.flush()
+	}
+	return addr, nil
+}
+
+// flush appends the buffered nodes to the file.
+func (w *nodeWriter) flush() error {
+	err := w.file.append(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
@@ -0,0 +1,27 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package flat
+
+import (
+	"io"
+	"os"
+)
+
+// mmapSupported is false as the platform has no mmap, the node file is read in
+// memory and the appended nodes are copied to it.
+const mmapSupported = false
+
+// mmap reads the first size bytes of the given file in a buffer of the given
+// capacity.
+func mmap(f *os.File, size, capacity int) ([]byte, error) {
+	data := make([]byte, size, capacity)
+	if _, err := f.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data[:capacity], nil
+}
+
+// munmap is a no-op, the buffer is garbage collected.
+func munmap([]byte) error {
+	return nil
+}
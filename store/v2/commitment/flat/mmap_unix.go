@@ -0,0 +1,23 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package flat
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmapSupported is true as the node file is memory-mapped, the appended nodes
+// being visible through the shared mapping.
+const mmapSupported = true
+
+// mmap maps the first capacity bytes of the given file read-only, the mapping may
+// extend past the end of the file.
+func mmap(f *os.File, _, capacity int) ([]byte, error) {
+	return syscall.Mmap(int(f.Fd()), 0, capacity, syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// munmap releases the given mapping.
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,348 @@
+package flat
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+)
+
+// The working tree is updated the same way as the IAVL one, the nodes along the
+// updated paths are cloned into working nodes and rebalanced, so that the same
+// changes result in the same tree structure and hash.
+
+var errCloneLeafNode = errors.New("attempt to clone a leaf node")
+
+// leftNode returns the left child of the given inner node.
+func (t *Tree) leftNode(n *node) (*node, error) {
+	if n.left != nil {
+		return n.left, nil
+	}
+	return t.loadNode(n.leftAddr)
+}
+
+// rightNode returns the right child of the given inner node.
+func (t *Tree) rightNode(n *node) (*node, error) {
+	if n.right != nil {
+		return n.right, nil
+	}
+	return t.loadNode(n.rightAddr)
+}
+
+// clone returns a working copy of the given inner node.
+func (t *Tree) clone(n *node) (*node, error) {
+	if n.isLeaf() {
+		return nil, errCloneLeafNode
+	}
+
+	left, err := t.leftNode(n)
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.rightNode(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &node{
+		key:    n.key,
+		height: n.height,
+		size:   n.size,
+		left:   left,
+		right:  right,
+	}, nil
+}
+
+func (t *Tree) calcHeightAndSize(n *node) error {
+	left, err := t.leftNode(n)
+	if err != nil {
+		return err
+	}
+	right, err := t.rightNode(n)
+	if err != nil {
+		return err
+	}
+
+	n.height = max(left.height, right.height) + 1
+	n.size = left.size + right.size
+	return nil
+}
+
+func (t *Tree) calcBalance(n *node) (int, error) {
+	left, err := t.leftNode(n)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.rightNode(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(left.height) - int(right.height), nil
+}
+
+func (t *Tree) set(key, value []byte) error {
+	if t.root == nil {
+		t.root = newLeaf(key, value)
+		return nil
+	}
+
+	root, _, err := t.recursiveSet(t.root, key, value)
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+func (t *Tree) recursiveSet(n *node, key, value []byte) (newSelf *node, updated bool, err error) {
+	if n.isLeaf() {
+		switch bytes.Compare(key, n.key) {
+		case -1:
+			return &node{
+				key:    n.key,
+				height: 1,
+				size:   2,
+				left:   newLeaf(key, value),
+				right:  n,
+			}, false, nil
+		case 1:
+			return &node{
+				key:    key,
+				height: 1,
+				size:   2,
+				left:   n,
+				right:  newLeaf(key, value),
+			}, false, nil
+		default:
+			return newLeaf(key, value), true, nil
+		}
+	}
+
+	n, err = t.clone(n)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if bytes.Compare(key, n.key) < 0 {
+		n.left, updated, err = t.recursiveSet(n.left, key, value)
+	} else {
+		n.right, updated, err = t.recursiveSet(n.right, key, value)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if updated {
+		return n, true, nil
+	}
+	if err := t.calcHeightAndSize(n); err != nil {
+		return nil, false, err
+	}
+	n, err = t.balance(n)
+	return n, false, err
+}
+
+func (t *Tree) remove(key []byte) error {
+	if t.root == nil {
+		return nil
+	}
+
+	root, _, removed, err := t.recursiveRemove(t.root, key)
+	if err != nil {
+		return err
+	}
+	if removed {
+		t.root = root
+	}
+	return nil
+}
+
+// recursiveRemove removes the given key from the subtree, returning the node
+// replacing the subtree and the new leftmost key of the subtree if it changed.
+func (t *Tree) recursiveRemove(n *node, key []byte) (newSelf *node, newKey []byte, removed bool, err error) {
+	if n.isLeaf() {
+		if bytes.Equal(key, n.key) {
+			return nil, nil, true, nil
+		}
+		return n, nil, false, nil
+	}
+
+	n, err = t.clone(n)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if bytes.Compare(key, n.key) < 0 {
+		newLeft, newKey, removed, err := t.recursiveRemove(n.left, key)
+		if err != nil || !removed {
+			return n, nil, removed, err
+		}
+		if newLeft == nil { // the left leaf was removed
+			return n.right, n.key, true, nil
+		}
+
+		n.left = newLeft
+		if err := t.calcHeightAndSize(n); err != nil {
+			return nil, nil, false, err
+		}
+		n, err = t.balance(n)
+		return n, newKey, true, err
+	}
+
+	newRight, newKey, removed, err := t.recursiveRemove(n.right, key)
+	if err != nil || !removed {
+		return n, nil, removed, err
+	}
+	if newRight == nil { // the right leaf was removed
+		return n.left, nil, true, nil
+	}
+
+	n.right = newRight
+	if newKey != nil {
+		n.key = newKey
+	}
+	if err := t.calcHeightAndSize(n); err != nil {
+		return nil, nil, false, err
+	}
+	n, err = t.balance(n)
+	return n, nil, true, err
+}
+
+func (t *Tree) rotateRight(n *node) (*node, error) {
+	n, err := t.clone(n)
+	if err != nil {
+		return nil, err
+	}
+	newNode, err := t.clone(n.left)
+	if err != nil {
+		return nil, err
+	}
+
+	n.left = newNode.right
+	newNode.right = n
+
+	if err := t.calcHeightAndSize(n); err != nil {
+		return nil, err
+	}
+	if err := t.calcHeightAndSize(newNode); err != nil {
+		return nil, err
+	}
+	return newNode, nil
+}
+
+func (t *Tree) rotateLeft(n *node) (*node, error) {
+	n, err := t.clone(n)
+	if err != nil {
+		return nil, err
+	}
+	newNode, err := t.clone(n.right)
+	if err != nil {
+		return nil, err
+	}
+
+	n.right = newNode.left
+	newNode.left = n
+
+	if err := t.calcHeightAndSize(n); err != nil {
+		return nil, err
+	}
+	if err := t.calcHeightAndSize(newNode); err != nil {
+		return nil, err
+	}
+	return newNode, nil
+}
+
+// balance rebalances the given working node.
+func (t *Tree) balance(n *node) (*node, error) {
+	balance, err := t.calcBalance(n)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case balance > 1:
+		leftBalance, err := t.calcBalance(n.left)
+		if err != nil {
+			return nil, err
+		}
+		if leftBalance < 0 {
+			if n.left, err = t.rotateLeft(n.left); err != nil {
+				return nil, err
+			}
+		}
+		return t.rotateRight(n)
+
+	case balance < -1:
+		rightBalance, err := t.calcBalance(n.right)
+		if err != nil {
+			return nil, err
+		}
+		if rightBalance > 0 {
+			if n.right, err = t.rotateRight(n.right); err != nil {
+				return nil, err
+			}
+		}
+		return t.rotateLeft(n)
+	}
+
+	return n, nil
+}
+
+// hashNode computes the hashes of the working nodes of the subtree at the given
+// version, hashing the subtrees of the working nodes at a depth lower than
+// hashDepth concurrently.
+func (t *Tree) hashNode(n *node, version int64, depth int) []byte {
+	if n.hash != nil {
+		return n.hash
+	}
+
+	if !n.isLeaf() {
+		if depth < t.hashDepth && n.left.hash == nil && n.right.hash == nil {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				t.hashNode(n.left, version, depth+1)
+			}()
+			t.hashNode(n.right, version, depth+1)
+			wg.Wait()
+		} else {
+			t.hashNode(n.left, version, depth+1)
+			t.hashNode(n.right, version, depth+1)
+		}
+	}
+
+	n.version = version
+	if n.isLeaf() {
+		n.hash = n.computeHash(version, nil, nil)
+	} else {
+		n.hash = n.computeHash(version, n.left.hash, n.right.hash)
+	}
+	return n.hash
+}
+
+// saveNode writes the working nodes of the subtree to the node writer, children
+// first, turning them into persisted nodes. It returns the address of the node.
+func (t *Tree) saveNode(w *nodeWriter, n *node) (uint64, error) {
+	if n.addr != 0 {
+		return n.addr, nil
+	}
+
+	if !n.isLeaf() {
+		var err error
+		if n.leftAddr, err = t.saveNode(w, n.left); err != nil {
+			return 0, err
+		}
+		if n.rightAddr, err = t.saveNode(w, n.right); err != nil {
+			return 0, err
+		}
+	}
+
+	addr, err := w.write(n)
+	if err != nil {
+		return 0, err
+	}
+	n.addr = addr
+	n.left, n.right = nil, nil
+	return addr, nil
+}
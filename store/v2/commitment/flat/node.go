@@ -0,0 +1,151 @@
+package flat
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// emptyHash is the hash of an empty tree.
+var emptyHash = sha256.New().Sum(nil)
+
+// node is a node of the tree. The nodes are hashed the same way as the IAVL ones
+// so that the proofs are verified with the IAVL ics23 spec.
+//
+// A node is either persisted, when it has an address in the node file, or part
+// of the working tree, in which case it holds pointers to its children.
+type node struct {
+	key     []byte
+	value   []byte
+	hash    []byte
+	version int64
+	size    int64
+	height  int8
+
+	// addr is the address of the node in the node file, 0 if it is not persisted.
+	addr uint64
+	// leftAddr and rightAddr are the addresses of the children of a persisted node.
+	leftAddr, rightAddr uint64
+	// left and right are the children of a working node.
+	left, right *node
+}
+
+// newLeaf creates a new leaf node.
+func newLeaf(key, value []byte) *node {
+	return &node{
+		key:   key,
+		value: value,
+		size:  1,
+	}
+}
+
+func (n *node) isLeaf() bool {
+	return n.height == 0
+}
+
+// computeHash computes the hash of the node at the given version from the hashes
+// of its children, see iavl.Node.writeHashBytes.
+func (n *node) computeHash(version int64, leftHash, rightHash []byte) []byte {
+	buf := nodeHashPrefix(n.height, n.size, version)
+	if n.isLeaf() {
+		valueHash := sha256.Sum256(n.value)
+		buf = binary.AppendUvarint(buf, uint64(len(n.key)))
+		buf = append(buf, n.key...)
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, valueHash[:]...)
+	} else {
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, leftHash...)
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, rightHash...)
+	}
+
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
+// encode appends the encoding of the persisted node to the given buffer:
+//
+//	height (1) | version (uvarint) | size (uvarint) | hash (32) | key length (uvarint) | key |
+//	leaf: value length (uvarint) | value
+//	inner: left address (8) | right address (8)
+func (n *node) encode(buf []byte) []byte {
+	buf = append(buf, byte(n.height))
+	buf = binary.AppendUvarint(buf, uint64(n.version))
+	buf = binary.AppendUvarint(buf, uint64(n.size))
+	buf = append(buf, n.hash...)
+	buf = binary.AppendUvarint(buf, uint64(len(n.key)))
+	buf = append(buf, n.key...)
+	if n.isLeaf() {
+		buf = binary.AppendUvarint(buf, uint64(len(n.value)))
+		buf = append(buf, n.value...)
+	} else {
+		buf = binary.BigEndian.AppendUint64(buf, n.leftAddr)
+		buf = binary.BigEndian.AppendUint64(buf, n.rightAddr)
+	}
+	return buf
+}
+
+var errCorruptedNode = errors.New("corrupted node")
+
+// decodeNode decodes the node at the given address from its encoding, the keys
+// and values are copied as the encoding points to the file mapping.
+func decodeNode(addr uint64, bz []byte) (*node, error) {
+	if len(bz) < 1 {
+		return nil, fmt.Errorf("%w at %d: empty encoding", errCorruptedNode, addr)
+	}
+	n := &node{addr: addr, height: int8(bz[0])}
+	bz = bz[1:]
+
+	version, read := binary.Uvarint(bz)
+	if read <= 0 {
+		return nil, fmt.Errorf("%w at %d: invalid version", errCorruptedNode, addr)
+	}
+	bz = bz[read:]
+	size, read := binary.Uvarint(bz)
+	if read <= 0 {
+		return nil, fmt.Errorf("%w at %d: invalid size", errCorruptedNode, addr)
+	}
+	bz = bz[read:]
+	n.version, n.size = int64(version), int64(size)
+
+	if len(bz) < sha256.Size {
+		return nil, fmt.Errorf("%w at %d: invalid hash", errCorruptedNode, addr)
+	}
+	n.hash = append([]byte(nil), bz[:sha256.Size]...)
+	bz = bz[sha256.Size:]
+
+	key, bz, err := decodeBytes(bz)
+	if err != nil {
+		return nil, fmt.Errorf("%w at %d: invalid key: %w", errCorruptedNode, addr, err)
+	}
+	n.key = key
+
+	if n.isLeaf() {
+		if n.value, _, err = decodeBytes(bz); err != nil {
+			return nil, fmt.Errorf("%w at %d: invalid value: %w", errCorruptedNode, addr, err)
+		}
+		return n, nil
+	}
+
+	if len(bz) < 16 {
+		return nil, fmt.Errorf("%w at %d: invalid children", errCorruptedNode, addr)
+	}
+	n.leftAddr = binary.BigEndian.Uint64(bz)
+	n.rightAddr = binary.BigEndian.Uint64(bz[8:])
+	return n, nil
+}
+
+// decodeBytes decodes a copy of length-prefixed bytes, returning the remaining bytes.
+func decodeBytes(bz []byte) ([]byte, []byte, error) {
+	size, read := binary.Uvarint(bz)
+	if read <= 0 {
+		return nil, nil, errors.New("invalid length")
+	}
+	bz = bz[read:]
+	if uint64(len(bz)) < size {
+		return nil, nil, fmt.Errorf("length %d exceeds the remaining %d bytes", size, len(bz))
+	}
+	return append([]byte{}, bz[:size]...), bz[size:], nil
+}
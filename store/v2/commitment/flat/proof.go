@@ -0,0 +1,115 @@
+package flat
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// getProof returns the existence proof of the given key in the subtree, or its
+// non-existence proof if the key does not exist. The proofs are the same as the
+// IAVL ones, see iavl.ImmutableTree.GetProof.
+func (t *Tree) getProof(root *node, key []byte) (*ics23.CommitmentProof, error) {
+	index, value, err := t.get(root, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if value != nil {
+		exist, err := t.existenceProof(root, key)
+		if err != nil {
+			return nil, err
+		}
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: exist},
+		}, nil
+	}
+
+	nonexist := &ics23.NonExistenceProof{Key: key}
+	if index >= 1 {
+		leftKey, err := t.getByIndex(root, index-1)
+		if err != nil {
+			return nil, err
+		}
+		if nonexist.Left, err = t.existenceProof(root, leftKey); err != nil {
+			return nil, err
+		}
+	}
+	rightKey, err := t.getByIndex(root, index)
+	if err != nil {
+		return nil, err
+	}
+	if rightKey != nil {
+		if nonexist.Right, err = t.existenceProof(root, rightKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist},
+	}, nil
+}
+
+// existenceProof returns the existence proof of the given key in the subtree.
+func (t *Tree) existenceProof(root *node, key []byte) (*ics23.ExistenceProof, error) {
+	// the inner ops go from the leaf to the root
+	var path []*ics23.InnerOp
+	n := root
+	for !n.isLeaf() {
+		left, err := t.leftNode(n)
+		if err != nil {
+			return nil, err
+		}
+		right, err := t.rightNode(n)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := nodeHashPrefix(n.height, n.size, n.version)
+		var suffix []byte
+		if bytes.Compare(key, n.key) < 0 {
+			prefix = append(prefix, sha256.Size)
+			suffix = append([]byte{sha256.Size}, right.hash...)
+			n = left
+		} else {
+			prefix = append(prefix, sha256.Size)
+			prefix = append(prefix, left.hash...)
+			prefix = append(prefix, sha256.Size)
+			n = right
+		}
+		path = append(path, &ics23.InnerOp{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: prefix,
+			Suffix: suffix,
+		})
+	}
+	if !bytes.Equal(n.key, key) {
+		return nil, fmt.Errorf("key %X not found", key)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   n.key,
+		Value: n.value,
+		Leaf: &ics23.LeafOp{
+			Hash:         ics23.HashOp_SHA256,
+			PrehashValue: ics23.HashOp_SHA256,
+			Length:       ics23.LengthOp_VAR_PROTO,
+			Prefix:       nodeHashPrefix(0, 1, n.version),
+		},
+		Path: path,
+	}, nil
+}
+
+// nodeHashPrefix returns the prefix of the hashed bytes of a node.
+func nodeHashPrefix(height int8, size, version int64) []byte {
+	prefix := binary.AppendVarint(nil, int64(height))
+	prefix = binary.AppendVarint(prefix, size)
+	return binary.AppendVarint(prefix, version)
+}
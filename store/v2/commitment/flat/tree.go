@@ -0,0 +1,736 @@
+package flat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+)
+
+var (
+	_ commitment.Tree = (*Tree)(nil)
+	_ store.Pruner    = (*Tree)(nil)
+)
+
+const (
+	// metaKey is the key of the tree metadata: the generation of the node file
+	// and its size after the last rotation.
+	metaKey = "m"
+	// rootKeyPrefix is the prefix of the root records, by version.
+	rootKeyPrefix = "r"
+)
+
+// Tree is an implementation of commitment.Tree backed by an append-only,
+// memory-mapped file of nodes. The nodes are addressed by their offset in the
+// file, a version being recorded in the metadata database as the address of its
+// root and the size of the file after its commit.
+//
+// The tree is structured and hashed like an IAVL tree, so that the same changes
+// result in the same hash, the proofs are verified with the IAVL ics23 spec and
+// the snapshots are interchangeable with the IAVL ones.
+//
+// The pruned versions are only removed from the metadata, the nodes being
+// reclaimed by rotating the node file: the nodes of the retained versions are
+// rewritten to a new file and the previous one is removed.
+type Tree struct {
+	logger log.Logger
+	db     corestore.KVStoreWithBatch
+	dir    string
+	cfg    *Config
+
+	// hashDepth is the depth up to which the working subtrees are hashed
+	// concurrently.
+	hashDepth int
+
+	mtx sync.RWMutex
+	// file is the node file of the current generation.
+	file *nodeFile
+	// generation is the generation of the node file, incremented on rotation.
+	generation uint64
+	// rotatedSize is the size of the node file after the last rotation.
+	rotatedSize uint64
+
+	// version is the latest saved version and hash its root hash.
+	version uint64
+	hash    []byte
+	// initialVersion is the version of the first commit if set.
+	initialVersion uint64
+	// root is the root of the working tree, nil if the tree is empty.
+	root *node
+	// exporters is the number of running exporters, the rotations are deferred
+	// while there are any as they change the node addresses.
+	exporters int
+}
+
+// rootRecord is the record of a saved version.
+type rootRecord struct {
+	// addr is the address of the root node, 0 if the tree is empty.
+	addr uint64
+	// end is the size of the node file after the version was saved.
+	end  uint64
+	hash []byte
+}
+
+// NewTree opens the tree stored in the given directory, with its metadata in the
+// given database. The nodes of an interrupted commit are discarded.
+func NewTree(dir string, db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config) (*Tree, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	parallelism := cfg.HashParallelism
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	t := &Tree{
+		logger:    logger.With("module", "flat_tree"),
+		db:        db,
+		dir:       dir,
+		cfg:       cfg,
+		hashDepth: bits.Len(uint(parallelism - 1)),
+		hash:      emptyHash,
+	}
+
+	bz, err := db.Get([]byte(metaKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 16 {
+		t.generation = binary.BigEndian.Uint64(bz)
+		t.rotatedSize = binary.BigEndian.Uint64(bz[8:])
+	}
+
+	version, record, err := t.latestRoot()
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		t.file, err = createNodeFile(t.filePath(t.generation))
+	} else {
+		t.file, err = openNodeFile(t.filePath(t.generation), record.end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t.rotatedSize == 0 {
+		t.rotatedSize = t.file.size
+	}
+	if err := t.removeStaleFiles(); err != nil {
+		return nil, errors.Join(err, t.file.close())
+	}
+
+	if version > 0 {
+		if err := t.loadRoot(version, record); err != nil {
+			return nil, errors.Join(err, t.file.close())
+		}
+	}
+
+	return t, nil
+}
+
+func (t *Tree) filePath(generation uint64) string {
+	return filepath.Join(t.dir, fmt.Sprintf("nodes-%06d.dat", generation))
+}
+
+// removeStaleFiles removes the node files of the other generations, left over
+// by an interrupted rotation.
+func (t *Tree) removeStaleFiles() error {
+	paths, err := filepath.Glob(filepath.Join(t.dir, "nodes-*.dat"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if path == t.file.path {
+			continue
+		}
+		t.logger.Info("removing stale node file", "path", path)
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rootKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(rootKeyPrefix), version)
+}
+
+func encodeRootRecord(r rootRecord) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, r.addr)
+	bz = binary.BigEndian.AppendUint64(bz, r.end)
+	return append(bz, r.hash...)
+}
+
+func decodeRootRecord(bz []byte) (rootRecord, error) {
+	if len(bz) < 16 {
+		return rootRecord{}, fmt.Errorf("invalid root record of length %d", len(bz))
+	}
+	return rootRecord{
+		addr: binary.BigEndian.Uint64(bz),
+		end:  binary.BigEndian.Uint64(bz[8:]),
+		hash: bz[16:],
+	}, nil
+}
+
+// getRoot returns the root record of the given version, false if the version
+// does not exist.
+func (t *Tree) getRoot(version uint64) (rootRecord, bool, error) {
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil || bz == nil {
+		return rootRecord{}, false, err
+	}
+	record, err := decodeRootRecord(bz)
+	return record, err == nil, err
+}
+
+// latestRoot returns the latest saved version and its root record, 0 if there is
+// no saved version.
+func (t *Tree) latestRoot() (uint64, rootRecord, error) {
+	iter, err := t.db.ReverseIterator([]byte(rootKeyPrefix), prefixEnd(rootKeyPrefix))
+	if err != nil {
+		return 0, rootRecord{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, rootRecord{}, iter.Error()
+	}
+	record, err := decodeRootRecord(iter.Value())
+	if err != nil {
+		return 0, rootRecord{}, err
+	}
+	record.hash = bytes.Clone(record.hash)
+	return binary.BigEndian.Uint64(iter.Key()[len(rootKeyPrefix):]), record, nil
+}
+
+// versions returns the saved versions and their root records in ascending order.
+func (t *Tree) versions() ([]uint64, []rootRecord, error) {
+	iter, err := t.db.Iterator([]byte(rootKeyPrefix), prefixEnd(rootKeyPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		versions []uint64
+		records  []rootRecord
+	)
+	for ; iter.Valid(); iter.Next() {
+		record, err := decodeRootRecord(iter.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+		versions = append(versions, binary.BigEndian.Uint64(iter.Key()[len(rootKeyPrefix):]))
+		records = append(records, rootRecord{addr: record.addr, end: record.end, hash: bytes.Clone(record.hash)})
+	}
+	return versions, records, iter.Error()
+}
+
+func prefixEnd(prefix string) []byte {
+	end := []byte(prefix)
+	end[len(end)-1]++
+	return end
+}
+
+// loadRoot sets the working tree to the saved version.
+func (t *Tree) loadRoot(version uint64, record rootRecord) error {
+	root, err := t.loadNode(record.addr)
+	if err != nil {
+		return err
+	}
+	t.version = version
+	t.hash = bytes.Clone(record.hash)
+	t.root = root
+	return nil
+}
+
+// loadNode decodes the persisted node at the given address, nil for the address 0.
+func (t *Tree) loadNode(addr uint64) (*node, error) {
+	if addr == 0 {
+		return nil, nil
+	}
+	bz, err := t.file.read(addr)
+	if err != nil {
+		return nil, err
+	}
+	return decodeNode(addr, bz)
+}
+
+// loadVersionRoot returns the root node of the given saved version.
+func (t *Tree) loadVersionRoot(version uint64) (*node, error) {
+	record, ok, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	return t.loadNode(record.addr)
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *Tree) Set(key, value []byte) error {
+	if value == nil {
+		return fmt.Errorf("attempt to store nil value at key '%s'", key)
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.set(key, value)
+}
+
+// Remove removes the given key from the tree.
+func (t *Tree) Remove(key []byte) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.remove(key)
+}
+
+// RemoveRange removes all the keys in the given range from the tree.
+func (t *Tree) RemoveRange(start, end []byte) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	var keys [][]byte
+	if err := t.collectKeys(t.root, start, end, &keys); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := t.remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectKeys collects the keys of the working subtree in the range [start, end),
+// an empty bound meaning the range is unbounded on that side.
+func (t *Tree) collectKeys(n *node, start, end []byte, keys *[][]byte) error {
+	if n == nil {
+		return nil
+	}
+	if n.isLeaf() {
+		if (len(start) == 0 || bytes.Compare(n.key, start) >= 0) && (len(end) == 0 || bytes.Compare(n.key, end) < 0) {
+			*keys = append(*keys, n.key)
+		}
+		return nil
+	}
+
+	// the keys of the left subtree are lower than the node key
+	if len(start) == 0 || bytes.Compare(start, n.key) < 0 {
+		left, err := t.leftNode(n)
+		if err != nil {
+			return err
+		}
+		if err := t.collectKeys(left, start, end, keys); err != nil {
+			return err
+		}
+	}
+	if len(end) == 0 || bytes.Compare(n.key, end) < 0 {
+		right, err := t.rightNode(n)
+		if err != nil {
+			return err
+		}
+		return t.collectKeys(right, start, end, keys)
+	}
+	return nil
+}
+
+// GetLatestVersion returns the latest saved version of the tree.
+func (t *Tree) GetLatestVersion() uint64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.version
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *Tree) Hash() []byte {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.hash
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *Tree) WorkingHash() []byte {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.root == nil {
+		return emptyHash
+	}
+	return t.hashNode(t.root, int64(t.workingVersion()), 0)
+}
+
+func (t *Tree) workingVersion() uint64 {
+	if t.version == 0 && t.initialVersion > 0 {
+		return t.initialVersion
+	}
+	return t.version + 1
+}
+
+// LoadVersion loads the given version, discarding the working changes and the
+// later versions. The version 0 loads the latest version.
+func (t *Tree) LoadVersion(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	latest, latestRecord, err := t.latestRoot()
+	if err != nil {
+		return err
+	}
+	if version == 0 || version == latest {
+		if latest == 0 {
+			t.root, t.version, t.hash = nil, 0, emptyHash
+			return nil
+		}
+		return t.loadRoot(latest, latestRecord)
+	}
+
+	record, ok, err := t.getRoot(version)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+
+	// the nodes of the later versions are appended after the ones of the version
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	for v := version + 1; v <= latest; v++ {
+		if err := batch.Delete(rootKey(v)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	if err := t.file.truncate(record.end); err != nil {
+		return err
+	}
+
+	return t.loadRoot(version, record)
+}
+
+// Commit saves the working tree as a new version.
+func (t *Tree) Commit() ([]byte, uint64, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	version := t.workingVersion()
+	record := rootRecord{hash: emptyHash}
+	if t.root != nil {
+		record.hash = t.hashNode(t.root, int64(version), 0)
+
+		w := &nodeWriter{file: t.file}
+		addr, err := t.saveNode(w, t.root)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := w.flush(); err != nil {
+			return nil, 0, err
+		}
+		record.addr = addr
+	}
+	record.end = t.file.size
+
+	if t.cfg.SyncWrites {
+		if err := t.file.sync(); err != nil {
+			return nil, 0, err
+		}
+	}
+	if err := t.db.Set(rootKey(version), encodeRootRecord(record)); err != nil {
+		return nil, 0, err
+	}
+
+	t.version = version
+	t.hash = record.hash
+	return record.hash, version, nil
+}
+
+// SetInitialVersion sets the version of the first commit of the tree.
+func (t *Tree) SetInitialVersion(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.initialVersion = version
+	return nil
+}
+
+// GetProof returns a proof for the given key and version.
+func (t *Tree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	root, err := t.loadVersionRoot(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the root at version %d: %w", version, err)
+	}
+	if root == nil {
+		return nil, errors.New("cannot generate the proof with nil root")
+	}
+
+	return t.getProof(root, key)
+}
+
+// Get returns the value of the given key at the given version.
+func (t *Tree) Get(version uint64, key []byte) ([]byte, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	root, err := t.loadVersionRoot(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the root at version %d: %w", version, err)
+	}
+	if root == nil {
+		return nil, nil
+	}
+
+	_, value, err := t.get(root, key)
+	return value, err
+}
+
+// get returns the index of the given key in the subtree, or the index of the
+// next key if it does not exist, and its value.
+func (t *Tree) get(n *node, key []byte) (int64, []byte, error) {
+	var index int64
+	for !n.isLeaf() {
+		left, err := t.leftNode(n)
+		if err != nil {
+			return 0, nil, err
+		}
+		if bytes.Compare(key, n.key) < 0 {
+			n = left
+			continue
+		}
+		index += left.size
+		if n, err = t.rightNode(n); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	switch bytes.Compare(n.key, key) {
+	case -1:
+		return index + 1, nil, nil
+	case 1:
+		return index, nil, nil
+	default:
+		return index, n.value, nil
+	}
+}
+
+// getByIndex returns the key of the given index in the subtree, nil if the index
+// is out of range.
+func (t *Tree) getByIndex(n *node, index int64) ([]byte, error) {
+	if index < 0 || index >= n.size {
+		return nil, nil
+	}
+	for !n.isLeaf() {
+		left, err := t.leftNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if index < left.size {
+			n = left
+			continue
+		}
+		index -= left.size
+		if n, err = t.rightNode(n); err != nil {
+			return nil, err
+		}
+	}
+	return n.key, nil
+}
+
+// Prune removes all the versions up to and including the given version. The
+// node file is rotated once it has grown by the configured ratio.
+func (t *Tree) Prune(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if version >= t.version {
+		return fmt.Errorf("cannot prune the latest saved version %d", t.version)
+	}
+
+	iter, err := t.db.Iterator([]byte(rootKeyPrefix), rootKey(version+1))
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	if err := errors.Join(iter.Error(), iter.Close()); err != nil {
+		return err
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	if t.cfg.RotationRatio > 0 && float64(t.file.size) >= t.cfg.RotationRatio*float64(t.rotatedSize) {
+		return t.rotate()
+	}
+	return nil
+}
+
+// rotate rewrites the nodes of the saved versions to a node file of the next
+// generation and removes the current one. It is skipped while the working tree
+// has changes or exporters are running, as the node addresses change.
+func (t *Tree) rotate() error {
+	if t.exporters > 0 || (t.root != nil && t.root.addr == 0) {
+		t.logger.Debug("deferring the node file rotation", "exporters", t.exporters)
+		return nil
+	}
+
+	versions, records, err := t.versions()
+	if err != nil {
+		return err
+	}
+
+	generation := t.generation + 1
+	file, err := createNodeFile(t.filePath(generation))
+	if err != nil {
+		return err
+	}
+
+	// the versions are rewritten in ascending order so that the nodes of a version
+	// remain before its end in the file
+	addrs := make(map[uint64]uint64)
+	w := &nodeWriter{file: file}
+	for i := range records {
+		if records[i].addr != 0 {
+			if records[i].addr, err = t.copyNode(w, records[i].addr, addrs); err != nil {
+				return errors.Join(err, file.close())
+			}
+		}
+		if err := w.flush(); err != nil {
+			return errors.Join(err, file.close())
+		}
+		records[i].end = file.size
+	}
+	if err := file.sync(); err != nil {
+		return errors.Join(err, file.close())
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	for i, version := range versions {
+		if err := batch.Set(rootKey(version), encodeRootRecord(records[i])); err != nil {
+			return errors.Join(err, file.close())
+		}
+	}
+	meta := binary.BigEndian.AppendUint64(nil, generation)
+	meta = binary.BigEndian.AppendUint64(meta, file.size)
+	if err := batch.Set([]byte(metaKey), meta); err != nil {
+		return errors.Join(err, file.close())
+	}
+	if err := batch.Write(); err != nil {
+		return errors.Join(err, file.close())
+	}
+
+	prev := t.file
+	t.file, t.generation, t.rotatedSize = file, generation, file.size
+	if err := errors.Join(prev.close(), os.Remove(prev.path)); err != nil {
+		return err
+	}
+	t.logger.Debug("rotated the node file", "generation", generation, "versions", len(versions), "size", file.size, "previous_size", prev.size)
+
+	if len(versions) == 0 {
+		return nil
+	}
+	return t.loadRoot(versions[len(versions)-1], records[len(records)-1])
+}
+
+// copyNode copies the persisted subtree at the given address to the node writer,
+// the nodes already copied being shared. It returns the new address of the node.
+func (t *Tree) copyNode(w *nodeWriter, addr uint64, addrs map[uint64]uint64) (uint64, error) {
+	if newAddr, ok := addrs[addr]; ok {
+		return newAddr, nil
+	}
+
+	n, err := t.loadNode(addr)
+	if err != nil {
+		return 0, err
+	}
+	if !n.isLeaf() {
+		if n.leftAddr, err = t.copyNode(w, n.leftAddr, addrs); err != nil {
+			return 0, err
+		}
+		if n.rightAddr, err = t.copyNode(w, n.rightAddr, addrs); err != nil {
+			return 0, err
+		}
+	}
+
+	newAddr, err := w.write(n)
+	if err != nil {
+		return 0, err
+	}
+	addrs[addr] = newAddr
+	return newAddr, nil
+}
+
+// Export exports the tree at the given version.
+func (t *Tree) Export(version uint64) (commitment.Exporter, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	root, err := t.loadVersionRoot(version)
+	if err != nil {
+		return nil, err
+	}
+	t.exporters++
+
+	return newExporter(t, root), nil
+}
+
+// Import imports the tree at the given version, the tree must be empty.
+func (t *Tree) Import(version uint64) (commitment.Importer, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if version == 0 {
+		return nil, errors.New("imported version must be greater than zero")
+	}
+	if t.version != 0 || t.root != nil {
+		return nil, errors.New("tree must be empty to import")
+	}
+
+	return &Importer{tree: t, version: version, writer: &nodeWriter{file: t.file}, start: t.file.size}, nil
+}
+
+// Close closes the tree.
+func (t *Tree) Close() error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.file == nil {
+		return nil
+	}
+	err := errors.Join(t.file.sync(), t.file.close())
+	t.file = nil
+	return err
+}
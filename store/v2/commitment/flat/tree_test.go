@@ -0,0 +1,203 @@
+package flat
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	iavltree "github.com/cosmos/iavl"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db corestore.KVStoreWithBatch, storeKeys []string, logger log.Logger) (*commitment.CommitStore, error) {
+			dir := t.TempDir()
+			multiTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				tree, err := NewTree(filepath.Join(dir, storeKey), prefixDB, logger, DefaultConfig())
+				if err != nil {
+					return nil, err
+				}
+				multiTrees[storeKey] = tree
+			}
+			return commitment.NewCommitStore(multiTrees, db, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func newTestTree(t *testing.T, dir string, db corestore.KVStoreWithBatch, cfg *Config) *Tree {
+	t.Helper()
+	tree, err := NewTree(dir, db, log.NewNopLogger(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tree.Close() })
+	return tree
+}
+
+// TestTreeIavlCompatibility checks the tree against an IAVL tree receiving the same
+// changes: the hashes, the proofs and the snapshots must be the same.
+func TestTreeIavlCompatibility(t *testing.T) {
+	tree := newTestTree(t, t.TempDir(), dbm.NewMemDB(), DefaultConfig())
+	iavlTree := iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig(), iavltree.AsyncPruningOption(false))
+	require.Equal(t, iavlTree.WorkingHash(), tree.WorkingHash())
+
+	hashes := make(map[uint64][]byte)
+	rng := rand.New(rand.NewSource(1))
+	key := func() []byte { return []byte(fmt.Sprintf("key%03d", rng.Intn(300))) }
+	for version := uint64(1); version <= 30; version++ {
+		for i := 0; i < 50; i++ {
+			switch op := rng.Intn(10); {
+			case op < 6:
+				k, value := key(), []byte(fmt.Sprintf("value%d", rng.Int()))
+				require.NoError(t, tree.Set(k, value))
+				require.NoError(t, iavlTree.Set(k, value))
+			case op < 9:
+				k := key()
+				require.NoError(t, tree.Remove(k))
+				require.NoError(t, iavlTree.Remove(k))
+			default:
+				start := key()
+				end := append(start[:len(start):len(start)], '5')
+				require.NoError(t, tree.RemoveRange(start, end))
+				require.NoError(t, iavlTree.RemoveRange(start, end))
+			}
+		}
+		require.Equal(t, iavlTree.WorkingHash(), tree.WorkingHash(), "version %d", version)
+
+		hash, v, err := tree.Commit()
+		require.NoError(t, err)
+		require.Equal(t, version, v)
+		iavlHash, _, err := iavlTree.Commit()
+		require.NoError(t, err)
+		require.Equal(t, iavlHash, hash, "version %d", version)
+		hashes[version] = hash
+	}
+
+	// the proofs are verified with the IAVL spec against the historical hashes
+	for _, version := range []uint64{10, 30} {
+		for i := 0; i < 300; i++ {
+			k := []byte(fmt.Sprintf("key%03d", i))
+			value, err := tree.Get(version, k)
+			require.NoError(t, err)
+			iavlValue, err := iavlTree.Get(version, k)
+			require.NoError(t, err)
+			require.Equal(t, iavlValue, value)
+
+			p, err := tree.GetProof(version, k)
+			require.NoError(t, err)
+			if value != nil {
+				require.True(t, ics23.VerifyMembership(ics23.IavlSpec, hashes[version], p, k, value), "key %s", k)
+			} else {
+				require.True(t, ics23.VerifyNonMembership(ics23.IavlSpec, hashes[version], p, k), "key %s", k)
+			}
+		}
+	}
+
+	// the snapshots are interchangeable
+	exportTo := func(from, to commitment.Tree) {
+		exporter, err := from.Export(20)
+		require.NoError(t, err)
+		defer exporter.Close()
+		importer, err := to.Import(20)
+		require.NoError(t, err)
+		defer importer.Close()
+		for {
+			item, err := exporter.Next()
+			if errors.Is(err, commitment.ErrorExportDone) {
+				break
+			}
+			require.NoError(t, err)
+			require.NoError(t, importer.Add(item))
+		}
+		require.NoError(t, importer.Commit())
+	}
+	imported := newTestTree(t, t.TempDir(), dbm.NewMemDB(), DefaultConfig())
+	exportTo(iavlTree, imported)
+	iavlImported := iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())
+	exportTo(tree, iavlImported)
+	require.Equal(t, iavlImported.Hash(), imported.Hash())
+	require.Equal(t, uint64(20), imported.GetLatestVersion())
+}
+
+func TestTreeRotation(t *testing.T) {
+	dir, db := t.TempDir(), dbm.NewMemDB()
+	cfg := DefaultConfig()
+	cfg.RotationRatio = 1.5
+	tree := newTestTree(t, dir, db, cfg)
+
+	hashes := make(map[uint64][]byte)
+	for version := uint64(1); version <= 50; version++ {
+		for i := 0; i < 20; i++ {
+			key := []byte(fmt.Sprintf("key%03d", (int(version)*7+i)%100))
+			require.NoError(t, tree.Set(key, []byte(fmt.Sprintf("value%d", version))))
+		}
+		hash, _, err := tree.Commit()
+		require.NoError(t, err)
+		hashes[version] = hash
+
+		if version%10 == 0 {
+			require.NoError(t, tree.Prune(version-5))
+		}
+	}
+	require.NotZero(t, tree.generation)
+
+	// the pruned versions are removed and the node files of the previous
+	// generations are deleted
+	_, err := tree.Get(44, []byte("key000"))
+	require.Error(t, err)
+	paths, err := filepath.Glob(filepath.Join(dir, "nodes-*.dat"))
+	require.NoError(t, err)
+	require.Equal(t, []string{tree.file.path}, paths)
+
+	for version := uint64(46); version <= 50; version++ {
+		p, err := tree.GetProof(version, []byte("key042"))
+		require.NoError(t, err)
+		root, err := p.Calculate()
+		require.NoError(t, err)
+		require.Equal(t, hashes[version], []byte(root))
+	}
+
+	// the reopened tree discards the nodes of an interrupted commit
+	require.NoError(t, tree.Set([]byte("key000"), []byte("uncommitted")))
+	tree.hashNode(tree.root, 51, 0)
+	w := &nodeWriter{file: tree.file}
+	_, err = tree.saveNode(w, tree.root)
+	require.NoError(t, err)
+	require.NoError(t, w.flush())
+	require.NoError(t, tree.Close())
+
+	tree = newTestTree(t, dir, db, cfg)
+	require.Equal(t, uint64(50), tree.GetLatestVersion())
+	require.Equal(t, hashes[50], tree.Hash())
+	info, err := os.Stat(tree.file.path)
+	require.NoError(t, err)
+	require.Equal(t, int64(tree.file.size), info.Size())
+
+	// loading a previous version discards the later ones
+	require.NoError(t, tree.LoadVersion(48))
+	require.Equal(t, hashes[48], tree.Hash())
+	_, err = tree.Get(49, []byte("key000"))
+	require.Error(t, err)
+	for i := 0; i < 20; i++ {
+		key := []byte(fmt.Sprintf("key%03d", (49*7+i)%100))
+		require.NoError(t, tree.Set(key, []byte("value49")))
+	}
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(49), version)
+	require.Equal(t, hashes[49], hash)
+}
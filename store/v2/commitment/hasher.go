@@ -0,0 +1,72 @@
+package commitment
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// emptyTreeHash is the root hash of an empty tree.
+var emptyTreeHash = sha256.New().Sum(nil)
+
+// treeHasher computes the root hash of a tree from its nodes exported in
+// post-order, so that an imported tree can be verified before it is committed.
+type treeHasher struct {
+	// stack holds the hashed subtrees not yet attached to their parent.
+	stack []hashedNode
+}
+
+type hashedNode struct {
+	hash   []byte
+	size   int64
+	height int8
+}
+
+// add hashes the given node, whose children are the last two hashed subtrees
+// when it is an inner node.
+func (h *treeHasher) add(item *snapshotstypes.SnapshotIAVLItem) error {
+	height := int8(item.Height)
+	n := hashedNode{height: height, size: 1}
+	buf := binary.AppendVarint(nil, int64(height))
+	if height == 0 {
+		valueHash := sha256.Sum256(item.Value)
+		buf = binary.AppendVarint(buf, n.size)
+		buf = binary.AppendVarint(buf, item.Version)
+		buf = binary.AppendUvarint(buf, uint64(len(item.Key)))
+		buf = append(buf, item.Key...)
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, valueHash[:]...)
+	} else {
+		if len(h.stack) < 2 {
+			return fmt.Errorf("missing the children of the node at height %d", height)
+		}
+		left, right := h.stack[len(h.stack)-2], h.stack[len(h.stack)-1]
+		h.stack = h.stack[:len(h.stack)-2]
+		n.size = left.size + right.size
+		buf = binary.AppendVarint(buf, n.size)
+		buf = binary.AppendVarint(buf, item.Version)
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, left.hash...)
+		buf = binary.AppendUvarint(buf, sha256.Size)
+		buf = append(buf, right.hash...)
+	}
+
+	hash := sha256.Sum256(buf)
+	n.hash = hash[:]
+	h.stack = append(h.stack, n)
+	return nil
+}
+
+// rootHash returns the root hash of the hashed tree.
+func (h *treeHasher) rootHash() ([]byte, error) {
+	switch len(h.stack) {
+	case 0:
+		return emptyTreeHash, nil
+	case 1:
+		return h.stack[0].hash, nil
+	default:
+		return nil, fmt.Errorf("invalid tree: %d subtrees are not attached to a root", len(h.stack))
+	}
+}
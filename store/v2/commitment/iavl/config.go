@@ -1,9 +1,19 @@
 package iavl
 
+import "time"
+
+// DefaultPruningCloseTimeout is the default maximum duration Close waits for the
+// background pruning to complete.
+const DefaultPruningCloseTimeout = 30 * time.Second
+
 // Config is the configuration for the IAVL tree.
 type Config struct {
 	CacheSize              int  `mapstructure:"cache_size"`
 	SkipFastStorageUpgrade bool `mapstructure:"skip_fast_storage_upgrade"`
+	// PruningCloseTimeout is the maximum duration Close waits for the background
+	// pruning to complete, so that a stalled pruning can't block the shutdown.
+	// DefaultPruningCloseTimeout is used if zero.
+	PruningCloseTimeout time.Duration `mapstructure:"pruning_close_timeout"`
 }
 
 // DefaultConfig returns the default configuration for the IAVL tree.
@@ -11,5 +21,6 @@ func DefaultConfig() *Config {
 	return &Config{
 		CacheSize:              1000,
 		SkipFastStorageUpgrade: false,
+		PruningCloseTimeout:    DefaultPruningCloseTimeout,
 	}
 }
@@ -1,7 +1,11 @@
 package iavl
 
 import (
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cosmos/iavl"
 	ics23 "github.com/cosmos/ics23/go"
@@ -14,20 +18,54 @@ import (
 )
 
 var (
-	_ commitment.Tree      = (*IavlTree)(nil)
-	_ store.PausablePruner = (*IavlTree)(nil)
+	_ commitment.Tree       = (*IavlTree)(nil)
+	_ commitment.KeyCounter = (*IavlTree)(nil)
+	_ store.PausablePruner  = (*IavlTree)(nil)
 )
 
 // IavlTree is a wrapper around iavl.MutableTree.
 type IavlTree struct {
-	tree *iavl.MutableTree
+	tree   *iavl.MutableTree
+	logger log.Logger
+
+	// asyncPruning is true if the versions are pruned in the background.
+	asyncPruning bool
+	// pruningCloseTimeout is the maximum duration Close waits for the background pruning.
+	pruningCloseTimeout time.Duration
+	// pruningPaused is true while the pruning is paused.
+	pruningPaused atomic.Bool
+
+	pruneMtx sync.Mutex
+	// pruneVersion is the version up to which the background pruning was requested.
+	pruneVersion uint64
+	// pruneDone is closed when the background pruning completes, nil if it isn't running.
+	pruneDone chan struct{}
 }
 
-// NewIavlTree creates a new IavlTree instance.
-func NewIavlTree(db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config) *IavlTree {
-	tree := iavl.NewMutableTree(dbm.NewWrapper(db), cfg.CacheSize, cfg.SkipFastStorageUpgrade, logger, iavl.AsyncPruningOption(true))
+// NewIavlTree creates a new IavlTree instance. The pruning runs in the background
+// unless disabled with iavl.AsyncPruningOption(false).
+func NewIavlTree(db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config, opts ...iavl.Option) *IavlTree {
+	iavlOpts := iavl.DefaultOptions()
+	opts = append([]iavl.Option{iavl.AsyncPruningOption(true)}, opts...)
+	for _, opt := range opts {
+		opt(&iavlOpts)
+	}
+
+	// the background pruning is run by the wrapper instead of the iavl tree, so that
+	// Close can wait for it to complete.
+	opts = append(opts, iavl.AsyncPruningOption(false))
+	tree := iavl.NewMutableTree(dbm.NewWrapper(db), cfg.CacheSize, cfg.SkipFastStorageUpgrade, logger, opts...)
+
+	pruningCloseTimeout := cfg.PruningCloseTimeout
+	if pruningCloseTimeout <= 0 {
+		pruningCloseTimeout = DefaultPruningCloseTimeout
+	}
+
 	return &IavlTree{
-		tree: tree,
+		tree:                tree,
+		logger:              logger,
+		asyncPruning:        iavlOpts.AsyncPruning,
+		pruningCloseTimeout: pruningCloseTimeout,
 	}
 }
 
@@ -40,6 +78,41 @@ func (t *IavlTree) Remove(key []byte) error {
 	return nil
 }
 
+// RemoveRange removes all the keys in the given range from the tree.
+func (t *IavlTree) RemoveRange(start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+
+	// the keys are collected first as the tree can't be written while iterated
+	iter, err := t.tree.Iterator(start, end, true)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Error(); err != nil {
+		_ = iter.Close()
+		return err
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if _, _, err := t.tree.Remove(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Set sets the given key-value pair in the tree.
 func (t *IavlTree) Set(key, value []byte) error {
 	_, err := t.tree.Set(key, value)
@@ -86,6 +159,16 @@ func (t *IavlTree) Get(version uint64, key []byte) ([]byte, error) {
 	return immutableTree.Get(key)
 }
 
+// KeyCount returns the number of keys of the tree at the given version.
+func (t *IavlTree) KeyCount(version uint64) (uint64, error) {
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	return uint64(immutableTree.Size()), nil
+}
+
 // GetLatestVersion returns the latest version of the tree.
 func (t *IavlTree) GetLatestVersion() uint64 {
 	return uint64(t.tree.Version())
@@ -98,12 +181,51 @@ func (t *IavlTree) SetInitialVersion(version uint64) error {
 }
 
 // Prune prunes all versions up to and including the provided version.
+// With the asynchronous pruning, the versions are pruned in the background and
+// Prune returns immediately.
 func (t *IavlTree) Prune(version uint64) error {
-	return t.tree.DeleteVersionsTo(int64(version))
+	if !t.asyncPruning {
+		return t.tree.DeleteVersionsTo(int64(version))
+	}
+
+	t.pruneMtx.Lock()
+	defer t.pruneMtx.Unlock()
+
+	t.pruneVersion = max(t.pruneVersion, version)
+	if t.pruneDone == nil {
+		t.pruneDone = make(chan struct{})
+		go t.prune(t.pruneDone)
+	}
+
+	return nil
+}
+
+// prune prunes the versions requested through Prune until there is nothing left
+// to prune, then closes done.
+func (t *IavlTree) prune(done chan struct{}) {
+	defer close(done)
+
+	var pruned uint64
+	for {
+		t.pruneMtx.Lock()
+		version := t.pruneVersion
+		if version <= pruned {
+			t.pruneDone = nil
+			t.pruneMtx.Unlock()
+			return
+		}
+		t.pruneMtx.Unlock()
+
+		if err := t.tree.DeleteVersionsTo(int64(version)); err != nil {
+			t.logger.Error("failed to prune the iavl tree", "version", version, "err", err)
+		}
+		pruned = version
+	}
 }
 
 // PausePruning pauses the pruning process.
 func (t *IavlTree) PausePruning(pause bool) {
+	t.pruningPaused.Store(pause)
 	if pause {
 		t.tree.SetCommitting()
 	} else {
@@ -141,5 +263,22 @@ func (t *IavlTree) Import(version uint64) (commitment.Importer, error) {
 
 // Close closes the iavl tree.
 func (t *IavlTree) Close() error {
-	return t.tree.Close()
+	// wait for the background pruning to complete as it can't write to the node
+	// db once the tree is closed.
+	t.pruneMtx.Lock()
+	done := t.pruneDone
+	t.pruneMtx.Unlock()
+
+	var err error
+	if done != nil && !t.pruningPaused.Load() {
+		timer := time.NewTimer(t.pruningCloseTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-done:
+		case <-timer.C:
+			err = fmt.Errorf("timed out after %s waiting for the pruning to complete", t.pruningCloseTimeout)
+		}
+	}
+	return errors.Join(err, t.tree.Close())
 }
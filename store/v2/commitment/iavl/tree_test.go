@@ -117,3 +117,41 @@ func TestIavlTree(t *testing.T) {
 	// close the db
 	require.NoError(t, tree.Close())
 }
+
+func TestIavlTreeCloseStalledPruning(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.PruningCloseTimeout = 200 * time.Millisecond
+	tree := NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), cfg)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, tree.Set([]byte("key"), []byte{byte(i)}))
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	// stall the pruning of the underlying tree without pausing it through the wrapper.
+	tree.tree.SetCommitting()
+	require.NoError(t, tree.Prune(1))
+	require.ErrorContains(t, tree.Close(), "timed out after 200ms waiting for the pruning to complete")
+}
+
+func TestIavlTreeCloseWaitsPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := NewIavlTree(db, log.NewNopLogger(), DefaultConfig())
+	for i := 0; i < 10; i++ {
+		require.NoError(t, tree.Set([]byte("key"), []byte{byte(i)}))
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tree.Prune(9))
+	require.NoError(t, tree.Close())
+
+	// the pruning completed before the tree was closed.
+	tree = NewIavlTree(db, log.NewNopLogger(), DefaultConfig())
+	require.NoError(t, tree.LoadVersion(10))
+	for version := int64(1); version <= 9; version++ {
+		require.False(t, tree.tree.VersionExists(version))
+	}
+	require.True(t, tree.tree.VersionExists(10))
+	require.NoError(t, tree.Close())
+}
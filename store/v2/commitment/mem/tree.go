@@ -18,6 +18,35 @@ func (t *Tree) Remove(key []byte) error {
 	return t.MemDB.Delete(key)
 }
 
+func (t *Tree) RemoveRange(start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+
+	iter, err := t.MemDB.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := t.MemDB.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (t *Tree) GetLatestVersion() uint64 {
 	return 0
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/internal/encoding"
 	"cosmossdk.io/store/v2/proof"
 )
@@ -59,7 +60,9 @@ func (m *MetadataStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error)
 	return cInfo, nil
 }
 
-func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo) error {
+// flushCommitInfo persists the commit info of the version as the latest one, along
+// with the writes of the hook, if not nil, in a single batch.
+func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo, hook store.CommitHook) error {
 	// do nothing if commit info is nil, as will be the case for an empty, initializing store
 	if cInfo == nil {
 		return nil
@@ -84,12 +87,29 @@ func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo)
 		return err
 	}
 
+	if hook != nil {
+		if err := hook(batch, cInfo); err != nil {
+			return err
+		}
+	}
+
 	if err := batch.WriteSync(); err != nil {
 		return err
 	}
 	return batch.Close()
 }
 
+// setLatestVersion sets the latest version, it is used when the store is rolled back.
+func (m *MetadataStore) setLatestVersion(version uint64) error {
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeUvarintSize(version))
+	if err := encoding.EncodeUvarint(&buf, version); err != nil {
+		return err
+	}
+
+	return m.kv.Set([]byte(latestVersionKey), buf.Bytes())
+}
+
 func (m *MetadataStore) deleteCommitInfo(version uint64) error {
 	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
 	return m.kv.Delete(cInfoKey)
@@ -1,6 +1,7 @@
 package commitment
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -19,9 +20,11 @@ import (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                     = (*CommitStore)(nil)
+	_ snapshots.CommitSnapshotter         = (*CommitStore)(nil)
+	_ snapshots.ParallelCommitSnapshotter = (*CommitStore)(nil)
+	_ store.PausablePruner                = (*CommitStore)(nil)
+	_ store.CommitHooker                  = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -33,6 +36,10 @@ type CommitStore struct {
 	logger     log.Logger
 	metadata   *MetadataStore
 	multiTrees map[string]Tree
+
+	// commitHook is called with the batch persisting the commit info of every
+	// committed version.
+	commitHook store.CommitHook
 }
 
 // NewCommitStore creates a new CommitStore instance.
@@ -54,7 +61,11 @@ func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 			return fmt.Errorf("store key %s not found in multiTrees", key)
 		}
 		for _, kv := range pairs.StateChanges {
-			if kv.Remove {
+			if kv.RemoveRange {
+				if err := tree.RemoveRange(kv.Key, kv.End); err != nil {
+					return err
+				}
+			} else if kv.Remove {
 				if err := tree.Remove(kv.Key); err != nil {
 					return err
 				}
@@ -67,6 +78,25 @@ func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 	return nil
 }
 
+// KeyCounts returns the number of keys of each store at the given version, the
+// stores whose trees don't implement KeyCounter being omitted.
+func (c *CommitStore) KeyCounts(version uint64) (map[string]uint64, error) {
+	counts := make(map[string]uint64, len(c.multiTrees))
+	for storeKey, tree := range c.multiTrees {
+		counter, ok := tree.(KeyCounter)
+		if !ok || internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
+		count, err := counter.KeyCount(version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count the keys of store %s: %w", storeKey, err)
+		}
+		counts[storeKey] = count
+	}
+
+	return counts, nil
+}
+
 func (c *CommitStore) WorkingCommitInfo(version uint64) *proof.CommitInfo {
 	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
 	for storeKey, tree := range c.multiTrees {
@@ -101,6 +131,9 @@ func (c *CommitStore) LoadVersion(targetVersion uint64) error {
 				return err
 			}
 		}
+		if err = c.metadata.setLatestVersion(targetVersion); err != nil {
+			return err
+		}
 	}
 
 	for _, tree := range c.multiTrees {
@@ -116,7 +149,7 @@ func (c *CommitStore) LoadVersion(targetVersion uint64) error {
 		cInfo = c.WorkingCommitInfo(targetVersion)
 	}
 
-	return c.metadata.flushCommitInfo(targetVersion, cInfo)
+	return c.metadata.flushCommitInfo(targetVersion, cInfo, nil)
 }
 
 func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
@@ -157,13 +190,18 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 		StoreInfos: storeInfos,
 	}
 
-	if err := c.metadata.flushCommitInfo(version, cInfo); err != nil {
+	if err := c.metadata.flushCommitInfo(version, cInfo, c.commitHook); err != nil {
 		return nil, err
 	}
 
 	return cInfo, nil
 }
 
+// SetCommitHook implements store.CommitHooker.
+func (c *CommitStore) SetCommitHook(hook store.CommitHook) {
+	c.commitHook = hook
+}
+
 func (c *CommitStore) SetInitialVersion(version uint64) error {
 	for _, tree := range c.multiTrees {
 		if err := tree.SetInitialVersion(version); err != nil {
@@ -256,45 +294,79 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 	}
 
 	for storeKey, tree := range c.multiTrees {
-		// TODO: check the parallelism of this loop
-		if err := func() error {
-			exporter, err := tree.Export(version)
-			if err != nil {
-				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
-			}
-			defer exporter.Close()
+		if err := exportTree(version, storeKey, tree, protoWriter); err != nil {
+			return err
+		}
+	}
 
-			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-				Item: &snapshotstypes.SnapshotItem_Store{
-					Store: &snapshotstypes.SnapshotStoreItem{
-						Name: storeKey,
-					},
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to write store name: %w", err)
-			}
+	return nil
+}
 
-			for {
-				item, err := exporter.Next()
-				if errors.Is(err, ErrorExportDone) {
-					break
-				} else if err != nil {
-					return fmt.Errorf("failed to get the next export node: %w", err)
-				}
+// SnapshotStores implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) SnapshotStores(version uint64) (map[string][]byte, error) {
+	if version == 0 {
+		return nil, fmt.Errorf("the snapshot version must be greater than 0")
+	}
 
-				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-					Item: &snapshotstypes.SnapshotItem_IAVL{
-						IAVL: item,
-					},
-				}); err != nil {
-					return fmt.Errorf("failed to write iavl node: %w", err)
-				}
-			}
+	cInfo, err := c.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	if cInfo == nil {
+		return nil, fmt.Errorf("commit info not found for version %d", version)
+	}
 
-			return nil
-		}(); err != nil {
-			return err
+	hashes := make(map[string][]byte, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		hashes[string(storeInfo.Name)] = storeInfo.GetHash()
+	}
+
+	return hashes, nil
+}
+
+// SnapshotStore implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	return exportTree(version, storeKey, tree, protoWriter)
+}
+
+// exportTree writes the store item of the given tree followed by its exported nodes.
+func exportTree(version uint64, storeKey string, tree Tree, protoWriter protoio.Writer) error {
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+		Item: &snapshotstypes.SnapshotItem_Store{
+			Store: &snapshotstypes.SnapshotStoreItem{
+				Name: storeKey,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write store name: %w", err)
+	}
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_IAVL{
+				IAVL: item,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write iavl node: %w", err)
 		}
 	}
 
@@ -350,35 +422,8 @@ loop:
 			if importer == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("received IAVL node item before store item")
 			}
-			node := item.IAVL
-			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 {
-				if node.Value == nil {
-					node.Value = []byte{}
-				}
-
-				// If the node is a leaf node, it will be written to the storage.
-				chStorage <- &corestore.StateChanges{
-					Actor: storeKey,
-					StateChanges: []corestore.KVPair{
-						{
-							Key:   node.Key,
-							Value: node.Value,
-						},
-					},
-				}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+			if err := importNode(importer, storeKey, item.IAVL, chStorage); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
 			}
 		default:
 			break loop
@@ -394,6 +439,113 @@ loop:
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// RestoreStore implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) RestoreStore(
+	version uint64,
+	storeKey string,
+	hash []byte,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	var snapshotItem snapshotstypes.SnapshotItem
+	if err := protoReader.ReadMsg(&snapshotItem); err != nil {
+		return fmt.Errorf("invalid protobuf message: %w", err)
+	}
+	if item := snapshotItem.GetStore(); item == nil || item.Name != storeKey {
+		return fmt.Errorf("expected the store item of %s, got %v", storeKey, snapshotItem.Item)
+	}
+
+	importer, err := tree.Import(version)
+	if err != nil {
+		return fmt.Errorf("failed to import tree for version %d: %w", version, err)
+	}
+	defer importer.Close()
+
+	var hasher treeHasher
+	for {
+		snapshotItem = snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		item := snapshotItem.GetIAVL()
+		if item == nil {
+			return fmt.Errorf("unexpected snapshot item %T in the snapshot of store %s", snapshotItem.Item, storeKey)
+		}
+		if err := hasher.add(item); err != nil {
+			return err
+		}
+		if err := importNode(importer, []byte(storeKey), item, chStorage); err != nil {
+			return err
+		}
+	}
+
+	// the tree is verified before the import is committed, so that a tree not
+	// matching the snapshot is never saved.
+	if hash != nil {
+		rootHash, err := hasher.rootHash()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(rootHash, hash) {
+			return fmt.Errorf("%w: store %s: expected %X, got %X", snapshotstypes.ErrRootHashMismatch, storeKey, hash, rootHash)
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit importer: %w", err)
+	}
+
+	return nil
+}
+
+// FinalizeRestore implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) FinalizeRestore(version uint64) error {
+	return c.LoadVersion(version)
+}
+
+// importNode adds the given node to the importer, the leaf nodes are also sent
+// to the storage channel.
+func importNode(importer Importer, storeKey []byte, node *snapshotstypes.SnapshotIAVLItem, chStorage chan<- *corestore.StateChanges) error {
+	if node.Height > int32(math.MaxInt8) {
+		return fmt.Errorf("node height %v cannot exceed %v", node.Height, math.MaxInt8)
+	}
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 {
+		if node.Value == nil {
+			node.Value = []byte{}
+		}
+
+		// If the node is a leaf node, it will be written to the storage.
+		chStorage <- &corestore.StateChanges{
+			Actor: storeKey,
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   node.Key,
+					Value: node.Value,
+				},
+			},
+		}
+	}
+	if err := importer.Add(node); err != nil {
+		return fmt.Errorf("failed to add node to importer: %w", err)
+	}
+
+	return nil
+}
+
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/flat"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	dbm "cosmossdk.io/store/v2/db"
 )
@@ -30,6 +31,17 @@ var (
 			return dbm.NewGoLevelDB("test", dataDir, nil)
 		},
 	}
+	treeTypes = map[string]func(b *testing.B, db corestore.KVStoreWithBatch) commitment.Tree{
+		"iavl": func(_ *testing.B, db corestore.KVStoreWithBatch) commitment.Tree {
+			return iavl.NewIavlTree(db, log.NewNopLogger(), iavl.DefaultConfig())
+		},
+		"flat": func(b *testing.B, db corestore.KVStoreWithBatch) commitment.Tree {
+			tree, err := flat.NewTree(b.TempDir(), db, log.NewNopLogger(), flat.DefaultConfig())
+			require.NoError(b, err)
+			b.Cleanup(func() { _ = tree.Close() })
+			return tree
+		},
+	}
 	rng        = rand.New(rand.NewSource(543210))
 	changesets = make([]*corestore.Changeset, 1000)
 )
@@ -58,12 +70,12 @@ func init() {
 	}
 }
 
-func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch) *commitment.CommitStore {
+func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch, treeType string) *commitment.CommitStore {
 	b.Helper()
 	multiTrees := make(map[string]commitment.Tree)
 	for _, storeKey := range storeKeys {
 		prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
-		multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, log.NewNopLogger(), iavl.DefaultConfig())
+		multiTrees[storeKey] = treeTypes[treeType](b, prefixDB)
 	}
 
 	sc, err := commitment.NewCommitStore(multiTrees, db, log.NewNopLogger())
@@ -73,57 +85,61 @@ func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch) *commitment.Com
 }
 
 func BenchmarkCommit(b *testing.B) {
-	for ty, fn := range dbBackends {
-		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
-			b.ResetTimer()
-			b.ReportAllocs()
-			b.StopTimer()
-			for i := 0; i < b.N; i++ {
-				db, err := fn(b.TempDir())
-				require.NoError(b, err)
-				sc := getCommitStore(b, db)
-				b.StartTimer()
-				for j, cs := range changesets {
-					require.NoError(b, sc.WriteChangeset(cs))
-					_, err := sc.Commit(uint64(j + 1))
+	for treeType := range treeTypes {
+		for ty, fn := range dbBackends {
+			b.Run(fmt.Sprintf("tree_%s/backend_%s", treeType, ty), func(b *testing.B) {
+				b.ResetTimer()
+				b.ReportAllocs()
+				b.StopTimer()
+				for i := 0; i < b.N; i++ {
+					db, err := fn(b.TempDir())
 					require.NoError(b, err)
+					sc := getCommitStore(b, db, treeType)
+					b.StartTimer()
+					for j, cs := range changesets {
+						require.NoError(b, sc.WriteChangeset(cs))
+						_, err := sc.Commit(uint64(j + 1))
+						require.NoError(b, err)
+					}
+					b.StopTimer()
+					require.NoError(b, db.Close())
 				}
-				b.StopTimer()
-				require.NoError(b, db.Close())
-			}
-		})
+			})
+		}
 	}
 }
 
 func BenchmarkGetProof(b *testing.B) {
-	for ty, fn := range dbBackends {
-		db, err := fn(b.TempDir())
-		require.NoError(b, err)
-		sc := getCommitStore(b, db)
+	for treeType := range treeTypes {
+		for ty, fn := range dbBackends {
+			db, err := fn(b.TempDir())
+			require.NoError(b, err)
+			sc := getCommitStore(b, db, treeType)
 
-		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
-			b.ResetTimer()
-			b.ReportAllocs()
-			b.StopTimer()
-			// commit some changesets
-			for i, cs := range changesets {
-				require.NoError(b, sc.WriteChangeset(cs))
-				_, err = sc.Commit(uint64(i + 1))
-				require.NoError(b, err)
-			}
-			b.StartTimer()
+			b.Run(fmt.Sprintf("tree_%s/backend_%s", treeType, ty), func(b *testing.B) {
+				b.ResetTimer()
+				b.ReportAllocs()
+				b.StopTimer()
+				// commit some changesets
+				for i, cs := range changesets {
+					require.NoError(b, sc.WriteChangeset(cs))
+					_, err = sc.Commit(uint64(i + 1))
+					require.NoError(b, err)
+				}
+				b.StartTimer()
 
-			for i := 0; i < b.N; i++ {
-				// non-existing proof
-				p, err := sc.GetProof([]byte(storeKeys[0]), 500, []byte("key-1-1"))
-				require.NoError(b, err)
-				require.NotNil(b, p)
-				// existing proof
-				p, err = sc.GetProof([]byte(storeKeys[1]), 500, changesets[499].Changes[1].StateChanges[1].Key)
-				require.NoError(b, err)
-				require.NotNil(b, p)
-			}
-		})
-		require.NoError(b, db.Close())
+				for i := 0; i < b.N; i++ {
+					// non-existing proof
+					p, err := sc.GetProof([]byte(storeKeys[0]), 500, []byte("key-1-1"))
+					require.NoError(b, err)
+					require.NotNil(b, p)
+					// existing proof
+					p, err = sc.GetProof([]byte(storeKeys[1]), 500, changesets[499].Changes[1].StateChanges[1].Key)
+					require.NoError(b, err)
+					require.NotNil(b, p)
+				}
+			})
+			require.NoError(b, db.Close())
+		}
 	}
 }
@@ -123,6 +123,181 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_ParallelSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(10)
+	kvCount := 10
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{}
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%d-%d", i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	hashes, err := commitStore.SnapshotStores(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Len(hashes, len(storeKeys))
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	chStorage := make(chan *corestore.StateChanges, 100)
+	leaves := make(map[string]string)
+	storageDone := make(chan struct{})
+	go func() {
+		defer close(storageDone)
+		for kv := range chStorage {
+			for _, actor := range kv.StateChanges {
+				leaves[fmt.Sprintf("%s_%s", kv.Actor, actor.Key)] = string(actor.Value)
+			}
+		}
+	}()
+
+	// export and restore each tree concurrently
+	wg := sync.WaitGroup{}
+	restoreErrs := make([]error, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		chunks := make(chan io.ReadCloser, kvCount*int(latestVersion))
+		go func(storeKey string) {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			s.Require().NotNil(streamWriter)
+			defer streamWriter.Close()
+			s.Require().NoError(commitStore.SnapshotStore(latestVersion, storeKey, streamWriter))
+		}(storeKey)
+
+		wg.Add(1)
+		go func(i int, storeKey string) {
+			defer wg.Done()
+			streamReader, err := snapshots.NewStreamReader(chunks)
+			if err != nil {
+				restoreErrs[i] = err
+				return
+			}
+			defer streamReader.Close()
+			restoreErrs[i] = targetStore.RestoreStore(latestVersion, storeKey, hashes[storeKey], streamReader, chStorage)
+		}(i, storeKey)
+	}
+	wg.Wait()
+	close(chStorage)
+	<-storageDone
+
+	for i, storeKey := range storeKeys {
+		s.Require().NoError(restoreErrs[i])
+		s.Require().Equal(hashes[storeKey], targetStore.multiTrees[storeKey].Hash())
+	}
+	s.Require().Equal(len(storeKeys)*kvCount*int(latestVersion), len(leaves))
+
+	s.Require().NoError(targetStore.FinalizeRestore(latestVersion))
+	targetVersion, err := targetStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, targetVersion)
+
+	cInfo, err := commitStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	targetCommitInfo, err := targetStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), targetCommitInfo.Hash())
+}
+
+func (s *CommitStoreTestSuite) TestStore_RestoreStoreHashMismatch() {
+	commitStore, err := s.NewStore(dbm.NewMemDB(), []string{storeKey1}, log.NewNopLogger())
+	s.Require().NoError(err)
+	s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		storeKey1: {{Key: []byte("key"), Value: []byte("value")}},
+	})))
+	_, err = commitStore.Commit(1)
+	s.Require().NoError(err)
+
+	chunks := make(chan io.ReadCloser, 10)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		s.Require().NotNil(streamWriter)
+		defer streamWriter.Close()
+		s.Require().NoError(commitStore.SnapshotStore(1, storeKey1, streamWriter))
+	}()
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	s.Require().NoError(err)
+	defer streamReader.Close()
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), []string{storeKey1}, log.NewNopLogger())
+	s.Require().NoError(err)
+	chStorage := make(chan *corestore.StateChanges, 10)
+	err = targetStore.RestoreStore(1, storeKey1, []byte("invalid hash"), streamReader, chStorage)
+	s.Require().ErrorIs(err, snapshotstypes.ErrRootHashMismatch)
+	// the tree not matching the snapshot is not saved
+	s.Require().Equal(uint64(0), targetStore.multiTrees[storeKey1].GetLatestVersion())
+}
+
+func (s *CommitStoreTestSuite) TestStore_RemoveRange() {
+	storeKeys := []string{storeKey1, storeKey2}
+
+	// the range removals must result in the same state as the equivalent removals
+	// of the keys one by one
+	rangeStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	keyStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	cs := corestore.NewChangeset()
+	for _, storeKey := range storeKeys {
+		for i := 0; i < 20; i++ {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", i)), []byte("value"), false)
+		}
+	}
+	for _, commitStore := range []*CommitStore{rangeStore, keyStore} {
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+		_, err = commitStore.Commit(1)
+		s.Require().NoError(err)
+	}
+
+	cs = corestore.NewChangeset()
+	cs.AddRangeRemove([]byte(storeKey1), []byte("key005"), []byte("key010"))
+	cs.Add([]byte(storeKey1), []byte("key007"), []byte("value"), false)
+	cs.AddPrefixRemove([]byte(storeKey1), []byte("key01"))
+	s.Require().NoError(rangeStore.WriteChangeset(cs))
+
+	cs = corestore.NewChangeset()
+	for i := 5; i < 20; i++ {
+		cs.Add([]byte(storeKey1), []byte(fmt.Sprintf("key%03d", i)), nil, true)
+		if i == 9 {
+			cs.Add([]byte(storeKey1), []byte("key007"), []byte("value"), false)
+		}
+	}
+	s.Require().NoError(keyStore.WriteChangeset(cs))
+
+	rangeInfo, err := rangeStore.Commit(2)
+	s.Require().NoError(err)
+	keyInfo, err := keyStore.Commit(2)
+	s.Require().NoError(err)
+	s.Require().Equal(keyInfo.Hash(), rangeInfo.Hash())
+
+	for i := 0; i < 20; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		val, err := rangeStore.Get([]byte(storeKey1), 2, key)
+		s.Require().NoError(err)
+		if i < 5 || i == 7 {
+			s.Require().Equal([]byte("value"), val, "key %s", key)
+		} else {
+			s.Require().Nil(val, "key %s", key)
+		}
+		val, err = rangeStore.Get([]byte(storeKey2), 2, key)
+		s.Require().NoError(err)
+		s.Require().Equal([]byte("value"), val, "key %s", key)
+	}
+}
+
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	storeKeys := []string{storeKey1, storeKey2}
 	pruneOpts := store.NewPruningOptionWithCustom(10, 5)
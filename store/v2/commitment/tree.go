@@ -16,6 +16,11 @@ var ErrorExportDone = errors.New("export is complete")
 type Tree interface {
 	Set(key, value []byte) error
 	Remove(key []byte) error
+
+	// RemoveRange removes all the keys in the range [start, end) from the working
+	// tree. An empty start or end means the range is unbounded on that side.
+	RemoveRange(start, end []byte) error
+
 	GetLatestVersion() uint64
 
 	// Hash returns the hash of the latest saved version of the tree.
@@ -42,6 +47,12 @@ type Tree interface {
 	io.Closer
 }
 
+// KeyCounter is implemented by the trees able to report their number of keys at a
+// version.
+type KeyCounter interface {
+	KeyCount(version uint64) (uint64, error)
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
@@ -125,6 +125,13 @@ func (pdb *PrefixDB) NewBatchWithSize(size int) corestore.Batch {
 	return newPrefixBatch(pdb.prefix, pdb.db.NewBatchWithSize(size))
 }
 
+// WrapBatch returns a batch writing the prefixed keys to the given batch of the
+// underlying database, so they are written atomically with the other writes of
+// the batch.
+func (pdb *PrefixDB) WrapBatch(batch corestore.Batch) corestore.Batch {
+	return newPrefixBatch(pdb.prefix, batch)
+}
+
 // Close implements corestore.KVStore.
 func (pdb *PrefixDB) Close() error {
 	pdb.mtx.Lock()
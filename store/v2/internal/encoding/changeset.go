@@ -15,8 +15,10 @@ func encodedSize(cs *corestore.Changeset) int {
 		size += EncodeUvarintSize(uint64(len(changes.StateChanges)))
 		for _, pair := range changes.StateChanges {
 			size += EncodeBytesSize(pair.Key)
-			size += EncodeUvarintSize(1) // pair.Remove
-			if !pair.Remove {
+			size += EncodeUvarintSize(1) // pair.Remove or pair.RemoveRange
+			if pair.RemoveRange {
+				size += EncodeBytesSize(pair.End)
+			} else if !pair.Remove {
 				size += EncodeBytesSize(pair.Value)
 			}
 		}
@@ -32,8 +34,8 @@ func encodedSize(cs *corestore.Changeset) int {
 // -- number of pairs (uvarint)
 // -- for each pair:
 // --- key (bytes)
-// --- remove (1 byte)
-// --- value (bytes)
+// --- remove (1 byte), 2 for a range removal
+// --- value (bytes), or the range end for a range removal
 func MarshalChangeset(cs *corestore.Changeset) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.Grow(encodedSize(cs))
@@ -52,7 +54,14 @@ func MarshalChangeset(cs *corestore.Changeset) ([]byte, error) {
 			if err := EncodeBytes(&buf, pair.Key); err != nil {
 				return nil, err
 			}
-			if pair.Remove {
+			if pair.RemoveRange {
+				if err := EncodeUvarint(&buf, 2); err != nil {
+					return nil, err
+				}
+				if err := EncodeBytes(&buf, pair.End); err != nil {
+					return nil, err
+				}
+			} else if pair.Remove {
 				if err := EncodeUvarint(&buf, 1); err != nil {
 					return nil, err
 				}
@@ -113,6 +122,13 @@ func UnmarshalChangeset(cs *corestore.Changeset, buf []byte) error {
 				buf = buf[n:]
 			} else if remove == 1 {
 				pairs[j].Remove = true
+			} else if remove == 2 {
+				pairs[j].RemoveRange = true
+				pairs[j].End, n, err = DecodeBytes(buf)
+				if err != nil {
+					return err
+				}
+				buf = buf[n:]
 			} else {
 				return fmt.Errorf("invalid remove flag: %d", remove)
 			}
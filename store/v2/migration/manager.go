@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	protoio "github.com/cosmos/gogoproto/io"
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/core/log"
@@ -26,8 +28,19 @@ const (
 	defaultStorageBufferSize = 1024
 
 	migrateChangesetKeyFmt = "m/cs_%x" // m/cs_<version>
+
+	// checkpointInterval is the interval at which the migration progress of a
+	// store is checkpointed.
+	checkpointInterval = 5 * time.Second
 )
 
+// KeyCounter is implemented by the source stores of a migration able to report
+// the number of keys of each of their stores at a version, such as
+// commitment.CommitStore.
+type KeyCounter interface {
+	KeyCounts(version uint64) (map[string]uint64, error)
+}
+
 // VersionedChangeset is a pair of version and Changeset.
 type VersionedChangeset struct {
 	Version   uint64
@@ -42,9 +55,18 @@ type Manager struct {
 	stateStorage    *storage.StorageStore
 	stateCommitment *commitment.CommitStore
 
-	db              corestore.KVStoreWithBatch
-	mtx             sync.Mutex // mutex for migratedVersion
-	migratedVersion uint64
+	db corestore.KVStoreWithBatch
+
+	// source reports the number of keys to migrate, if set.
+	source KeyCounter
+
+	mtx            sync.Mutex // mutex for the status fields
+	status         *Status
+	runStart       time.Time // time the migration was (re)started
+	runStoresDone  int       // number of stores migrated since runStart
+	runKeysWritten uint64    // number of keys written since runStart
+	checkpointPath string
+	lastCheckpoint time.Time
 
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
@@ -60,6 +82,7 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 		stateStorage:     ss,
 		stateCommitment:  sc,
 		db:               db,
+		status:           &Status{},
 	}
 }
 
@@ -91,8 +114,57 @@ func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 	return m.stateCommitment
 }
 
+// SetCheckpointPath sets the path of the checkpoint file, from which an
+// interrupted migration resumes. The checkpoint also reports the status of the
+// migration, see LoadStatus. The migration isn't checkpointed if the path is empty.
+func (m *Manager) SetCheckpointPath(path string) {
+	m.checkpointPath = path
+}
+
+// SetSource sets the store migrated from, whose number of keys the progress of the
+// migration is computed from. Otherwise the progress is computed from the number
+// of stores migrated.
+func (m *Manager) SetSource(source KeyCounter) {
+	m.source = source
+}
+
+// Status returns the status of the migration.
+func (m *Manager) Status() Status {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	status := *m.status
+	status.Stores = append([]StoreStatus(nil), m.status.Stores...)
+	return status
+}
+
 // Migrate migrates the whole state at the given height to the new store/v2.
+//
+// If the migration was interrupted, it resumes from the checkpoint at the height
+// of the interrupted migration instead, skipping the stores already migrated.
 func (m *Manager) Migrate(height uint64) error {
+	resumed, err := m.loadCheckpoint(height)
+	if err != nil {
+		return err
+	}
+
+	status := m.Status()
+	if status.Phase != PhaseMigrating {
+		// the state at the migration height is already migrated, only the
+		// changesets committed since remain to be caught up
+		m.logger.Info("resuming the migration", "height", status.Height, "migrated_version", status.MigratedVersion)
+		if m.stateCommitment != nil {
+			if err := m.stateCommitment.LoadVersion(status.MigratedVersion); err != nil {
+				return fmt.Errorf("failed to load the migrated version %d: %w", status.MigratedVersion, err)
+			}
+		}
+		return nil
+	}
+	if resumed {
+		m.logger.Info("resuming the migration", "height", status.Height, "stores_done", status.StoresDone)
+		height = status.Height
+	}
+
 	// create the migration stream and snapshot,
 	// which acts as protoio.Reader and snapshots.WriteCloser.
 	ms := NewMigrationStream(defaultChannelBufferSize)
@@ -101,70 +173,237 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
-	// restore the snapshot
+	stream := &streamReader{stream: ms}
+	if err := m.migrateStores(height, stream, resumed); err != nil {
+		// the snapshot of the migration height is blocked until the stream is consumed
+		go stream.drain()
+		if resumed {
+			return fmt.Errorf("failed to resume the migration at height %d, remove the checkpoint %s to restart it: %w", height, m.checkpointPath, err)
+		}
+		return err
+	}
+
+	if m.stateCommitment != nil {
+		if err := m.stateCommitment.FinalizeRestore(height); err != nil {
+			return fmt.Errorf("failed to load the migrated commitment: %w", err)
+		}
+	}
+
+	return m.updateStatus(true, func(s *Status) {
+		s.Phase = PhaseSyncing
+		s.MigratedVersion = height
+	})
+}
+
+// loadCheckpoint initializes the status of the migration at the given height
+// from the checkpoint, if any, and returns whether the migration is resumed.
+func (m *Manager) loadCheckpoint(height uint64) (bool, error) {
+	var checkpoint *Status
+	if m.checkpointPath != "" {
+		var err error
+		if checkpoint, err = LoadStatus(m.checkpointPath); err != nil {
+			return false, err
+		}
+	}
+
+	resumed := checkpoint != nil
+	if !resumed {
+		checkpoint = &Status{
+			Phase:     PhaseMigrating,
+			Height:    height,
+			StartedAt: time.Now(),
+		}
+		if m.stateCommitment != nil {
+			checkpoint.StoresTotal = len(m.stateCommitment.WorkingCommitInfo(height).StoreInfos)
+		}
+		if m.source != nil {
+			counts, err := m.source.KeyCounts(height)
+			if err != nil {
+				return false, fmt.Errorf("failed to count the keys to migrate: %w", err)
+			}
+			for name, count := range counts {
+				checkpoint.store(name).KeysTotal = count
+				checkpoint.KeysTotal += count
+			}
+		}
+	}
+
+	m.mtx.Lock()
+	m.status = checkpoint
+	m.runStart = time.Now()
+	m.runStoresDone = 0
+	m.runKeysWritten = 0
+	m.mtx.Unlock()
+
+	return resumed, m.updateStatus(true, func(*Status) {})
+}
+
+// migrateStores migrates the stores read from the stream one by one, skipping
+// the ones already migrated. The first store is restored over the state storage
+// at the height if resume is true.
+func (m *Manager) migrateStores(height uint64, stream *streamReader, resume bool) error {
+	for {
+		item, err := stream.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot item: %w", err)
+		}
+
+		storeItem := item.GetStore()
+		if storeItem == nil {
+			// there is nothing to migrate past the stores
+			stream.drain()
+			return nil
+		}
+
+		reader := &storeReader{stream: stream, store: item}
+		m.mtx.Lock()
+		done := m.status.store(storeItem.Name).Done
+		m.mtx.Unlock()
+		if done {
+			if err := reader.skip(); err != nil {
+				return fmt.Errorf("failed to skip store %s: %w", storeItem.Name, err)
+			}
+			continue
+		}
+
+		if err := m.migrateStore(height, storeItem.Name, reader, resume); err != nil {
+			return fmt.Errorf("failed to migrate store %s: %w", storeItem.Name, err)
+		}
+		// the next stores are restored over the state storage at the height
+		resume = true
+	}
+}
+
+// migrateStore migrates the store read from the given reader, counting the keys
+// and bytes written to the state storage, and checkpoints it once done.
+func (m *Manager) migrateStore(height uint64, storeKey string, reader protoio.Reader, resume bool) error {
+	// the store is migrated from scratch as the keys written before an interruption
+	// are overwritten
+	if err := m.updateStatus(false, func(s *Status) {
+		store := s.store(storeKey)
+		store.KeysWritten, store.BytesWritten = 0, 0
+	}); err != nil {
+		return err
+	}
+
 	chStorage := make(chan *corestore.StateChanges, defaultStorageBufferSize)
+	chSS := make(chan *corestore.StateChanges, defaultStorageBufferSize)
 
-	eg := new(errgroup.Group)
+	eg, ctx := errgroup.WithContext(context.Background())
 	eg.Go(func() error {
-		return m.stateStorage.Restore(height, chStorage)
+		if resume {
+			return m.stateStorage.ResumeRestore(height, chSS)
+		}
+		return m.stateStorage.Restore(height, chSS)
 	})
 	eg.Go(func() error {
-		defer close(chStorage)
-		if m.stateCommitment != nil {
-			if _, err := m.stateCommitment.Restore(height, 0, ms, chStorage); err != nil {
-				return err
+		defer close(chSS)
+		var failed bool
+		for changes := range chStorage {
+			// the changes are still consumed when the storage restore failed so
+			// that the commitment restore ends
+			if failed {
+				continue
 			}
-		} else { // there is no commitment migration, just consume the stream to restore the state storage
-			var storeKey []byte
-		loop:
-			for {
-				snapshotItem := snapshotstypes.SnapshotItem{}
-				err := ms.ReadMsg(&snapshotItem)
-				if errors.Is(err, io.EOF) {
-					break
-				}
-				if err != nil {
-					return fmt.Errorf("failed to read snapshot item: %w", err)
-				}
-				switch item := snapshotItem.Item.(type) {
-				case *snapshotstypes.SnapshotItem_Store:
-					storeKey = []byte(item.Store.Name)
-				case *snapshotstypes.SnapshotItem_IAVL:
-					if item.IAVL.Height == 0 { // only restore the leaf nodes
-						key := item.IAVL.Key
-						if key == nil {
-							key = []byte{}
-						}
-						value := item.IAVL.Value
-						if value == nil {
-							value = []byte{}
-						}
-						chStorage <- &corestore.StateChanges{
-							Actor: storeKey,
-							StateChanges: []corestore.KVPair{
-								{
-									Key:   key,
-									Value: value,
-								},
-							},
-						}
-					}
-				default:
-					break loop
-				}
+			select {
+			case chSS <- changes:
+			case <-ctx.Done():
+				failed = true
+				continue
+			}
+
+			var bytes uint64
+			for _, kv := range changes.StateChanges {
+				bytes += uint64(len(kv.Key) + len(kv.Value))
+			}
+			if err := m.updateStatus(false, func(s *Status) {
+				store := s.store(storeKey)
+				store.KeysWritten += uint64(len(changes.StateChanges))
+				store.BytesWritten += bytes
+				m.runKeysWritten += uint64(len(changes.StateChanges))
+			}); err != nil {
+				return err
 			}
 		}
 		return nil
 	})
+	eg.Go(func() error {
+		defer close(chStorage)
+		if m.stateCommitment != nil {
+			// the migrated trees are not verified, the snapshot of the source store
+			// does not carry their root hashes
+			return m.stateCommitment.RestoreStore(height, storeKey, nil, reader, chStorage)
+		}
+		// there is no commitment migration, just consume the stream to restore the state storage
+		return restoreLeaves(storeKey, reader, chStorage)
+	})
 
 	if err := eg.Wait(); err != nil {
 		return err
 	}
 
+	return m.updateStatus(true, func(s *Status) {
+		s.store(storeKey).Done = true
+		m.runStoresDone++
+	})
+}
+
+// restoreLeaves sends the leaf nodes read from the given reader to the storage channel.
+func restoreLeaves(storeKey string, reader protoio.Reader, chStorage chan<- *corestore.StateChanges) error {
+	for {
+		snapshotItem := snapshotstypes.SnapshotItem{}
+		err := reader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot item: %w", err)
+		}
+
+		item := snapshotItem.GetIAVL()
+		if item == nil || item.Height != 0 { // only restore the leaf nodes
+			continue
+		}
+		key := item.Key
+		if key == nil {
+			key = []byte{}
+		}
+		value := item.Value
+		if value == nil {
+			value = []byte{}
+		}
+		chStorage <- &corestore.StateChanges{
+			Actor: []byte(storeKey),
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   key,
+					Value: value,
+				},
+			},
+		}
+	}
+}
+
+// updateStatus applies the given update to the status of the migration and writes
+// the checkpoint, if forced or if the last one is older than checkpointInterval.
+func (m *Manager) updateStatus(force bool, update func(*Status)) error {
 	m.mtx.Lock()
-	m.migratedVersion = height
-	m.mtx.Unlock()
+	defer m.mtx.Unlock()
+
+	update(m.status)
+	now := time.Now()
+	m.status.update(now, m.runStart, m.runStoresDone, m.runKeysWritten)
+	if m.checkpointPath == "" || (!force && now.Sub(m.lastCheckpoint) < checkpointInterval) {
+		return nil
+	}
 
+	m.lastCheckpoint = now
+	if err := writeStatus(m.checkpointPath, m.status); err != nil {
+		return fmt.Errorf("failed to write the migration checkpoint: %w", err)
+	}
 	return nil
 }
 
@@ -207,7 +446,7 @@ func (m *Manager) writeChangeset() error {
 func (m *Manager) GetMigratedVersion() uint64 {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	return m.migratedVersion
+	return m.status.MigratedVersion
 }
 
 // Sync catches up the Changesets which are committed while the migration is in progress.
@@ -253,9 +492,11 @@ func (m *Manager) Sync() error {
 				return fmt.Errorf("failed to write changeset to storage: %w", err)
 			}
 
-			m.mtx.Lock()
-			m.migratedVersion = version
-			m.mtx.Unlock()
+			if err := m.updateStatus(true, func(s *Status) {
+				s.MigratedVersion = version
+			}); err != nil {
+				return err
+			}
 
 			version += 1
 		}
@@ -265,6 +506,11 @@ func (m *Manager) Sync() error {
 // Close closes the manager. It should be called after the migration is done.
 // It will close the db and notify the snapshotsManager that the migration is done.
 func (m *Manager) Close() error {
+	if err := m.updateStatus(true, func(s *Status) {
+		s.Phase = PhaseDone
+	}); err != nil {
+		return err
+	}
 	if err := m.db.Close(); err != nil {
 		return fmt.Errorf("failed to close db: %w", err)
 	}
@@ -2,7 +2,9 @@ package migration
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -112,3 +114,144 @@ func TestMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateResume(t *testing.T) {
+	m, orgCommitStore := setupMigrationManager(t, false)
+	checkpointPath := filepath.Join(t.TempDir(), DefaultCheckpointFile)
+	m.SetCheckpointPath(checkpointPath)
+
+	// apply changeset
+	toVersion := uint64(20)
+	keyCount := 10
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+		}
+		require.NoError(t, orgCommitStore.WriteChangeset(cs))
+		_, err := orgCommitStore.Commit(version)
+		require.NoError(t, err)
+	}
+
+	// interrupt the migration once the first store is migrated
+	height := toVersion - 1
+	_, err := m.loadCheckpoint(height)
+	require.NoError(t, err)
+	ms := NewMigrationStream(defaultChannelBufferSize)
+	go func() {
+		if err := orgCommitStore.Snapshot(height, ms); err != nil {
+			ms.CloseWithError(err)
+			return
+		}
+		_ = ms.Close()
+	}()
+	stream := &streamReader{stream: ms}
+	item, err := stream.read()
+	require.NoError(t, err)
+	migratedStore := item.GetStore().Name
+	require.NoError(t, m.migrateStore(height, migratedStore, &storeReader{stream: stream, store: item}, false))
+	stream.drain()
+
+	status, err := LoadStatus(checkpointPath)
+	require.NoError(t, err)
+	require.Equal(t, PhaseMigrating, status.Phase)
+	require.Equal(t, height, status.Height)
+	require.Equal(t, 2, status.StoresTotal)
+	require.Equal(t, 1, status.StoresDone)
+	require.Equal(t, float64(50), status.Percent)
+	require.Len(t, status.Stores, 1)
+	require.Equal(t, StoreStatus{
+		Name:         migratedStore,
+		Done:         true,
+		KeysWritten:  uint64(keyCount) * height,
+		BytesWritten: status.BytesWritten,
+	}, status.Stores[0])
+	require.NotZero(t, status.BytesWritten)
+
+	// the resumed migration skips the migrated store, at the checkpoint height
+	resumed := NewManager(m.db, m.snapshotsManager, m.stateStorage, m.stateCommitment, log.NewNopLogger())
+	resumed.SetCheckpointPath(checkpointPath)
+	require.NoError(t, resumed.Migrate(toVersion))
+
+	status, err = LoadStatus(checkpointPath)
+	require.NoError(t, err)
+	require.Equal(t, resumed.Status().Stores, status.Stores)
+	require.Equal(t, PhaseSyncing, status.Phase)
+	require.Equal(t, height, status.MigratedVersion)
+	require.Equal(t, height, resumed.GetMigratedVersion())
+	require.Equal(t, 2, status.StoresDone)
+	require.Equal(t, float64(100), status.Percent)
+	require.Len(t, status.Stores, 2)
+	for _, store := range status.Stores {
+		require.True(t, store.Done)
+		require.Equal(t, uint64(keyCount)*height, store.KeysWritten)
+	}
+
+	for version := uint64(1); version <= toVersion; version++ {
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", version, i))
+				var expected []byte
+				if version <= height {
+					expected = []byte(fmt.Sprintf("value-%d-%d", version, i))
+				}
+				val, err := resumed.stateCommitment.Get([]byte(storeKey), height, key)
+				require.NoError(t, err)
+				require.Equal(t, expected, val)
+				val, err = resumed.stateStorage.Get([]byte(storeKey), height, key)
+				require.NoError(t, err)
+				require.Equal(t, expected, val)
+			}
+		}
+	}
+}
+
+func TestMigrateProgressKeys(t *testing.T) {
+	m, orgCommitStore := setupMigrationManager(t, false)
+	m.SetSource(orgCommitStore)
+
+	// store1 holds 10x more keys than store2
+	toVersion := uint64(5)
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for i := 0; i < 100; i++ {
+			cs.Add([]byte("store1"), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte("value"), false)
+			if i < 10 {
+				cs.Add([]byte("store2"), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte("value"), false)
+			}
+		}
+		require.NoError(t, orgCommitStore.WriteChangeset(cs))
+		_, err := orgCommitStore.Commit(version)
+		require.NoError(t, err)
+	}
+
+	resumed, err := m.loadCheckpoint(toVersion)
+	require.NoError(t, err)
+	require.False(t, resumed)
+
+	status := m.Status()
+	require.Equal(t, uint64(550), status.KeysTotal)
+	require.Equal(t, []StoreStatus{
+		{Name: "store1", KeysTotal: 500},
+		{Name: "store2", KeysTotal: 50},
+	}, status.Stores)
+
+	// the progress of the large store counts before it is done
+	now := time.Now()
+	status.store("store1").KeysWritten = 275
+	status.update(now, now.Add(-time.Minute), 0, 275)
+	require.Equal(t, uint64(275), status.KeysDone)
+	require.Equal(t, 0, status.StoresDone)
+	require.Equal(t, float64(50), status.Percent)
+	require.Equal(t, time.Minute, status.ETA)
+
+	status.store("store1").KeysWritten = 500
+	status.store("store1").Done = true
+	status.update(now, now.Add(-time.Minute), 1, 500)
+	require.Equal(t, uint64(500), status.KeysDone)
+	require.Equal(t, 1, status.StoresDone)
+	require.InDelta(t, float64(100)*500/550, status.Percent, 1e-9)
+	require.Equal(t, 6*time.Second, status.ETA)
+}
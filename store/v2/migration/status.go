@@ -0,0 +1,169 @@
+package migration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Phase is the phase of a migration.
+type Phase string
+
+const (
+	// PhaseMigrating is the phase migrating the state at the migration height.
+	PhaseMigrating Phase = "migrating"
+	// PhaseSyncing is the phase catching up the changesets committed since the
+	// migration height.
+	PhaseSyncing Phase = "syncing"
+	// PhaseDone is the phase of a completed migration.
+	PhaseDone Phase = "done"
+)
+
+// DefaultCheckpointFile is the name of the checkpoint file in the migration
+// directory of the node data.
+const DefaultCheckpointFile = "checkpoint.json"
+
+// StoreStatus is the migration progress of a store.
+type StoreStatus struct {
+	Name string `json:"name"`
+	// Done is true once the store is fully migrated at the migration height.
+	Done bool `json:"done"`
+	// KeysTotal is the number of keys of the store at the migration height, 0 if
+	// unknown.
+	KeysTotal uint64 `json:"keys_total"`
+	// KeysWritten and BytesWritten are the number of keys and bytes (keys and
+	// values) written to the state storage.
+	KeysWritten  uint64 `json:"keys_written"`
+	BytesWritten uint64 `json:"bytes_written"`
+}
+
+// Status is the progress of a migration, it is also the checkpoint from which an
+// interrupted migration resumes.
+type Status struct {
+	Phase Phase `json:"phase"`
+	// Height is the height at which the state is migrated.
+	Height uint64 `json:"height"`
+	// MigratedVersion is the latest version migrated, including the changesets
+	// caught up, 0 until the state at the migration height is migrated.
+	MigratedVersion uint64 `json:"migrated_version"`
+
+	// StoresTotal is the number of stores to migrate, 0 if unknown.
+	StoresTotal int `json:"stores_total"`
+	StoresDone  int `json:"stores_done"`
+	// KeysTotal is the number of keys to migrate, 0 if unknown, see
+	// Manager.SetSource.
+	KeysTotal uint64 `json:"keys_total"`
+	KeysDone  uint64 `json:"keys_done"`
+	// Percent is the percentage of the keys migrated at the migration height, or
+	// of the stores if the number of keys is unknown.
+	Percent float64 `json:"percent"`
+	// ETA is the estimated remaining time of the migration at the migration
+	// height, based on the keys, or the stores if the number of keys is unknown,
+	// migrated since the migration was (re)started.
+	ETA time.Duration `json:"eta"`
+
+	BytesWritten uint64        `json:"bytes_written"`
+	Stores       []StoreStatus `json:"stores"`
+
+	StartedAt time.Time `json:"started_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LoadStatus loads the migration status from the given checkpoint file, nil if
+// the file does not exist.
+func LoadStatus(path string) (*Status, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{}
+	if err := json.Unmarshal(bz, status); err != nil {
+		return nil, fmt.Errorf("failed to decode the migration checkpoint %s: %w", path, err)
+	}
+	return status, nil
+}
+
+// writeStatus writes the migration status to the given checkpoint file, the file
+// is replaced atomically so that it can be read while the migration runs.
+func writeStatus(path string, status *Status) error {
+	bz, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err := f.Sync(); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// store returns the status of the given store, adding it if missing.
+func (s *Status) store(name string) *StoreStatus {
+	for i := range s.Stores {
+		if s.Stores[i].Name == name {
+			return &s.Stores[i]
+		}
+	}
+	s.Stores = append(s.Stores, StoreStatus{Name: name})
+	sort.Slice(s.Stores, func(i, j int) bool { return s.Stores[i].Name < s.Stores[j].Name })
+	return s.store(name)
+}
+
+// update computes the aggregated progress, runStart being the time the migration
+// was (re)started, runStoresDone and runKeysWritten the number of stores migrated
+// and of keys written since.
+func (s *Status) update(now, runStart time.Time, runStoresDone int, runKeysWritten uint64) {
+	s.UpdatedAt = now
+	s.StoresDone = 0
+	s.KeysDone = 0
+	s.BytesWritten = 0
+	for _, store := range s.Stores {
+		if store.Done {
+			s.StoresDone++
+			s.KeysDone += max(store.KeysTotal, store.KeysWritten)
+		} else {
+			s.KeysDone += min(store.KeysTotal, store.KeysWritten)
+		}
+		s.BytesWritten += store.BytesWritten
+	}
+
+	elapsed := now.Sub(runStart)
+	switch {
+	case s.Phase != PhaseMigrating:
+		s.Percent, s.ETA = 100, 0
+	case s.KeysTotal > 0:
+		s.Percent = min(100, 100*float64(s.KeysDone)/float64(s.KeysTotal))
+		s.ETA = 0
+		if runKeysWritten > 0 && s.KeysTotal > s.KeysDone {
+			s.ETA = time.Duration(float64(elapsed) / float64(runKeysWritten) * float64(s.KeysTotal-s.KeysDone))
+		}
+	case s.StoresTotal > 0:
+		s.Percent = 100 * float64(s.StoresDone) / float64(s.StoresTotal)
+		s.ETA = 0
+		if remaining := s.StoresTotal - s.StoresDone; runStoresDone > 0 && remaining > 0 {
+			s.ETA = elapsed / time.Duration(runStoresDone) * time.Duration(remaining)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"sync/atomic"
@@ -58,6 +59,10 @@ func (ms *MigrationStream) ReadMsg(msg proto.Message) error {
 	// It doesn't require any deserialization, just a type assertion.
 	item := <-ms.chBuffer
 	if item == nil {
+		// the stream is closed, with an error if the snapshot failed
+		if err, ok := ms.err.Load().(error); ok && err != nil {
+			return err
+		}
 		return io.EOF
 	}
 
@@ -77,3 +82,82 @@ func (ms *MigrationStream) Close() error {
 	close(ms.chBuffer)
 	return nil
 }
+
+// streamReader reads the migration stream store by store, see storeReader.
+type streamReader struct {
+	stream protoio.Reader
+	// pending is the item read past the end of a store.
+	pending *snapshotstypes.SnapshotItem
+}
+
+// read returns the next item of the stream.
+func (r *streamReader) read() (*snapshotstypes.SnapshotItem, error) {
+	if item := r.pending; item != nil {
+		r.pending = nil
+		return item, nil
+	}
+
+	item := &snapshotstypes.SnapshotItem{}
+	if err := r.stream.ReadMsg(item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// drain consumes the rest of the stream.
+func (r *streamReader) drain() {
+	for {
+		if _, err := r.read(); err != nil {
+			return
+		}
+	}
+}
+
+var _ protoio.Reader = (*storeReader)(nil)
+
+// storeReader reads the items of a store from the migration stream: the store
+// item, then the IAVL items of the store until the next store.
+type storeReader struct {
+	stream *streamReader
+	// store is the store item, nil once read.
+	store *snapshotstypes.SnapshotItem
+}
+
+// ReadMsg implements the protoio.Reader interface, it returns io.EOF at the end
+// of the store.
+func (r *storeReader) ReadMsg(msg proto.Message) error {
+	snapshotItem, ok := msg.(*snapshotstypes.SnapshotItem)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", msg)
+	}
+
+	if r.store != nil {
+		*snapshotItem = *r.store
+		r.store = nil
+		return nil
+	}
+
+	item, err := r.stream.read()
+	if err != nil {
+		return err
+	}
+	if item.GetIAVL() == nil {
+		r.stream.pending = item
+		return io.EOF
+	}
+	*snapshotItem = *item
+	return nil
+}
+
+// skip consumes the items of the store.
+func (r *storeReader) skip() error {
+	for {
+		err := r.ReadMsg(&snapshotstypes.SnapshotItem{})
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
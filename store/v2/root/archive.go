@@ -0,0 +1,578 @@
+package root
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/internal"
+	"cosmossdk.io/store/v2/internal/conv"
+	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/snapshots"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+const (
+	// proofArchivePrefix is the prefix of the proof archive in the SC database.
+	proofArchivePrefix = "a/"
+
+	archiveCommitInfoPrefix = 'c' // c<version> -> CommitInfo
+	archiveChangesetPrefix  = 's' // s<version> -> Changeset
+	archiveCheckpointPrefix = 'k' // k<version> -> empty, marks a complete checkpoint
+	archiveExportPrefix     = 'e' // e<version><storeKey><chunk> -> chunk of an exported tree
+
+	// archiveChunkSize is the size of the chunks the exported trees are split into.
+	archiveChunkSize = int(10e6)
+	// archiveMaxItemSize is the maximum size of an exported tree node.
+	archiveMaxItemSize = int(64e6)
+)
+
+// ProofArchive persists what is needed to serve proofs for the versions pruned
+// from the SC backend: the CommitInfo and the changeset of every committed
+// version, along with periodic checkpoints of the trees. The tree of a store at
+// a pruned version is rebuilt by importing the latest checkpoint at or before the
+// version and replaying the changesets up to the version, so at most a checkpoint
+// interval of changesets is replayed.
+//
+// The archive is stored in the SC database: the changeset and the CommitInfo of a
+// version are written in the batch committing the version to the SC backend, so
+// every committed version is archived.
+//
+// NOTE: A rebuilt tree is held in memory, so serving proofs of pruned versions
+// for large stores is expensive, the closer the checkpoints the cheaper it is.
+type ProofArchive struct {
+	db *db.PrefixDB
+
+	// checkpointInterval is the maximum number of versions between two checkpoints.
+	checkpointInterval uint64
+	// keepRecent is the number of recent versions the archive serves proofs for,
+	// the older versions are pruned. If it is 0, all the versions are kept.
+	keepRecent uint64
+
+	// newTree creates the empty tree a store tree is rebuilt into.
+	newTree func() commitment.Tree
+
+	// pending is the changeset of the version being committed, and lastCheckpoint
+	// the version of the latest checkpoint. They are only accessed by the commits.
+	pending        *pendingChangeset
+	lastCheckpoint uint64
+
+	// mtx guards the last rebuilt tree, which is kept as proofs of the same
+	// version are usually queried together.
+	mtx         sync.Mutex
+	lastRebuilt *rebuiltTree
+}
+
+// pendingChangeset is the changeset of a version being committed.
+type pendingChangeset struct {
+	version uint64
+	cs      *corestore.Changeset
+}
+
+// rebuiltTree is the tree of a store rebuilt at a given version.
+type rebuiltTree struct {
+	storeKey string
+	version  uint64
+	tree     commitment.Tree
+}
+
+// NewProofArchive creates a new ProofArchive stored in the given SC database. The
+// trees are checkpointed at least every checkpointInterval versions, which must
+// not be 0, and the proofs of the latest keepRecent versions are kept, or of all
+// the versions if keepRecent is 0.
+func NewProofArchive(scDB corestore.KVStoreWithBatch, checkpointInterval, keepRecent uint64, newTree func() commitment.Tree) (*ProofArchive, error) {
+	if checkpointInterval == 0 {
+		return nil, errors.New("the checkpoint interval of the proof archive must be greater than 0")
+	}
+
+	return &ProofArchive{
+		db:                 db.NewPrefixDB(scDB, []byte(proofArchivePrefix)),
+		checkpointInterval: checkpointInterval,
+		keepRecent:         keepRecent,
+		newTree:            newTree,
+	}, nil
+}
+
+// init checkpoints the trees at the given version if no checkpoint at or before
+// the version is recent enough, so the versions committed from now on can be
+// rebuilt. It is the case when the archive is empty, or when a checkpoint has
+// been interrupted.
+func (a *ProofArchive) init(version uint64, sc store.Committer, cInfo *proof.CommitInfo) error {
+	checkpoint, found, err := a.latestCheckpoint(version)
+	if err != nil {
+		return err
+	}
+	if found && version-checkpoint < a.checkpointInterval {
+		a.lastCheckpoint = checkpoint
+		return nil
+	}
+
+	return a.checkpoint(version, sc, cInfo)
+}
+
+// stage sets the changeset of the version about to be committed, which is
+// archived by writeCommit.
+func (a *ProofArchive) stage(version uint64, cs *corestore.Changeset) {
+	a.pending = &pendingChangeset{version: version, cs: cs}
+}
+
+// writeCommit implements store.CommitHook, it writes the staged changeset and the
+// commit info of the committed version to the batch committing the version.
+func (a *ProofArchive) writeCommit(batch corestore.Batch, cInfo *proof.CommitInfo) error {
+	if a.pending == nil || a.pending.version != cInfo.Version {
+		return fmt.Errorf("the changeset of version %d is not staged in the proof archive", cInfo.Version)
+	}
+	csBz, err := encoding.MarshalChangeset(a.pending.cs)
+	if err != nil {
+		return err
+	}
+	cInfoBz, err := cInfo.Marshal()
+	if err != nil {
+		return err
+	}
+
+	archiveBatch := a.db.WrapBatch(batch)
+	if err := archiveBatch.Set(archiveVersionKey(archiveChangesetPrefix, cInfo.Version), csBz); err != nil {
+		return err
+	}
+
+	return archiveBatch.Set(archiveVersionKey(archiveCommitInfoPrefix, cInfo.Version), cInfoBz)
+}
+
+// postCommit checkpoints the trees at the committed version if the checkpoint
+// interval has elapsed, and prunes the versions out of the kept window.
+func (a *ProofArchive) postCommit(version uint64, sc store.Committer, cInfo *proof.CommitInfo) error {
+	a.pending = nil
+
+	if version-a.lastCheckpoint >= a.checkpointInterval {
+		if err := a.checkpoint(version, sc, cInfo); err != nil {
+			return fmt.Errorf("failed to checkpoint the proof archive at version %d: %w", version, err)
+		}
+	}
+
+	if err := a.prune(version); err != nil {
+		return fmt.Errorf("failed to prune the proof archive at version %d: %w", version, err)
+	}
+
+	return nil
+}
+
+// checkpoint exports the trees of the stores of the commit info at the given
+// version. A checkpoint at version 0 is made of empty trees. The memory stores
+// are not checkpointed, as they don't support proofs.
+func (a *ProofArchive) checkpoint(version uint64, sc store.Committer, cInfo *proof.CommitInfo) error {
+	if version > 0 {
+		if cInfo == nil {
+			return fmt.Errorf("commit info of version %d not found", version)
+		}
+		snapshotter, ok := sc.(snapshots.ParallelCommitSnapshotter)
+		if !ok {
+			return fmt.Errorf("exporting a store tree is not supported by the SC backend %T", sc)
+		}
+		// discard the chunks of an interrupted checkpoint at the same version
+		if err := a.deleteRange(archiveVersionKey(archiveExportPrefix, version), archiveVersionKey(archiveExportPrefix, version+1)); err != nil {
+			return err
+		}
+		for _, si := range cInfo.StoreInfos {
+			storeKey := string(si.Name)
+			if internal.IsMemoryStoreKey(storeKey) {
+				continue
+			}
+			w := &archiveChunkWriter{db: a.db, prefix: archiveExportKey(version, storeKey)}
+			if err := snapshotter.SnapshotStore(version, storeKey, protoio.NewDelimitedWriter(w)); err != nil {
+				return fmt.Errorf("failed to export store %s at version %d: %w", storeKey, version, err)
+			}
+			if err := w.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	// the checkpoint is complete once all the trees are exported
+	if err := a.db.Set(archiveVersionKey(archiveCheckpointPrefix, version), []byte{}); err != nil {
+		return err
+	}
+	a.lastCheckpoint = version
+
+	return nil
+}
+
+// prune discards the archived versions older than the keepRecent latest ones,
+// keeping the checkpoint and the changesets needed to rebuild the oldest kept
+// version.
+func (a *ProofArchive) prune(version uint64) error {
+	if a.keepRecent == 0 || version <= a.keepRecent {
+		return nil
+	}
+	oldest := version - a.keepRecent + 1
+	checkpoint, found, err := a.latestCheckpoint(oldest)
+	if err != nil || !found {
+		return err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if a.lastRebuilt != nil && a.lastRebuilt.version < oldest {
+		_ = a.lastRebuilt.tree.Close()
+		a.lastRebuilt = nil
+	}
+
+	for _, r := range [][2][]byte{
+		{[]byte{archiveCommitInfoPrefix}, archiveVersionKey(archiveCommitInfoPrefix, oldest)},
+		{[]byte{archiveChangesetPrefix}, archiveVersionKey(archiveChangesetPrefix, checkpoint+1)},
+		{[]byte{archiveCheckpointPrefix}, archiveVersionKey(archiveCheckpointPrefix, checkpoint)},
+		{[]byte{archiveExportPrefix}, archiveVersionKey(archiveExportPrefix, checkpoint)},
+	} {
+		if err := a.deleteRange(r[0], r[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRange deletes the archive keys in the range [start, end).
+func (a *ProofArchive) deleteRange(start, end []byte) error {
+	iter, err := a.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	batch := a.db.NewBatch()
+	defer batch.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// latestCheckpoint returns the version of the latest checkpoint at or before the
+// given version.
+func (a *ProofArchive) latestCheckpoint(version uint64) (uint64, bool, error) {
+	iter, err := a.db.ReverseIterator(
+		[]byte{archiveCheckpointPrefix},
+		archiveVersionKey(archiveCheckpointPrefix, version+1),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false, iter.Error()
+	}
+
+	return archiveKeyVersion(iter.Key()), true, nil
+}
+
+// GetCommitInfo returns the archived CommitInfo of the given version, or nil if
+// the version isn't archived.
+func (a *ProofArchive) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
+	bz, err := a.db.Get(archiveVersionKey(archiveCommitInfoPrefix, version))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	cInfo := &proof.CommitInfo{}
+	if err := cInfo.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return cInfo, nil
+}
+
+// GetProof returns the proof of existence or non-existence of the given key at
+// the given version, by rebuilding the tree of the store at the version.
+func (a *ProofArchive) GetProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error) {
+	cInfo, err := a.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	if cInfo == nil {
+		return nil, fmt.Errorf("version %d is not archived", version)
+	}
+	storeHash := cInfo.GetStoreCommitID(storeKey).Hash
+	if storeHash == nil {
+		return nil, fmt.Errorf("store %s not found in the commit info of version %d", storeKey, version)
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	tree, err := a.rebuiltTree(string(storeKey), version)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(tree.Hash(), storeHash) {
+		return nil, fmt.Errorf("rebuilt store %s at version %d has hash %X, expected %X", storeKey, version, tree.Hash(), storeHash)
+	}
+
+	iProof, err := tree.GetProof(version, key)
+	if err != nil {
+		return nil, err
+	}
+	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return []proof.CommitmentOp{commitOp, *storeCommitmentOp}, nil
+}
+
+// rebuiltTree returns the tree of the store rebuilt at the given version, reusing
+// the last rebuilt tree if possible. It must be called with the mutex held.
+func (a *ProofArchive) rebuiltTree(storeKey string, version uint64) (commitment.Tree, error) {
+	if last := a.lastRebuilt; last != nil && last.storeKey == storeKey && last.version == version {
+		return last.tree, nil
+	}
+
+	tree, err := a.rebuildTree(storeKey, version)
+	if err != nil {
+		return nil, err
+	}
+	if a.lastRebuilt != nil {
+		_ = a.lastRebuilt.tree.Close()
+	}
+	a.lastRebuilt = &rebuiltTree{storeKey: storeKey, version: version, tree: tree}
+
+	return tree, nil
+}
+
+// rebuildTree rebuilds the tree of the store at the given version from the latest
+// checkpoint at or before the version and the changesets committed since.
+func (a *ProofArchive) rebuildTree(storeKey string, version uint64) (_ commitment.Tree, err error) {
+	checkpoint, found, err := a.latestCheckpoint(version)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("no checkpoint found at or before version %d", version)
+	}
+
+	tree := a.newTree()
+	defer func() {
+		if err != nil {
+			_ = tree.Close()
+		}
+	}()
+
+	if checkpoint > 0 {
+		if err := a.importCheckpoint(tree, storeKey, checkpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	iter, err := a.db.Iterator(
+		archiveVersionKey(archiveChangesetPrefix, checkpoint+1),
+		archiveVersionKey(archiveChangesetPrefix, version+1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	next := checkpoint + 1
+	for ; iter.Valid(); iter.Next() {
+		csVersion := archiveKeyVersion(iter.Key())
+		if checkpoint == 0 && next == 1 && csVersion > 1 {
+			// the store started at an initial version greater than 1
+			if err := tree.SetInitialVersion(csVersion); err != nil {
+				return nil, err
+			}
+			next = csVersion
+		}
+		if csVersion != next {
+			return nil, fmt.Errorf("missing the archived changeset of version %d", next)
+		}
+
+		cs := corestore.NewChangeset()
+		if err := encoding.UnmarshalChangeset(cs, iter.Value()); err != nil {
+			return nil, fmt.Errorf("failed to decode the changeset of version %d: %w", csVersion, err)
+		}
+		for _, pairs := range cs.Changes {
+			if conv.UnsafeBytesToStr(pairs.Actor) != storeKey {
+				continue
+			}
+			for _, kv := range pairs.StateChanges {
+				if kv.RemoveRange {
+					err = tree.RemoveRange(kv.Key, kv.End)
+				} else if kv.Remove {
+					err = tree.Remove(kv.Key)
+				} else {
+					err = tree.Set(kv.Key, kv.Value)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if _, _, err := tree.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit the rebuilt tree at version %d: %w", csVersion, err)
+		}
+		next++
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if next != version+1 {
+		return nil, fmt.Errorf("missing the archived changeset of version %d", next)
+	}
+
+	return tree, nil
+}
+
+// importCheckpoint imports the exported tree of the store at the checkpoint version.
+func (a *ProofArchive) importCheckpoint(tree commitment.Tree, storeKey string, version uint64) error {
+	prefix := archiveExportKey(version, storeKey)
+	iter, err := a.db.Iterator(prefix, append(bytes.Clone(prefix), snapshotstypes.Uint64ToBigEndian(math.MaxUint64)...))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		if err := iter.Error(); err != nil {
+			return err
+		}
+		return fmt.Errorf("store %s not found in the checkpoint of version %d", storeKey, version)
+	}
+
+	importer, err := tree.Import(version)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	reader := protoio.NewDelimitedReader(&archiveChunkReader{iter: iter}, archiveMaxItemSize)
+	for {
+		var item snapshotstypes.SnapshotItem
+		err := reader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid checkpoint of store %s at version %d: %w", storeKey, version, err)
+		}
+		if node := item.GetIAVL(); node != nil {
+			if err := importer.Add(node); err != nil {
+				return err
+			}
+		}
+	}
+
+	return importer.Commit()
+}
+
+// rollback discards the archived versions greater than the given version.
+func (a *ProofArchive) rollback(version uint64) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if a.lastRebuilt != nil && a.lastRebuilt.version > version {
+		_ = a.lastRebuilt.tree.Close()
+		a.lastRebuilt = nil
+	}
+
+	for _, prefix := range []byte{archiveCommitInfoPrefix, archiveChangesetPrefix, archiveCheckpointPrefix, archiveExportPrefix} {
+		if err := a.deleteRange(archiveVersionKey(prefix, version+1), []byte{prefix + 1}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archiveVersionKey returns the key of the given version under the prefix, the
+// version is big endian encoded so the keys are sorted by version.
+func archiveVersionKey(prefix byte, version uint64) []byte {
+	return append([]byte{prefix}, snapshotstypes.Uint64ToBigEndian(version)...)
+}
+
+// archiveExportKey returns the prefix of the chunks of the exported tree of the
+// store at the given version.
+func archiveExportKey(version uint64, storeKey string) []byte {
+	key := binary.AppendUvarint(archiveVersionKey(archiveExportPrefix, version), uint64(len(storeKey)))
+	return append(key, storeKey...)
+}
+
+// archiveKeyVersion returns the version of a key built by archiveVersionKey.
+func archiveKeyVersion(key []byte) uint64 {
+	return snapshotstypes.BigEndianToUint64(key[1:9])
+}
+
+// archiveChunkWriter writes an exported tree to the archive in chunks of
+// archiveChunkSize bytes, keyed by their big endian index under the prefix.
+type archiveChunkWriter struct {
+	db     corestore.KVStore
+	prefix []byte
+	buf    bytes.Buffer
+	chunks uint64
+}
+
+// Write implements io.Writer.
+func (w *archiveChunkWriter) Write(p []byte) (int, error) {
+	n, _ := w.buf.Write(p)
+	for w.buf.Len() >= archiveChunkSize {
+		if err := w.writeChunk(w.buf.Next(archiveChunkSize)); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// flush writes the buffered bytes as the last chunk.
+func (w *archiveChunkWriter) flush() error {
+	if w.buf.Len() == 0 && w.chunks > 0 {
+		return nil
+	}
+	return w.writeChunk(w.buf.Next(w.buf.Len()))
+}
+
+func (w *archiveChunkWriter) writeChunk(chunk []byte) error {
+	key := append(bytes.Clone(w.prefix), snapshotstypes.Uint64ToBigEndian(w.chunks)...)
+	if err := w.db.Set(key, bytes.Clone(chunk)); err != nil {
+		return err
+	}
+	w.chunks++
+	return nil
+}
+
+// archiveChunkReader reads the chunks of an exported tree in order.
+type archiveChunkReader struct {
+	iter corestore.Iterator
+	buf  []byte
+}
+
+// Read implements io.Reader.
+func (r *archiveChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if !r.iter.Valid() {
+			if err := r.iter.Error(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		r.buf = bytes.Clone(r.iter.Value())
+		r.iter.Next()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 
+	iavltree "github.com/cosmos/iavl"
+
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/flat"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	"cosmossdk.io/store/v2/commitment/mem"
 	"cosmossdk.io/store/v2/db"
@@ -29,6 +32,7 @@ const (
 	SSTypeRocks  SSType = 2
 	SCTypeIavl   SCType = 0
 	SCTypeIavlV2 SCType = 1
+	SCTypeFlat   SCType = 2
 )
 
 type FactoryOptions struct {
@@ -39,8 +43,24 @@ type FactoryOptions struct {
 	SSPruningOption *store.PruningOption
 	SCPruningOption *store.PruningOption
 	IavlConfig      *iavl.Config
+	FlatConfig      *flat.Config
 	StoreKeys       []string
 	SCRawDB         corestore.KVStoreWithBatch
+
+	// ArchiveProofs enables serving the proofs of the versions pruned from the SC
+	// backend, see ProofArchive. The archive is stored in the SC database.
+	ArchiveProofs bool
+	// ProofArchiveCheckpointInterval is the maximum number of versions between two
+	// checkpoints of the archived trees, it bounds the number of changesets replayed
+	// to serve a proof and must be set when ArchiveProofs is enabled.
+	ProofArchiveCheckpointInterval uint64
+	// ProofArchiveKeepRecent is the number of recent versions the archive serves
+	// proofs for, the older versions are pruned. 0 keeps all the versions.
+	ProofArchiveKeepRecent uint64
+
+	// SSCompactionInterval is the interval, in versions, at which the SS backend is
+	// compacted in the background, 0 disables the background compaction.
+	SSCompactionInterval uint64
 }
 
 // CreateRootStore is a convenience function to create a root store based on the
@@ -111,6 +131,16 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 				trees[key] = iavl.NewIavlTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, opts.IavlConfig)
 			case SCTypeIavlV2:
 				return nil, fmt.Errorf("iavl v2 not supported")
+			case SCTypeFlat:
+				cfg := opts.FlatConfig
+				if cfg == nil {
+					cfg = flat.DefaultConfig()
+				}
+				dir := fmt.Sprintf("%s/data/sc/flat/%s", opts.RootDir, key)
+				trees[key], err = flat.NewTree(dir, db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, cfg)
+				if err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
@@ -121,5 +151,31 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 
 	pm := pruning.NewManager(sc, ss, opts.SCPruningOption, opts.SSPruningOption)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ArchiveProofs {
+		archive, err := NewProofArchive(
+			opts.SCRawDB,
+			opts.ProofArchiveCheckpointInterval,
+			opts.ProofArchiveKeepRecent,
+			func() commitment.Tree {
+				return iavl.NewIavlTree(db.NewMemDB(), opts.Logger, opts.IavlConfig, iavltree.AsyncPruningOption(false))
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := rs.(*Store).SetProofArchive(archive); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.SSCompactionInterval > 0 {
+		rs.(*Store).SetCompactionManager(storage.NewCompactionManager(ss, opts.SSCompactionInterval, opts.Logger))
+	}
+
+	return rs, nil
 }
@@ -77,6 +77,7 @@ func (s *MigrateStoreTestSuite) SetupTest() {
 	s.Require().NoError(err)
 	snapshotManager := snapshots.NewManager(snapshotsStore, snapshots.NewSnapshotOptions(1500, 2), orgSC, nil, nil, testLog)
 	migrationManager := migration.NewManager(dbm.NewMemDB(), snapshotManager, ss, sc, testLog)
+	migrationManager.SetSource(orgSC)
 	pm := pruning.NewManager(sc, ss, nil, nil)
 
 	// assume no storage store, simulate the migration process
@@ -160,4 +161,11 @@ func (s *MigrateStoreTestSuite) TestMigrateState() {
 	version, err = s.rootStore.GetLatestVersion()
 	s.Require().NoError(err)
 	s.Require().Equal(latestVersion+10, version)
+
+	status, ok := s.rootStore.(*Store).MigrationStatus()
+	s.Require().True(ok)
+	s.Require().Equal(migration.PhaseDone, status.Phase)
+	s.Require().Equal(originalLatestVersion, status.Height)
+	s.Require().Equal(len(storeKeys), status.StoresDone)
+	s.Require().Equal(float64(100), status.Percent)
 }
@@ -18,9 +18,13 @@ import (
 	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
 )
 
-var _ store.RootStore = (*Store)(nil)
+var (
+	_ store.RootStore  = (*Store)(nil)
+	_ store.Rollbacker = (*Store)(nil)
+)
 
 // Store defines the SDK's default RootStore implementation. It contains a single
 // State Storage (SS) backend and a single State Commitment (SC) backend. The SC
@@ -59,6 +63,14 @@ type Store struct {
 	chDone chan struct{}
 	// isMigrating reflects whether the store is currently migrating
 	isMigrating bool
+
+	// proofArchive reflects the archive serving the proofs of the versions pruned
+	// from the SC backend (if any)
+	proofArchive *ProofArchive
+
+	// compactionManager reflects the compaction manager used to compact the SS
+	// backend in the background (if any)
+	compactionManager *storage.CompactionManager
 }
 
 // New creates a new root Store instance.
@@ -87,6 +99,9 @@ func New(
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
+	if s.compactionManager != nil {
+		err = errors.Join(err, s.compactionManager.Close())
+	}
 	err = errors.Join(err, s.stateStorage.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
 
@@ -100,6 +115,32 @@ func (s *Store) Close() (err error) {
 
 func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
+	if s.compactionManager != nil {
+		s.compactionManager.SetMetrics(m)
+	}
+}
+
+// SetCompactionManager sets the manager compacting the SS backend in the background.
+func (s *Store) SetCompactionManager(m *storage.CompactionManager) {
+	if s.telemetry != nil {
+		m.SetMetrics(s.telemetry)
+	}
+	s.compactionManager = m
+}
+
+// SetProofArchive sets the archive used to serve the proofs of the versions pruned
+// from the SC backend. It must be set before the store is loaded, and the archive
+// must be stored in the SC database, as the archived versions are written in the
+// commits of the SC backend.
+func (s *Store) SetProofArchive(a *ProofArchive) error {
+	sc, ok := s.stateCommitment.(store.CommitHooker)
+	if !ok {
+		return fmt.Errorf("proof archive is not supported by the SC backend %T", s.stateCommitment)
+	}
+	sc.SetCommitHook(a.writeCommit)
+	s.proofArchive = a
+
+	return nil
 }
 
 func (s *Store) SetInitialVersion(v uint64) error {
@@ -137,6 +178,17 @@ func (s *Store) GetStateCommitment() store.Committer {
 	return s.stateCommitment
 }
 
+// MigrationStatus returns the status of the migration from store/v1, false if
+// the store isn't migrated. It is served by the migration query service of the
+// gRPC server, and also written to the migration checkpoint read by the
+// migration-status command.
+func (s *Store) MigrationStatus() (migration.Status, bool) {
+	if s.migrationManager == nil {
+		return migration.Status{}, false
+	}
+	return s.migrationManager.Status(), true
+}
+
 // LastCommitID returns a CommitID based off of the latest internal CommitInfo.
 // If an internal CommitInfo is not set, a new one will be returned with only the
 // latest version set, which is based off of the SC view.
@@ -205,15 +257,41 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	}
 
 	if prove {
-		result.ProofOps, err = s.stateCommitment.GetProof(storeKey, version, key)
+		result.ProofOps, err = s.getProof(storeKey, version, key)
 		if err != nil {
-			return store.QueryResult{}, fmt.Errorf("failed to get SC store proof: %w", err)
+			return store.QueryResult{}, err
 		}
 	}
 
 	return result, nil
 }
 
+// getProof returns the proof of the key at the given version from the SC backend,
+// or from the proof archive if the version has been pruned from the SC backend.
+func (s *Store) getProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error) {
+	if s.proofArchive != nil {
+		cInfo, err := s.stateCommitment.GetCommitInfo(version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit info for version %d: %w", version, err)
+		}
+		if cInfo == nil {
+			proofOps, err := s.proofArchive.GetProof(storeKey, version, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get archived proof: %w", err)
+			}
+
+			return proofOps, nil
+		}
+	}
+
+	proofOps, err := s.stateCommitment.GetProof(storeKey, version, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SC store proof: %w", err)
+	}
+
+	return proofOps, nil
+}
+
 func (s *Store) LoadLatestVersion() error {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -237,6 +315,46 @@ func (s *Store) LoadVersion(version uint64) error {
 	return s.loadVersion(version)
 }
 
+// Rollback rolls back both the SC and SS backends to the given version, discarding
+// all the versions after it. The given version must not be greater than the
+// latest version, and must not have been pruned.
+func (s *Store) Rollback(version uint64) error {
+	latestVersion, err := s.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latestVersion {
+		return fmt.Errorf("cannot rollback to version %d, the latest version is %d", version, latestVersion)
+	}
+
+	ss, ok := s.stateStorage.(store.Rollbacker)
+	if !ok {
+		return fmt.Errorf("rollback is not supported by the SS backend %T", s.stateStorage)
+	}
+
+	// SC is rolled back first, as the SC metadata defines the latest version of the
+	// store: an interrupted rollback can be resumed by rolling back to the same version.
+	if err := s.loadVersion(version); err != nil {
+		return err
+	}
+
+	if err := ss.Rollback(version); err != nil {
+		return fmt.Errorf("failed to rollback SS to version %d: %w", version, err)
+	}
+
+	if s.proofArchive != nil {
+		if err := s.proofArchive.rollback(version); err != nil {
+			return fmt.Errorf("failed to rollback the proof archive to version %d: %w", version, err)
+		}
+		// the archive is checkpointed again if the rollback discarded all its checkpoints
+		if err := s.proofArchive.init(version, s.stateCommitment, s.lastCommitInfo); err != nil {
+			return fmt.Errorf("failed to initialize the proof archive: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Store) loadVersion(v uint64) error {
 	s.logger.Debug("loading version", "version", v)
 
@@ -253,6 +371,12 @@ func (s *Store) loadVersion(v uint64) error {
 		return fmt.Errorf("failed to get commit info for version %d: %w", v, err)
 	}
 
+	if s.proofArchive != nil {
+		if err := s.proofArchive.init(v, s.stateCommitment, s.lastCommitInfo); err != nil {
+			return fmt.Errorf("failed to initialize the proof archive: %w", err)
+		}
+	}
+
 	// if we're migrating, we need to start the migration process
 	if s.isMigrating {
 		s.startMigration()
@@ -327,6 +451,11 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.logger.Error("failed to signal commit to pruning manager", "err", err)
 	}
 
+	// the changeset is archived in the SC commit
+	if s.proofArchive != nil {
+		s.proofArchive.stage(version, cs)
+	}
+
 	eg := new(errgroup.Group)
 
 	// if we're migrating, we don't want to commit to the state storage to avoid
@@ -355,13 +484,24 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		return nil, err
 	}
 
+	if s.commitHeader != nil {
+		s.lastCommitInfo.Timestamp = s.commitHeader.Time
+	}
+
+	// checkpoint the archive before the pruning manager may prune the version
+	if s.proofArchive != nil {
+		if err := s.proofArchive.postCommit(version, s.stateCommitment, s.lastCommitInfo); err != nil {
+			return nil, err
+		}
+	}
+
 	// signal to the pruning manager that the commit is done
 	if err := s.pruningManager.SignalCommit(false, version); err != nil {
 		s.logger.Error("failed to signal commit done to pruning manager", "err", err)
 	}
 
-	if s.commitHeader != nil {
-		s.lastCommitInfo.Timestamp = s.commitHeader.Time
+	if s.compactionManager != nil {
+		s.compactionManager.SignalCommit(version)
 	}
 
 	return s.lastCommitInfo.Hash(), nil
@@ -416,6 +556,11 @@ func (s *Store) writeSC(cs *corestore.Changeset) error {
 			newStateCommitment := s.migrationManager.GetStateCommitment()
 			if newStateCommitment != nil {
 				s.stateCommitment = newStateCommitment
+				if s.proofArchive != nil {
+					if err := s.SetProofArchive(s.proofArchive); err != nil {
+						return err
+					}
+				}
 			}
 			if err := s.migrationManager.Close(); err != nil {
 				return fmt.Errorf("failed to close migration manager: %w", err)
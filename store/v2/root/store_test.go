@@ -231,6 +231,96 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestQueryArchivedProof() {
+	noopLog := log.NewNopLogger()
+
+	// only SC is pruned, the archive serves the proofs of the pruned versions
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+	ss := storage.NewStorageStore(sqliteDB, noopLog)
+	mdb := dbm.NewMemDB()
+	multiTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range testStoreKeys {
+		multiTrees[storeKey] = iavl.NewIavlTree(dbm.NewPrefixDB(mdb, []byte(storeKey)), noopLog, iavl.DefaultConfig())
+	}
+	scDB := dbm.NewMemDB()
+	sc, err := commitment.NewCommitStore(multiTrees, scDB, noopLog)
+	s.Require().NoError(err)
+	pm := pruning.NewManager(sc, ss, &store.PruningOption{KeepRecent: 2, Interval: 1}, nil)
+	s.newStoreWithBackendMount(ss, sc, pm)
+
+	newTree := func() commitment.Tree {
+		return iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	}
+	_, err = NewProofArchive(scDB, 0, 0, newTree)
+	s.Require().Error(err)
+	archive, err := NewProofArchive(scDB, 4, 8, newTree)
+	s.Require().NoError(err)
+
+	rs := s.rootStore.(*Store)
+	s.Require().NoError(rs.SetProofArchive(archive))
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	// each version sets its own key, overwrites key000 and removes the key of the
+	// version before the previous one
+	for v := uint64(1); v <= 10; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)), false)
+		cs.Add(testStoreKeyBytes, []byte("key000"), []byte(fmt.Sprintf("val%03d", v)), false)
+		cs.Add(testStoreKey2Bytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		if v > 2 {
+			cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", v-2)), nil, true)
+		}
+		_, err = rs.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	// wait for the versions to be pruned from the SC backend
+	s.Require().Eventually(func() bool {
+		cInfo, err := rs.GetStateCommitment().GetCommitInfo(7)
+		return err == nil && cInfo == nil
+	}, 2*time.Second, 100*time.Millisecond)
+
+	verify := func(storeKey []byte, v uint64, key, value []byte) {
+		result, err := rs.Query(storeKey, v, key, true)
+		s.Require().NoError(err, "version %d", v)
+		s.Require().Len(result.ProofOps, 2)
+
+		cInfo, err := rs.proofArchive.GetCommitInfo(v)
+		s.Require().NoError(err)
+		s.Require().NotNil(cInfo)
+		storeHash := cInfo.GetStoreCommitID(storeKey).Hash
+		treeRoots, err := result.ProofOps[0].Run([][]byte{value})
+		s.Require().NoError(err)
+		s.Require().Equal(storeHash, treeRoots[0])
+		expRoots, err := result.ProofOps[1].Run([][]byte{storeHash})
+		s.Require().NoError(err)
+		s.Require().Equal(cInfo.Hash(), expRoots[0])
+	}
+
+	// the versions out of the kept window are pruned from the archive
+	for v := uint64(1); v <= 2; v++ {
+		cInfo, err := rs.proofArchive.GetCommitInfo(v)
+		s.Require().NoError(err)
+		s.Require().Nil(cInfo)
+		_, err = rs.Query(testStoreKeyBytes, v, []byte("key000"), true)
+		s.Require().Error(err)
+	}
+
+	for v := uint64(3); v <= 10; v++ {
+		verify(testStoreKeyBytes, v, []byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)))
+		verify(testStoreKeyBytes, v, []byte("key000"), []byte(fmt.Sprintf("val%03d", v)))
+		verify(testStoreKey2Bytes, v, []byte("key"), []byte(fmt.Sprintf("val%03d", v)))
+	}
+
+	// the archived versions are discarded on rollback
+	s.Require().NoError(rs.Rollback(9))
+	cInfo, err := rs.proofArchive.GetCommitInfo(10)
+	s.Require().NoError(err)
+	s.Require().Nil(cInfo)
+	verify(testStoreKeyBytes, 5, []byte("key005"), []byte("val005"))
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
@@ -300,6 +390,60 @@ func (s *RootStoreTestSuite) TestLoadVersion() {
 	s.Require().Equal([]byte("overwritten_val005"), val)
 }
 
+func (s *RootStoreTestSuite) TestRollback() {
+	hashes := make(map[uint64][]byte)
+	for v := uint64(1); v <= 5; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		if v > 3 {
+			cs.Add(testStoreKey2Bytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		}
+
+		commitHash, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+		hashes[v] = commitHash
+	}
+
+	rs := s.rootStore.(store.Rollbacker)
+
+	// attempt to rollback to a version greater than the latest version
+	s.Require().Error(rs.Rollback(6))
+
+	s.Require().NoError(rs.Rollback(3))
+
+	// ensure both SC and SS have been rolled back
+	latest, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), latest)
+
+	commitID, err := s.rootStore.LastCommitID()
+	s.Require().NoError(err)
+	s.Require().Equal(hashes[3], commitID.Hash)
+
+	ssLatest, err := s.rootStore.GetStateStorage().GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), ssLatest)
+
+	val, err := s.rootStore.GetStateStorage().Get(testStoreKeyBytes, 5, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val003"), val)
+
+	has, err := s.rootStore.GetStateStorage().Has(testStoreKey2Bytes, 5, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// replaying the same changesets must produce the same hashes
+	for v := uint64(4); v <= 5; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		cs.Add(testStoreKey2Bytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+
+		commitHash, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+		s.Require().Equal(hashes[v], commitHash)
+	}
+}
+
 func (s *RootStoreTestSuite) TestCommit() {
 	lv, err := s.rootStore.GetLatestVersion()
 	s.Require().NoError(err)
@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"io"
+	"sync"
 	"testing"
 	"time"
 
@@ -287,3 +288,75 @@ func (s *extSnapshotter) RestoreExtension(height uint64, format uint32, payloadR
 	// finalize restoration
 	return nil
 }
+
+// mockParallelCommitSnapshotter snapshots each store as a stream of extension payloads,
+// the root hash of a store is the hash of its payloads.
+type mockParallelCommitSnapshotter struct {
+	mockCommitSnapshotter
+
+	mtx       sync.Mutex
+	stores    map[string][][]byte
+	finalized uint64
+}
+
+var _ snapshots.ParallelCommitSnapshotter = (*mockParallelCommitSnapshotter)(nil)
+
+func (m *mockParallelCommitSnapshotter) SnapshotStores(version uint64) (map[string][]byte, error) {
+	hashes := make(map[string][]byte, len(m.stores))
+	for storeKey, items := range m.stores {
+		hashes[storeKey] = hash(items)
+	}
+	return hashes, nil
+}
+
+func (m *mockParallelCommitSnapshotter) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+		Item: &snapshotstypes.SnapshotItem_Store{Store: &snapshotstypes.SnapshotStoreItem{Name: storeKey}},
+	}); err != nil {
+		return err
+	}
+	for _, item := range m.stores[storeKey] {
+		if err := snapshotstypes.WriteExtensionPayload(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelCommitSnapshotter) RestoreStore(
+	version uint64, storeKey string, expected []byte, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) error {
+	var item snapshotstypes.SnapshotItem
+	if err := protoReader.ReadMsg(&item); err != nil {
+		return err
+	}
+	if item.GetStore().GetName() != storeKey {
+		return errors.New("unexpected store item")
+	}
+
+	items := [][]byte{}
+	for {
+		item.Reset()
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return errorsmod.Wrap(err, "invalid protobuf message")
+		}
+		items = append(items, item.GetExtensionPayload().Payload)
+	}
+
+	if expected != nil && !bytes.Equal(hash(items), expected) {
+		return snapshotstypes.ErrRootHashMismatch
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.stores[storeKey] = items
+	return nil
+}
+
+func (m *mockParallelCommitSnapshotter) FinalizeRestore(version uint64) error {
+	m.finalized = version
+	return nil
+}
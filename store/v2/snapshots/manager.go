@@ -2,15 +2,19 @@ package snapshots
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"os"
+	"runtime"
 	"sort"
 	"sync"
 
+	"golang.org/x/sync/errgroup"
+
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -185,6 +189,18 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	switch m.opts.Format {
+	case 0, types.CurrentFormat:
+	case types.FormatParallel:
+		sc, ok := m.commitSnapshotter.(ParallelCommitSnapshotter)
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v is not supported by the commitment snapshotter", m.opts.Format)
+		}
+		return m.createParallelSnapshot(height, sc)
+	default:
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", m.opts.Format)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -209,6 +225,90 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// createParallelSnapshot creates a snapshot in the types.FormatParallel format, the
+// tree of each store key is exported concurrently into its own chunk set.
+func (m *Manager) createParallelSnapshot(height uint64, sc ParallelCommitSnapshotter) (*types.Snapshot, error) {
+	hashes, err := sc.SnapshotStores(height)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get the stores to snapshot")
+	}
+
+	manifest := types.Manifest{Stores: make([]types.ManifestStore, 0, len(hashes))}
+	for storeKey, hash := range hashes {
+		manifest.Stores = append(manifest.Stores, types.ManifestStore{Name: storeKey, Hash: hash})
+	}
+	sort.Slice(manifest.Stores, func(i, j int) bool {
+		return manifest.Stores[i].Name < manifest.Stores[j].Name
+	})
+
+	// the last chunk set holds the extension snapshots
+	chunkSets := make([]<-chan io.ReadCloser, len(manifest.Stores)+1)
+	sem := make(chan struct{}, runtime.NumCPU())
+	for i, store := range manifest.Stores {
+		ch := make(chan io.ReadCloser)
+		chunkSets[i] = ch
+		go func(storeKey string) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			m.createStoreSnapshot(height, storeKey, sc, ch)
+		}(store.Name)
+	}
+	ch := make(chan io.ReadCloser)
+	chunkSets[len(manifest.Stores)] = ch
+	go m.createExtensionsSnapshot(height, ch)
+
+	return m.store.SaveChunkSets(height, types.FormatParallel, chunkSets, func(chunks []uint32) ([]byte, error) {
+		for i := range manifest.Stores {
+			manifest.Stores[i].Chunks = chunks[i]
+		}
+		manifest.ExtensionChunks = chunks[len(manifest.Stores)]
+		return manifest.Marshal()
+	})
+}
+
+// createStoreSnapshot writes the snapshot of the tree of the given store key to the channel.
+func (m *Manager) createStoreSnapshot(height uint64, storeKey string, sc ParallelCommitSnapshotter, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	if err := sc.SnapshotStore(height, storeKey, streamWriter); err != nil {
+		streamWriter.CloseWithError(errorsmod.Wrapf(err, "store %s", storeKey))
+	}
+}
+
+// createExtensionsSnapshot writes the snapshots of the extensions to the channel.
+func (m *Manager) createExtensionsSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// writeExtensions writes the metadata and payloads of the extension snapshots.
+func (m *Manager) writeExtensions(height uint64, streamWriter *StreamWriter) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -221,17 +321,17 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+
+	return nil
 }
 
 // CreateMigration creates a migration snapshot and writes it to the given writer.
@@ -310,9 +410,12 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsFormatSupported(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if _, ok := m.commitSnapshotter.(ParallelCommitSnapshotter); !ok && snapshot.Format == types.FormatParallel {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v is not supported by the commitment snapshotter", snapshot.Format)
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storeerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -378,13 +481,163 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
+	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
+	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+
+	storageErrs := make(chan error, 1)
+	go func() {
+		defer close(storageErrs)
+		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
+		if err != nil {
+			storageErrs <- err
+		}
+		// keep draining the channel so the commitment restore doesn't block if
+		// the storage snapshotter returned early
+		for range chStorage { //nolint:revive // drain the channel
+		}
+	}()
+
+	var err error
+	if snapshot.Format == types.FormatParallel {
+		err = m.restoreParallelSnapshot(snapshot, chChunks, chStorage)
+	} else {
+		err = m.restoreStreamSnapshot(snapshot, chChunks, chStorage)
+	}
+	close(chStorage)
+	if err != nil {
+		return err
+	}
+
+	// wait for storage snapshotter to complete
+	if err := <-storageErrs; err != nil {
+		return errorsmod.Wrap(err, "storage snapshotter")
+	}
+
+	return nil
+}
+
+// restoreStreamSnapshot restores a snapshot made of a single stream of snapshot items.
+func (m *Manager) restoreStreamSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, chStorage chan<- *corestore.StateChanges) error {
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	nextItem, err := m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreExtensions(snapshot, streamReader, nextItem)
+}
+
+// restoreParallelSnapshot restores a snapshot in the types.FormatParallel format. The
+// chunks of each store are passed to a dedicated restore of the store tree, so the
+// trees are restored concurrently, and the restored version is only loaded once the
+// root hash of every tree has been verified against the manifest.
+func (m *Manager) restoreParallelSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, chStorage chan<- *corestore.StateChanges) error {
+	defer DrainChunks(chChunks)
+
+	sc, ok := m.commitSnapshotter.(ParallelCommitSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v is not supported by the commitment snapshotter", snapshot.Format)
+	}
+
+	manifestChunk, ok := <-chChunks
+	if !ok {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "missing manifest chunk")
+	}
+	bz, err := io.ReadAll(manifestChunk)
+	_ = manifestChunk.Close()
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read manifest chunk")
+	}
+	var manifest types.Manifest
+	if err := manifest.Unmarshal(bz); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "invalid manifest: %v", err)
+	}
+	if manifest.TotalChunks() != uint64(snapshot.Chunks) {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "manifest has %d chunks, but snapshot has %d chunks",
+			manifest.TotalChunks(), snapshot.Chunks)
+	}
+
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(runtime.NumCPU())
+	for _, store := range manifest.Stores {
+		store := store
+		chStore := make(chan io.ReadCloser, chunkBufferSize)
+		g.Go(func() error {
+			defer DrainChunks(chStore)
+			return m.restoreStore(sc, snapshot.Height, store, chStore, chStorage)
+		})
+
+		err := m.routeChunks(ctx, chChunks, chStore, store.Chunks)
+		close(chStore)
+		if err != nil {
+			return errors.Join(err, g.Wait())
+		}
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if err := sc.FinalizeRestore(snapshot.Height); err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	var nextItem types.SnapshotItem
+	if err := streamReader.ReadMsg(&nextItem); err != nil && !errors.Is(err, io.EOF) {
+		return errorsmod.Wrap(err, "invalid protobuf message")
+	}
+
+	return m.restoreExtensions(snapshot, streamReader, nextItem)
+}
+
+// routeChunks passes the given number of chunks to the channel of a store restore.
+func (m *Manager) routeChunks(ctx context.Context, chChunks <-chan io.ReadCloser, chStore chan<- io.ReadCloser, chunks uint32) error {
+	for i := uint32(0); i < chunks; i++ {
+		chunk, ok := <-chChunks
+		if !ok {
+			return errorsmod.Wrap(storeerrors.ErrLogic, "chunk stream ended prematurely")
+		}
+		select {
+		case chStore <- chunk:
+		case <-ctx.Done():
+			_ = chunk.Close()
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// restoreStore restores the tree of a store from its chunks and verifies its root hash.
+func (m *Manager) restoreStore(
+	sc ParallelCommitSnapshotter, height uint64, store types.ManifestStore,
+	chStore <-chan io.ReadCloser, chStorage chan<- *corestore.StateChanges,
+) error {
+	streamReader, err := NewStreamReader(chStore)
+	if err != nil {
+		return errorsmod.Wrapf(err, "store %s", store.Name)
+	}
+	defer streamReader.Close()
+
+	if err := sc.RestoreStore(height, store.Name, store.Hash, streamReader, chStorage); err != nil {
+		return errorsmod.Wrapf(err, "store %s restore", store.Name)
+	}
+
+	return nil
+}
+
+// restoreExtensions restores the extension snapshots, starting from the given item.
+func (m *Manager) restoreExtensions(snapshot types.Snapshot, streamReader *StreamReader, nextItem types.SnapshotItem) error {
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
@@ -398,24 +651,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
-	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
-	defer close(chStorage)
-
-	storageErrs := make(chan error, 1)
-	go func() {
-		defer close(storageErrs)
-		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
-		if err != nil {
-			storageErrs <- err
-		}
-	}()
-
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
-	if err != nil {
-		return errorsmod.Wrap(err, "multistore restore")
-	}
-
 	for {
 		if nextItem.Item == nil {
 			// end of stream
@@ -442,11 +677,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 	}
 
-	// wait for storage snapshotter to complete
-	if err := <-storageErrs; err != nil {
-		return errorsmod.Wrap(err, "storage snapshotter")
-	}
-
 	return nil
 }
 
@@ -254,3 +254,83 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_ParallelFormatUnsupported(t *testing.T) {
+	parallelOpts := opts
+	parallelOpts.Format = types.FormatParallel
+	manager := snapshots.NewManager(setupStore(t), parallelOpts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	_, err := manager.Create(5)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
+
+func TestManager_ParallelSnapshot(t *testing.T) {
+	source := &mockParallelCommitSnapshotter{stores: map[string][][]byte{
+		"store1": {{1, 2, 3}, {4, 5, 6}},
+		"store2": {{7, 8, 9}},
+		"store3": {},
+	}}
+	// the parallel format is opt-in
+	manager := snapshots.NewManager(setupStore(t), opts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(4)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentFormat, snapshot.Format)
+
+	parallelOpts := opts
+	parallelOpts.Format = types.FormatParallel
+	manager = snapshots.NewManager(setupStore(t), parallelOpts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	snapshot, err = manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatParallel, snapshot.Format)
+	// the manifest, one chunk per store and the extensions chunk
+	require.Equal(t, uint32(5), snapshot.Chunks)
+	require.Equal(t, hash(snapshot.Metadata.ChunkHashes), snapshot.Hash)
+
+	chunks := make([][]byte, snapshot.Chunks)
+	for i := range chunks {
+		chunks[i], err = manager.LoadChunk(snapshot.Height, snapshot.Format, uint32(i))
+		require.NoError(t, err)
+	}
+	require.Equal(t, snapshot.Metadata.ChunkHashes, checksums(chunks))
+
+	var manifest types.Manifest
+	require.NoError(t, manifest.Unmarshal(chunks[0]))
+	require.Len(t, manifest.Stores, 3)
+	require.Equal(t, "store1", manifest.Stores[0].Name)
+	require.Equal(t, uint64(snapshot.Chunks), manifest.TotalChunks())
+
+	restore := func(snapshot types.Snapshot, chunks [][]byte) (*mockParallelCommitSnapshotter, *extSnapshotter, error) {
+		target := &mockParallelCommitSnapshotter{stores: map[string][][]byte{}}
+		extSnapshotter := newExtSnapshotter(0)
+		manager := snapshots.NewManager(setupStore(t), opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+		require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+		require.NoError(t, manager.Restore(snapshot))
+
+		for i, chunk := range chunks {
+			done, err := manager.RestoreChunk(chunk)
+			if err != nil {
+				return target, extSnapshotter, err
+			}
+			require.Equal(t, i == len(chunks)-1, done)
+		}
+		return target, extSnapshotter, nil
+	}
+
+	target, extSnapshotter, err := restore(*snapshot, chunks)
+	require.NoError(t, err)
+	require.Equal(t, source.stores, target.stores)
+	require.Equal(t, uint64(5), target.finalized)
+	require.Len(t, extSnapshotter.state, 10)
+
+	// a tree whose root hash doesn't match the manifest fails the restore
+	manifest.Stores[1].Hash = []byte{1, 2, 3}
+	chunks[0], err = manifest.Marshal()
+	require.NoError(t, err)
+	snapshot.Metadata.ChunkHashes = checksums(chunks)
+
+	target, _, err = restore(*snapshot, chunks)
+	require.ErrorIs(t, err, types.ErrRootHashMismatch)
+	require.Zero(t, target.finalized)
+}
@@ -8,6 +8,12 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Format defines the format of the snapshots taken, types.CurrentFormat if zero.
+	// types.FormatParallel requires a commitment snapshotter implementing
+	// ParallelCommitSnapshotter, and must only be enabled once the nodes state
+	// syncing from this node are able to restore it.
+	Format uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -17,6 +17,30 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// ParallelCommitSnapshotter defines an API for creating and restoring snapshots
+// of the commitment state tree by tree, in the types.FormatParallel format. The
+// trees are independent of each other, so the methods are called concurrently
+// for different store keys.
+type ParallelCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// SnapshotStores returns the store keys of the trees to snapshot at the given
+	// version, along with their root hashes.
+	SnapshotStores(version uint64) (map[string][]byte, error)
+
+	// SnapshotStore writes a snapshot of the tree of the given store key at the
+	// given version.
+	SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the tree of the given store key from the snapshot reader.
+	// When the given root hash is not nil, the restored tree is verified against it
+	// before being saved, and types.ErrRootHashMismatch is returned if they differ.
+	RestoreStore(version uint64, storeKey string, hash []byte, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error
+
+	// FinalizeRestore loads the restored version once all the trees are restored.
+	FinalizeRestore(version uint64) error
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
@@ -15,6 +15,7 @@ import (
 	"sync"
 
 	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/sync/errgroup"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/errors"
@@ -294,6 +295,146 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// SaveChunkSets saves a snapshot made of several chunk sets which are produced
+// concurrently. The chunks of each set are persisted as they are produced, and
+// once all the sets are complete they are laid out in order after the header
+// chunk, which is built from the number of chunks of each set.
+//
+// As the chunks aren't hashed in order, the snapshot hash is the SHA-256 hash of
+// the chunk hashes rather than the one of the chunk contents.
+func (s *Store) SaveChunkSets(
+	height uint64, format uint32, chunkSets []<-chan io.ReadCloser, header func(chunks []uint32) ([]byte, error),
+) (_ *types.Snapshot, err error) {
+	defer func() {
+		for _, chunks := range chunkSets {
+			DrainChunks(chunks)
+		}
+	}()
+	if height == 0 {
+		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+	if saving {
+		return nil, errors.Wrapf(storeerrors.ErrConflict,
+			"a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	// create height directory or do nothing
+	if err := os.MkdirAll(s.pathHeight(height), 0o750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v", height)
+	}
+	// create format directory or fail (if for example the format directory already exists)
+	if err := os.Mkdir(s.pathSnapshot(height, format), 0o750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v format %v", height, format)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(s.pathSnapshot(height, format))
+		}
+	}()
+
+	// save the chunks of each set into its own temporary directory
+	chunkHashes := make([][][]byte, len(chunkSets))
+	g := new(errgroup.Group)
+	for i, chunks := range chunkSets {
+		dir := s.pathChunkSet(height, format, i)
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, errors.Wrapf(err, "failed to create chunk set directory %q", dir)
+		}
+		i, chunks := i, chunks
+		g.Go(func() error {
+			defer DrainChunks(chunks)
+
+			hasher := sha256.New()
+			for chunkBody := range chunks {
+				path := filepath.Join(dir, strconv.Itoa(len(chunkHashes[i])))
+				if err := saveChunkFile(chunkBody, path, hasher); err != nil {
+					return errors.Wrapf(err, "chunk set %d", i)
+				}
+				chunkHashes[i] = append(chunkHashes[i], hasher.Sum(nil))
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	counts := make([]uint32, len(chunkSets))
+	for i, hashes := range chunkHashes {
+		counts[i] = uint32(len(hashes))
+	}
+	headerChunk, err := header(counts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build the header chunk")
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+	}
+	if err := s.saveChunkContent(headerChunk, 0, snapshot); err != nil {
+		return nil, errors.Wrap(err, "failed to save the header chunk")
+	}
+	headerHash := sha256.Sum256(headerChunk)
+	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, headerHash[:])
+
+	index := uint32(1)
+	for i, hashes := range chunkHashes {
+		dir := s.pathChunkSet(height, format, i)
+		for j, chunkHash := range hashes {
+			if err := os.Rename(filepath.Join(dir, strconv.Itoa(j)), s.PathChunk(height, format, index)); err != nil {
+				return nil, errors.Wrapf(err, "failed to move chunk %d of chunk set %d", j, i)
+			}
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash)
+			index++
+		}
+		if err := os.Remove(dir); err != nil {
+			return nil, errors.Wrapf(err, "failed to remove chunk set directory %q", dir)
+		}
+	}
+
+	snapshotHasher := sha256.New()
+	for _, chunkHash := range snapshot.Metadata.ChunkHashes {
+		snapshotHasher.Write(chunkHash)
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// saveChunkFile writes the chunk body into the file at the given path, hashing it
+// with the given hasher.
+func saveChunkFile(chunkBody io.ReadCloser, path string, hasher hash.Hash) error {
+	defer chunkBody.Close()
+
+	chunkFile, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+	}
+	defer chunkFile.Close()
+
+	hasher.Reset()
+	if _, err := io.Copy(io.MultiWriter(chunkFile, hasher), chunkBody); err != nil {
+		return errors.Wrapf(err, "failed to generate snapshot chunk %q", path)
+	}
+
+	if err := chunkFile.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close snapshot chunk file %q", path)
+	}
+
+	return chunkBody.Close()
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
@@ -362,6 +503,11 @@ func (s *Store) pathMetadata(height uint64, format uint32) string {
 	return filepath.Join(s.pathMetadataDir(), fmt.Sprintf("%020d-%08d", height, format))
 }
 
+// pathChunkSet generates the temporary directory of a chunk set being saved.
+func (s *Store) pathChunkSet(height uint64, format uint32, set int) string {
+	return filepath.Join(s.pathSnapshot(height, format), fmt.Sprintf("set-%d", set))
+}
+
 // PathChunk generates a snapshot chunk path.
 func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
@@ -11,6 +11,10 @@ var (
 	// ErrChunkHashMismatch is returned when chunk hash verification failed.
 	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
 
+	// ErrRootHashMismatch is returned when the root hash of a restored tree doesn't match
+	// the one of the snapshot.
+	ErrRootHashMismatch = errors.New("root hash verification failed")
+
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 
@@ -4,3 +4,14 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// FormatParallel is the snapshot format where the tree of each store key is exported
+// independently into its own set of chunks, allowing the trees to be snapshotted and
+// restored concurrently. The chunk sets are preceded by a chunk holding the encoded
+// Manifest of the snapshot, and followed by the chunks of the extension snapshotters.
+const FormatParallel uint32 = 4
+
+// IsFormatSupported returns true if the given snapshot format can be restored.
+func IsFormatSupported(format uint32) bool {
+	return format == CurrentFormat || format == FormatParallel
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+// Manifest describes the layout of a FormatParallel snapshot: the chunk sets of
+// the store trees, in order, followed by the chunks of the extension snapshotters.
+type Manifest struct {
+	// Stores are the snapshotted trees, sorted by store key.
+	Stores []ManifestStore
+	// ExtensionChunks is the number of chunks holding the extension snapshots.
+	ExtensionChunks uint32
+}
+
+// ManifestStore describes the chunk set of a single store tree.
+type ManifestStore struct {
+	// Name is the store key of the tree.
+	Name string
+	// Hash is the root hash of the tree at the snapshot height, which the restored
+	// tree is verified against.
+	Hash []byte
+	// Chunks is the number of chunks holding the tree.
+	Chunks uint32
+}
+
+// TotalChunks returns the number of chunks of the snapshot described by the
+// manifest, including the chunk holding the manifest itself.
+func (m *Manifest) TotalChunks() uint64 {
+	total := uint64(1) + uint64(m.ExtensionChunks)
+	for _, store := range m.Stores {
+		total += uint64(store.Chunks)
+	}
+	return total
+}
+
+// Marshal returns the encoded byte representation of Manifest.
+// NOTE: Manifest is encoded as follows:
+// - number of stores (uvarint)
+// - for each store:
+//   - store name (bytes)
+//   - store hash (bytes)
+//   - number of chunks (uvarint)
+//
+// - number of extension chunks (uvarint)
+func (m *Manifest) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := encoding.EncodeUvarint(&buf, uint64(len(m.Stores))); err != nil {
+		return nil, err
+	}
+	for _, store := range m.Stores {
+		if err := encoding.EncodeBytes(&buf, []byte(store.Name)); err != nil {
+			return nil, err
+		}
+		if err := encoding.EncodeBytes(&buf, store.Hash); err != nil {
+			return nil, err
+		}
+		if err := encoding.EncodeUvarint(&buf, uint64(store.Chunks)); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoding.EncodeUvarint(&buf, uint64(m.ExtensionChunks)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal unmarshals the encoded byte representation of Manifest.
+func (m *Manifest) Unmarshal(buf []byte) error {
+	storesLen, n, err := encoding.DecodeUvarint(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+	// every store takes at least 3 bytes, bound the allocation by the buffer size
+	if storesLen > uint64(len(buf)) {
+		return fmt.Errorf("invalid number of stores %d", storesLen)
+	}
+	m.Stores = make([]ManifestStore, storesLen)
+	for i := range m.Stores {
+		name, n, err := encoding.DecodeBytes(buf)
+		if err != nil {
+			return err
+		}
+		buf = buf[n:]
+		hash, n, err := encoding.DecodeBytes(buf)
+		if err != nil {
+			return err
+		}
+		buf = buf[n:]
+		chunks, n, err := encoding.DecodeUvarint(buf)
+		if err != nil {
+			return err
+		}
+		buf = buf[n:]
+		if chunks > math.MaxUint32 {
+			return fmt.Errorf("invalid number of chunks %d for store %s", chunks, name)
+		}
+		m.Stores[i] = ManifestStore{Name: string(name), Hash: hash, Chunks: uint32(chunks)}
+	}
+	extensionChunks, n, err := encoding.DecodeUvarint(buf)
+	if err != nil {
+		return err
+	}
+	if extensionChunks > math.MaxUint32 {
+		return fmt.Errorf("invalid number of extension chunks %d", extensionChunks)
+	}
+	if n != len(buf) {
+		return fmt.Errorf("unexpected %d trailing bytes in manifest", len(buf)-n)
+	}
+	m.ExtensionChunks = uint32(extensionChunks)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/metrics"
+)
+
+// CompactionManager compacts the versions of a storage database in the background,
+// every given number of committed versions. See store.Compactor.
+type CompactionManager struct {
+	logger    log.Logger
+	compactor store.Compactor
+	telemetry metrics.StoreMetrics
+
+	// interval is the number of versions between two compactions, 0 disables the
+	// background compactions.
+	interval uint64
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCompactionManager creates a new CompactionManager compacting the given
+// compactor every interval versions.
+func NewCompactionManager(compactor store.Compactor, interval uint64, logger log.Logger) *CompactionManager {
+	return &CompactionManager{
+		logger:    logger.With("module", "compaction_manager"),
+		compactor: compactor,
+		interval:  interval,
+	}
+}
+
+// SetMetrics sets the metrics the compaction progress is reported to.
+func (m *CompactionManager) SetMetrics(telemetry metrics.StoreMetrics) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.telemetry = telemetry
+}
+
+// SignalCommit signals that the given version has been committed. A compaction up
+// to the version is started in the background if the version is a multiple of the
+// interval and no compaction is running.
+func (m *CompactionManager) SignalCommit(version uint64) {
+	if m.interval == 0 || version%m.interval != 0 {
+		return
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.done != nil {
+		select {
+		case <-m.done:
+		default:
+			m.logger.Debug("skipping compaction, the previous one is still running", "version", version)
+			return
+		}
+	}
+
+	if m.cancel != nil {
+		m.cancel() // releases the context of the previous compaction
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	go func(telemetry metrics.StoreMetrics, done chan struct{}) {
+		defer close(done)
+
+		if _, err := Compact(ctx, m.compactor, version, m.logger, telemetry); err != nil && !errors.Is(err, context.Canceled) {
+			m.logger.Error("failed to compact the storage database", "version", version, "err", err)
+		}
+	}(m.telemetry, m.done)
+}
+
+// Close cancels the running compaction, if any, and waits for it to return.
+func (m *CompactionManager) Close() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.cancel != nil {
+		m.cancel()
+		<-m.done
+		m.cancel = nil
+	}
+
+	return nil
+}
+
+// Compact compacts the given compactor up to the given version in the foreground,
+// reporting the progress to the logger and the metrics (if any).
+func Compact(ctx context.Context, compactor store.Compactor, version uint64, logger log.Logger, telemetry metrics.StoreMetrics) (store.CompactionStats, error) {
+	var last store.CompactionStats
+	if telemetry != nil {
+		now := time.Now()
+		defer telemetry.MeasureSince(now, "storage", "compaction")
+	}
+	// the progress is reported only if the metrics support counters and gauges
+	counters, _ := telemetry.(metrics.CounterMetrics)
+	if counters != nil {
+		counters.SetGauge(float32(version), "storage", "compaction", "version")
+	}
+
+	stats, err := compactor.Compact(ctx, version, func(stats store.CompactionStats) {
+		logger.Debug("compaction progress", "version", version, "scanned", stats.EntriesScanned, "removed", stats.EntriesRemoved)
+		if counters != nil {
+			counters.IncrCounter(float32(stats.EntriesScanned-last.EntriesScanned), "storage", "compaction", "entries_scanned")
+			counters.IncrCounter(float32(stats.EntriesRemoved-last.EntriesRemoved), "storage", "compaction", "entries_removed")
+			counters.IncrCounter(float32(stats.BytesRemoved-last.BytesRemoved), "storage", "compaction", "bytes_removed")
+		}
+		last = stats
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	logger.Info("compacted the storage database", "version", version, "scanned", stats.EntriesScanned, "removed", stats.EntriesRemoved, "bytes_removed", stats.BytesRemoved)
+	return stats, nil
+}
@@ -0,0 +1,73 @@
+package storage_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+// blockingCompactor records the compacted versions, each compaction blocking until
+// it is released or canceled.
+type blockingCompactor struct {
+	mtx      sync.Mutex
+	versions []uint64
+	canceled int
+	release  chan struct{}
+}
+
+func (c *blockingCompactor) Compact(ctx context.Context, version uint64, progress func(store.CompactionStats)) (store.CompactionStats, error) {
+	c.mtx.Lock()
+	c.versions = append(c.versions, version)
+	c.mtx.Unlock()
+
+	select {
+	case <-c.release:
+		stats := store.CompactionStats{EntriesScanned: 2, EntriesRemoved: 1}
+		progress(stats)
+		return stats, nil
+	case <-ctx.Done():
+		c.mtx.Lock()
+		c.canceled++
+		c.mtx.Unlock()
+		return store.CompactionStats{}, ctx.Err()
+	}
+}
+
+func (c *blockingCompactor) compacted() []uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return append([]uint64(nil), c.versions...)
+}
+
+func TestCompactionManager(t *testing.T) {
+	compactor := &blockingCompactor{release: make(chan struct{})}
+	m := storage.NewCompactionManager(compactor, 5, log.NewNopLogger())
+
+	for v := uint64(1); v <= 4; v++ {
+		m.SignalCommit(v)
+	}
+	m.SignalCommit(5)
+	require.Eventually(t, func() bool { return len(compactor.compacted()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// the compaction at 10 is skipped as the one at 5 is still running
+	m.SignalCommit(10)
+	compactor.release <- struct{}{}
+	require.Eventually(t, func() bool {
+		// the signal is skipped until the compaction at 5 returns
+		m.SignalCommit(15)
+		return len(compactor.compacted()) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{5, 15}, compactor.compacted())
+
+	// closing the manager cancels the running compaction
+	require.NoError(t, m.Close())
+	require.Equal(t, 1, compactor.canceled)
+}
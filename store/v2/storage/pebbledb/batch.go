@@ -4,10 +4,12 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/cockroachdb/pebble"
 
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 var _ store.Batch = (*Batch)(nil)
@@ -17,6 +19,12 @@ type Batch struct {
 	batch   *pebble.Batch
 	version uint64
 	sync    bool
+
+	// db is the database the batch is written to, if created by it, whose range
+	// tombstones are updated once the batch is written.
+	db *Database
+	// rangeTombstones are the range tombstones written by the batch.
+	rangeTombstones []util.RangeTombstone
 }
 
 func NewBatch(storage *pebble.DB, version uint64, sync bool) (*Batch, error) {
@@ -65,10 +73,42 @@ func (b *Batch) Delete(storeKey, key []byte) error {
 	return b.set(storeKey, b.version, key, []byte(tombstoneVal))
 }
 
+// DeleteRange writes a single range tombstone removing the keys of the range
+// written before the batch version, which is accounted for on reads.
+func (b *Batch) DeleteRange(storeKey, start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+
+	t := util.RangeTombstone{
+		StoreKey: slices.Clone(storeKey),
+		Start:    slices.Clone(start),
+		End:      slices.Clone(end),
+		Version:  b.version,
+	}
+	key := util.RangeTombstoneKey([]byte(rangeTombstonePrefix), b.version, uint32(len(b.rangeTombstones)))
+	if err := b.batch.Set(key, t.Marshal(), nil); err != nil {
+		return fmt.Errorf("failed to write PebbleDB batch: %w", err)
+	}
+	b.rangeTombstones = append(b.rangeTombstones, t)
+
+	return nil
+}
+
 func (b *Batch) Write() (err error) {
 	defer func() {
 		err = errors.Join(err, b.batch.Close())
 	}()
 
-	return b.batch.Commit(&pebble.WriteOptions{Sync: b.sync})
+	if err := b.batch.Commit(&pebble.WriteOptions{Sync: b.sync}); err != nil {
+		return err
+	}
+	if b.db != nil && len(b.rangeTombstones) > 0 {
+		b.db.rangeTombstones.Add(b.rangeTombstones...)
+	}
+
+	return nil
 }
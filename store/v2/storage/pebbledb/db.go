@@ -2,11 +2,13 @@ package pebbledb
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
 	"slices"
+	"sync"
 
 	"github.com/cockroachdb/pebble"
 
@@ -14,6 +16,7 @@ import (
 	"cosmossdk.io/store/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 const (
@@ -21,14 +24,23 @@ const (
 	// PruneCommitBatchSize defines the size, in number of key/value pairs, to prune
 	// in a single batch.
 	PruneCommitBatchSize = 50
+	// CompactCommitBatchSize defines the size, in number of key/value pairs, to
+	// remove in a single batch when compacting.
+	CompactCommitBatchSize = 1000
 
 	StorePrefixTpl   = "s/k:%s/"         // s/k:<storeKey>
 	latestVersionKey = "s/_latest"       // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
 	pruneHeightKey   = "s/_prune_height" // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
 	tombstoneVal     = "TOMBSTONE"
+
+	// NB: rangeTombstonePrefix must be lexically smaller than StorePrefixTpl
+	rangeTombstonePrefix = "s/_range/" // s/_range/<version><seq> -> range tombstone
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database = (*Database)(nil)
+	_ store.Compactor  = (*Database)(nil)
+)
 
 type Database struct {
 	storage *pebble.DB
@@ -47,6 +59,14 @@ type Database struct {
 	// call in which the data is buffered in the OS buffer cache and would thus
 	// survive a process crash.
 	sync bool
+
+	// rangeTombstones holds the range tombstones written by the batches, which
+	// are accounted for on reads until they are pruned.
+	rangeTombstones *util.RangeTombstones
+
+	// mtx guards the operations rewriting the history of the keys, i.e. Prune,
+	// Compact and Rollback, which must not run concurrently.
+	mtx sync.Mutex
 }
 
 func New(dataDir string) (*Database, error) {
@@ -65,10 +85,16 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
+	rangeTombstones, err := getRangeTombstones(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range tombstones: %w", err)
+	}
+
 	return &Database{
 		storage:         db,
 		earliestVersion: pruneHeight + 1,
 		sync:            true,
+		rangeTombstones: rangeTombstones,
 	}, nil
 }
 
@@ -78,10 +104,16 @@ func NewWithDB(storage *pebble.DB, sync bool) *Database {
 		panic(fmt.Errorf("failed to get prune height: %w", err))
 	}
 
+	rangeTombstones, err := getRangeTombstones(storage)
+	if err != nil {
+		panic(fmt.Errorf("failed to get range tombstones: %w", err))
+	}
+
 	return &Database{
 		storage:         storage,
 		earliestVersion: pruneHeight + 1,
 		sync:            sync,
+		rangeTombstones: rangeTombstones,
 	}
 }
 
@@ -100,6 +132,7 @@ func (db *Database) NewBatch(version uint64) (store.Batch, error) {
 	if err != nil {
 		return nil, err
 	}
+	b.db = db
 
 	return b, nil
 }
@@ -152,7 +185,7 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: targetVersion}
 	}
 
-	prefixedVal, err := getMVCCSlice(db.storage, storeKey, key, targetVersion)
+	prefixedVal, keyVersion, err := getMVCCSlice(db.storage, storeKey, key, targetVersion)
 	if err != nil {
 		if errors.Is(err, storeerrors.ErrRecordNotFound) {
 			return nil, nil
@@ -166,6 +199,11 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 		return nil, fmt.Errorf("invalid PebbleDB MVCC value: %s", prefixedVal)
 	}
 
+	// the key may have been removed by a range removal after it was written
+	if db.rangeTombstones.Removes(storeKey, key, keyVersion, targetVersion) {
+		return nil, nil
+	}
+
 	// A tombstone of zero or a target version that is less than the tombstone
 	// version means the key is not deleted at the target version.
 	if len(tombBz) == 0 {
@@ -196,6 +234,9 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 //
 // See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
 func (db *Database) Prune(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
 	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
 	if err != nil {
 		return err
@@ -205,6 +246,10 @@ func (db *Database) Prune(version uint64) error {
 	batch := db.storage.NewBatch()
 	defer batch.Close()
 
+	// the range tombstones <= the prune height are applied, i.e. the versions of
+	// the keys they remove are deleted, so they are deleted as well
+	rangeTombstones := db.rangeTombstones.Until(version)
+
 	var (
 		batchCounter                              int
 		prevKey, prevKeyPrefixed, prevPrefixedVal []byte
@@ -230,6 +275,24 @@ func (db *Database) Prune(version uint64) error {
 			continue
 		}
 
+		// Delete a key if it has been removed by a range tombstone <= to the prune
+		// height.
+		if len(rangeTombstones) > 0 && rangeTombstoneRemoves(rangeTombstones, keyBz, keyVersion, version) {
+			if err := batch.Delete(prefixedKey, nil); err != nil {
+				return err
+			}
+
+			batchCounter++
+			if batchCounter >= PruneCommitBatchSize {
+				if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+					return err
+				}
+
+				batchCounter = 0
+				batch.Reset()
+			}
+		}
+
 		// Delete a key if another entry for that key exists a larger version than
 		// the original but <= to the prune height. We also delete a key if it has
 		// been tombstoned and its version is <= to the prune height.
@@ -257,16 +320,204 @@ func (db *Database) Prune(version uint64) error {
 		itr.Next()
 	}
 
+	if len(rangeTombstones) > 0 {
+		if err := batch.DeleteRange([]byte(rangeTombstonePrefix), util.RangeTombstoneKey([]byte(rangeTombstonePrefix), version+1, 0), nil); err != nil {
+			return err
+		}
+		batchCounter++
+	}
+
 	// commit any leftover delete ops in batch
 	if batchCounter > 0 {
 		if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
 			return err
 		}
 	}
+	db.rangeTombstones.DeleteUntil(version)
 
 	return db.setPruneHeight(version)
 }
 
+// Compact removes every version <= the given version of a key which holds the same
+// value as the previous version of the key, neither being tombstoned, so the reads
+// fall back to the previous version holding the same value.
+//
+// Note, similarly to Prune, the implementation iterates over all keys in the
+// database.
+func (db *Database) Compact(ctx context.Context, version uint64, progress func(store.CompactionStats)) (stats store.CompactionStats, err error) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
+	if err != nil {
+		return stats, err
+	}
+	defer itr.Close()
+
+	batch := db.storage.NewBatch()
+	defer batch.Close()
+
+	// a version can't fall back to a previous version removed by a range tombstone
+	rangeTombstones := db.rangeTombstones.Until(version)
+
+	var (
+		batchCounter     int
+		prevKey, prevVal []byte
+		prevVersion      uint64
+	)
+
+	commit := func() error {
+		if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+			return err
+		}
+		batchCounter = 0
+		batch.Reset()
+		if progress != nil {
+			progress(stats)
+		}
+
+		return ctx.Err()
+	}
+
+	for itr.First(); itr.Valid(); {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return stats, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		keyVersion, err := decodeUint64Ascending(verBz)
+		if err != nil {
+			return stats, fmt.Errorf("failed to decode key version: %w", err)
+		}
+
+		// seek to next key if we are at a version which is higher than the compaction
+		// height, the versions of a key being sorted in ascending order
+		if keyVersion > version {
+			prevKey, prevVal = nil, nil
+			itr.NextPrefix()
+			continue
+		}
+
+		stats.EntriesScanned++
+		if stats.EntriesScanned%CompactCommitBatchSize == 0 && ctx.Err() != nil {
+			// commit the removals so far and return the context error
+			return stats, commit()
+		}
+
+		// The previous entry is kept as the previous version of the key, as it holds
+		// the same value as the removed entry.
+		val := itr.Value()
+		if bytes.Equal(prevKey, keyBz) && bytes.Equal(prevVal, val) && !valTombstoned(val) &&
+			!rangeTombstoneRemoves(rangeTombstones, keyBz, prevVersion, keyVersion) {
+			if err := batch.Delete(itr.Key(), nil); err != nil {
+				return stats, err
+			}
+
+			stats.EntriesRemoved++
+			stats.BytesRemoved += uint64(len(itr.Key()) + len(val))
+			batchCounter++
+			if batchCounter >= CompactCommitBatchSize {
+				if err := commit(); err != nil {
+					return stats, err
+				}
+			}
+		} else {
+			prevKey = slices.Clone(keyBz)
+			prevVal = slices.Clone(val)
+			prevVersion = keyVersion
+		}
+
+		itr.Next()
+	}
+
+	// commit any leftover delete ops in batch
+	if batchCounter > 0 {
+		if err := commit(); err != nil {
+			return stats, err
+		}
+	}
+
+	return stats, nil
+}
+
+// Rollback removes all versions of all keys that are > the given version, and
+// sets the latest version to the given version.
+//
+// Note, similarly to Prune, the implementation iterates over all keys in the
+// database.
+func (db *Database) Rollback(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	pruneHeight, err := getPruneHeight(db.storage)
+	if err != nil {
+		return err
+	}
+	if pruneHeight > 0 && version <= pruneHeight {
+		return fmt.Errorf("cannot rollback to version %d, versions <= %d have been pruned", version, pruneHeight)
+	}
+
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	batch := db.storage.NewBatch()
+	defer batch.Close()
+
+	var batchCounter int
+	for itr.First(); itr.Valid(); itr.Next() {
+		_, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		keyVersion, err := decodeUint64Ascending(verBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode key version: %w", err)
+		}
+		if keyVersion <= version {
+			continue
+		}
+
+		if err := batch.Delete(slices.Clone(itr.Key()), nil); err != nil {
+			return err
+		}
+
+		batchCounter++
+		if batchCounter >= PruneCommitBatchSize {
+			if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+				return err
+			}
+
+			batchCounter = 0
+			batch.Reset()
+		}
+	}
+
+	if err := batch.DeleteRange(
+		util.RangeTombstoneKey([]byte(rangeTombstonePrefix), version+1, 0),
+		util.CopyIncr([]byte(rangeTombstonePrefix)),
+		nil,
+	); err != nil {
+		return err
+	}
+
+	var ts [VersionSize]byte
+	binary.LittleEndian.PutUint64(ts[:], version)
+	if err := batch.Set([]byte(latestVersionKey), ts[:], nil); err != nil {
+		return err
+	}
+
+	if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+		return err
+	}
+	db.rangeTombstones.DeleteAfter(version)
+
+	return nil
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -288,7 +539,7 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, false), nil
+	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, false, db.rangeTombstones.Store(storeKey, version)), nil
 }
 
 func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -312,7 +563,7 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
+	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true, db.rangeTombstones.Store(storeKey, version)), nil
 }
 
 func storePrefix(storeKey []byte) []byte {
@@ -362,7 +613,9 @@ func valTombstoned(value []byte) bool {
 	return true
 }
 
-func getMVCCSlice(db *pebble.DB, storeKey, key []byte, version uint64) ([]byte, error) {
+// getMVCCSlice returns the MVCC value of the latest version <= the given version
+// of the key, along with that version.
+func getMVCCSlice(db *pebble.DB, storeKey, key []byte, version uint64) ([]byte, uint64, error) {
 	// end domain is exclusive, so we need to increment the version by 1
 	if version < math.MaxUint64 {
 		version++
@@ -373,27 +626,68 @@ func getMVCCSlice(db *pebble.DB, storeKey, key []byte, version uint64) ([]byte,
 		UpperBound: MVCCEncode(prependStoreKey(storeKey, key), version),
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer itr.Close()
 
 	if !itr.Last() {
-		return nil, storeerrors.ErrRecordNotFound
+		return nil, 0, storeerrors.ErrRecordNotFound
 	}
 
 	_, vBz, ok := SplitMVCCKey(itr.Key())
 	if !ok {
-		return nil, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		return nil, 0, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
 	}
 
 	keyVersion, err := decodeUint64Ascending(vBz)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode key version: %w", err)
+		return nil, 0, fmt.Errorf("failed to decode key version: %w", err)
 	}
 	if keyVersion > version {
-		return nil, fmt.Errorf("key version too large: %d", keyVersion)
+		return nil, 0, fmt.Errorf("key version too large: %d", keyVersion)
+	}
+
+	return slices.Clone(itr.Value()), keyVersion, nil
+}
+
+// getRangeTombstones returns the range tombstones stored in the database.
+func getRangeTombstones(storage *pebble.DB) (*util.RangeTombstones, error) {
+	itr, err := storage.NewIter(&pebble.IterOptions{
+		LowerBound: []byte(rangeTombstonePrefix),
+		UpperBound: util.CopyIncr([]byte(rangeTombstonePrefix)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	rangeTombstones := &util.RangeTombstones{}
+	for itr.First(); itr.Valid(); itr.Next() {
+		version, err := util.RangeTombstoneKeyVersion([]byte(rangeTombstonePrefix), itr.Key())
+		if err != nil {
+			return nil, err
+		}
+		t, err := util.UnmarshalRangeTombstone(itr.Value(), version)
+		if err != nil {
+			return nil, err
+		}
+		rangeTombstones.Add(t)
+	}
+
+	return rangeTombstones, itr.Error()
+}
+
+// rangeTombstoneRemoves returns true if one of the tombstones removes the version
+// of the key written at keyVersion for the reads at the given version, the key
+// being prefixed with its store prefix.
+func rangeTombstoneRemoves(tombstones []util.RangeTombstone, prefixedKey []byte, keyVersion, version uint64) bool {
+	for _, t := range tombstones {
+		prefix := storePrefix(t.StoreKey)
+		if bytes.HasPrefix(prefixedKey, prefix) && t.Removes(t.StoreKey, prefixedKey[len(prefix):], keyVersion, version) {
+			return true
+		}
 	}
 
-	return slices.Clone(itr.Value()), nil
+	return false
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/pebble"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 var _ corestore.Iterator = (*iterator)(nil)
@@ -24,9 +25,12 @@ type iterator struct {
 	version            uint64
 	valid              bool
 	reverse            bool
+
+	// rangeTombstones are the range tombstones of the store at the version.
+	rangeTombstones []util.RangeTombstone
 }
 
-func newPebbleDBIterator(src *pebble.Iterator, prefix, mvccStart, mvccEnd []byte, version, earliestVersion uint64, reverse bool) *iterator {
+func newPebbleDBIterator(src *pebble.Iterator, prefix, mvccStart, mvccEnd []byte, version, earliestVersion uint64, reverse bool, rangeTombstones []util.RangeTombstone) *iterator {
 	if version < earliestVersion {
 		return &iterator{
 			source:  src,
@@ -48,13 +52,14 @@ func newPebbleDBIterator(src *pebble.Iterator, prefix, mvccStart, mvccEnd []byte
 	}
 
 	itr := &iterator{
-		source:  src,
-		prefix:  prefix,
-		start:   mvccStart,
-		end:     mvccEnd,
-		version: version,
-		valid:   valid,
-		reverse: reverse,
+		source:          src,
+		prefix:          prefix,
+		start:           mvccStart,
+		end:             mvccEnd,
+		version:         version,
+		valid:           valid,
+		reverse:         reverse,
+		rangeTombstones: rangeTombstones,
 	}
 
 	if valid {
@@ -81,6 +86,12 @@ func newPebbleDBIterator(src *pebble.Iterator, prefix, mvccStart, mvccEnd []byte
 			// that is invalid since curKeyVersionDecoded <= requested iterator version,
 			// so there exists at least one version of currKey SeekLT may move to.
 			itr.valid = itr.source.SeekLT(MVCCEncode(currKey, itr.version+1))
+
+			// The cursor might now be pointing at a key/value pair that is tombstoned.
+			// If so, we must move the cursor.
+			if itr.valid && itr.cursorTombstoned() {
+				itr.Next()
+			}
 		}
 	}
 	return itr
@@ -170,11 +181,15 @@ func (itr *iterator) assertIsValid() {
 }
 
 // cursorTombstoned checks if the current cursor is pointing at a key/value pair
-// that is tombstoned. If the cursor is tombstoned, <true> is returned, otherwise
-// <false> is returned. In the case where the iterator is valid but the key/value
-// pair is tombstoned, the caller should call Next(). Note, this method assumes
-// the caller assures the iterator is valid first!
+// that is tombstoned, or removed by a range tombstone. If the cursor is tombstoned,
+// <true> is returned, otherwise <false> is returned. In the case where the iterator
+// is valid but the key/value pair is tombstoned, the caller should call Next().
+// Note, this method assumes the caller assures the iterator is valid first!
 func (itr *iterator) cursorTombstoned() bool {
+	if len(itr.rangeTombstones) > 0 && itr.cursorRangeRemoved() {
+		return true
+	}
+
 	_, tombBz, ok := SplitMVCCKey(itr.source.Value())
 	if !ok {
 		// XXX: This should not happen as that would indicate we have a malformed
@@ -201,6 +216,30 @@ func (itr *iterator) cursorTombstoned() bool {
 	return true
 }
 
+// cursorRangeRemoved checks if the key/value pair the cursor is pointing at is
+// removed by a range tombstone.
+func (itr *iterator) cursorRangeRemoved() bool {
+	key, vBz, ok := SplitMVCCKey(itr.source.Key())
+	if !ok {
+		// XXX: This should not happen as that would indicate we have a malformed
+		// MVCC key.
+		panic(fmt.Sprintf("invalid PebbleDB MVCC key: %s", itr.source.Key()))
+	}
+	keyVersion, err := decodeUint64Ascending(vBz)
+	if err != nil {
+		panic(fmt.Errorf("failed to decode key version: %w", err))
+	}
+
+	key = key[len(itr.prefix):]
+	for _, t := range itr.rangeTombstones {
+		if t.Removes(t.StoreKey, key, keyVersion, itr.version) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (itr *iterator) DebugRawIterate() {
 	valid := itr.source.Valid()
 	if valid {
@@ -5,33 +5,41 @@ package rocksdb
 
 import (
 	"encoding/binary"
+	"slices"
 
 	"github.com/linxGnu/grocksdb"
 
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 var _ store.Batch = (*Batch)(nil)
 
 type Batch struct {
+	db       *Database
 	version  uint64
 	ts       [TimestampSize]byte
 	storage  *grocksdb.DB
 	cfHandle *grocksdb.ColumnFamilyHandle
 	batch    *grocksdb.WriteBatch
+
+	// rangeTombstones are the range tombstones written by the batch, which are
+	// added to the ones of the database once the batch is written.
+	rangeTombstones []util.RangeTombstone
 }
 
 // NewBatch creates a new versioned batch used for batch writes. The caller
 // must ensure to call Write() on the returned batch to commit the changes and to
 // destroy the batch when done.
-func NewBatch(db *Database, version uint64) Batch {
+func NewBatch(db *Database, version uint64) *Batch {
 	var ts [TimestampSize]byte
 	binary.LittleEndian.PutUint64(ts[:], version)
 
 	batch := grocksdb.NewWriteBatch()
 	batch.Put([]byte(latestVersionKey), ts[:])
 
-	return Batch{
+	return &Batch{
+		db:       db,
 		version:  version,
 		ts:       ts,
 		storage:  db.storage,
@@ -40,28 +48,61 @@ func NewBatch(db *Database, version uint64) Batch {
 	}
 }
 
-func (b Batch) Size() int {
+func (b *Batch) Size() int {
 	return len(b.batch.Data())
 }
 
-func (b Batch) Reset() error {
+func (b *Batch) Reset() error {
 	b.batch.Clear()
+	b.rangeTombstones = nil
 	return nil
 }
 
-func (b Batch) Set(storeKey, key, value []byte) error {
+func (b *Batch) Set(storeKey, key, value []byte) error {
 	prefixedKey := prependStoreKey(storeKey, key)
 	b.batch.PutCFWithTS(b.cfHandle, prefixedKey, b.ts[:], value)
 	return nil
 }
 
-func (b Batch) Delete(storeKey, key []byte) error {
+func (b *Batch) Delete(storeKey, key []byte) error {
 	prefixedKey := prependStoreKey(storeKey, key)
 	b.batch.DeleteCFWithTS(b.cfHandle, prefixedKey, b.ts[:])
 	return nil
 }
 
-func (b Batch) Write() error {
+// DeleteRange writes a single range tombstone, in the default column family,
+// removing the keys of the range written before the batch version. It is
+// accounted for on reads until the version is pruned, the removals being then
+// written to the state storage column family.
+func (b *Batch) DeleteRange(storeKey, start, end []byte) error {
+	if len(start) == 0 {
+		start = nil
+	}
+	if len(end) == 0 {
+		end = nil
+	}
+
+	t := util.RangeTombstone{
+		StoreKey: slices.Clone(storeKey),
+		Start:    slices.Clone(start),
+		End:      slices.Clone(end),
+		Version:  b.version,
+	}
+	key := util.RangeTombstoneKey([]byte(rangeTombstonePrefix), b.version, uint32(len(b.rangeTombstones)))
+	b.batch.Put(key, t.Marshal())
+	b.rangeTombstones = append(b.rangeTombstones, t)
+
+	return nil
+}
+
+func (b *Batch) Write() error {
 	defer b.batch.Destroy()
-	return b.storage.Write(defaultWriteOpts, b.batch)
+	if err := b.storage.Write(defaultWriteOpts, b.batch); err != nil {
+		return err
+	}
+	if len(b.rangeTombstones) > 0 {
+		b.db.rangeTombstones.Add(b.rangeTombstones...)
+	}
+
+	return nil
 }
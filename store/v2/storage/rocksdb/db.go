@@ -23,10 +23,15 @@ const (
 
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
+
+	// rangeTombstonePrefix is the prefix of the range tombstones, stored in the
+	// default column family.
+	rangeTombstonePrefix = "s/_range/" // s/_range/<version><seq> -> range tombstone
 )
 
 var (
 	_ storage.Database = (*Database)(nil)
+	_ store.Rollbacker = (*Database)(nil)
 
 	defaultWriteOpts = grocksdb.NewDefaultWriteOptions()
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
@@ -35,10 +40,17 @@ var (
 type Database struct {
 	storage  *grocksdb.DB
 	cfHandle *grocksdb.ColumnFamilyHandle
+	// dataDir is the directory of the database, it is empty when the database
+	// was opened by the caller.
+	dataDir string
 
 	// tsLow reflects the full_history_ts_low CF value, which is earliest version
 	// supported
 	tsLow uint64
+
+	// rangeTombstones holds the range tombstones of the versions not pruned yet,
+	// which are accounted for on reads.
+	rangeTombstones *util.RangeTombstones
 }
 
 func New(dataDir string) (*Database, error) {
@@ -58,10 +70,17 @@ func New(dataDir string) (*Database, error) {
 		tsLow = binary.LittleEndian.Uint64(tsLowBz)
 	}
 
+	rangeTombstones, err := getRangeTombstones(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range tombstones: %w", err)
+	}
+
 	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
+		storage:         storage,
+		cfHandle:        cfHandle,
+		dataDir:         dataDir,
+		tsLow:           tsLow,
+		rangeTombstones: rangeTombstones,
 	}, nil
 }
 
@@ -77,10 +96,16 @@ func NewWithDB(storage *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) (*Da
 		tsLow = binary.LittleEndian.Uint64(tsLowBz)
 	}
 
+	rangeTombstones, err := getRangeTombstones(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range tombstones: %w", err)
+	}
+
 	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
+		storage:         storage,
+		cfHandle:        cfHandle,
+		tsLow:           tsLow,
+		rangeTombstones: rangeTombstones,
 	}, nil
 }
 
@@ -97,16 +122,30 @@ func (db *Database) NewBatch(version uint64) (store.Batch, error) {
 	return NewBatch(db, version), nil
 }
 
-func (db *Database) getSlice(storeKey []byte, version uint64, key []byte) (*grocksdb.Slice, error) {
+// get returns the value of the key at the given version, and whether the key
+// exists.
+func (db *Database) get(storeKey []byte, version uint64, key []byte) ([]byte, bool, error) {
 	if version < db.tsLow {
-		return nil, errors.ErrVersionPruned{EarliestVersion: db.tsLow, RequestedVersion: version}
+		return nil, false, errors.ErrVersionPruned{EarliestVersion: db.tsLow, RequestedVersion: version}
 	}
 
-	return db.storage.GetCF(
+	value, ts, err := db.storage.GetCFWithTS(
 		newTSReadOptions(version),
 		db.cfHandle,
 		prependStoreKey(storeKey, key),
 	)
+	if err != nil {
+		return nil, false, err
+	}
+	defer ts.Free()
+
+	// the key may have been removed by a range removal after it was written
+	if !value.Exists() || db.rangeTombstones.Removes(storeKey, key, binary.LittleEndian.Uint64(ts.Data()), version) {
+		value.Free()
+		return nil, false, nil
+	}
+
+	return copyAndFreeSlice(value), true, nil
 }
 
 func (db *Database) SetLatestVersion(version uint64) error {
@@ -131,27 +170,37 @@ func (db *Database) GetLatestVersion() (uint64, error) {
 }
 
 func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
-	slice, err := db.getSlice(storeKey, version, key)
+	_, ok, err := db.get(storeKey, version, key)
 	if err != nil {
 		return false, err
 	}
 
-	return slice.Exists(), nil
+	return ok, nil
 }
 
 func (db *Database) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
-	slice, err := db.getSlice(storeKey, version, key)
+	value, _, err := db.get(storeKey, version, key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get RocksDB slice: %w", err)
 	}
 
-	return copyAndFreeSlice(slice), nil
+	return value, nil
 }
 
 // Prune prunes all versions up to and including the provided version argument.
 // Internally, this performs a manual compaction, the data with older timestamp
 // will be GCed by compaction.
+//
+// The range tombstones of the pruned versions are first written as the removals
+// of the keys they remove, so they are not needed anymore.
 func (db *Database) Prune(version uint64) error {
+	if rangeTombstones := db.rangeTombstones.Until(version); len(rangeTombstones) > 0 {
+		if err := db.writeRangeRemovals(rangeTombstones, version); err != nil {
+			return fmt.Errorf("failed to write range removals: %w", err)
+		}
+		db.rangeTombstones.DeleteUntil(version)
+	}
+
 	tsLow := version + 1 // we increment by 1 to include the provided version
 
 	var ts [TimestampSize]byte
@@ -164,6 +213,80 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// Rollback removes all versions of all keys that are > the given version, and
+// sets the latest version to the given version.
+//
+// Note, RocksDB only trims the versions of a column family with user-defined
+// timestamps when the database is opened, so the database is closed and reopened.
+func (db *Database) Rollback(version uint64) error {
+	if db.dataDir == "" {
+		return fmt.Errorf("rollback is not supported by a RocksDB database opened by the caller")
+	}
+	if version < db.tsLow {
+		return fmt.Errorf("cannot rollback to version %d, versions < %d have been pruned", version, db.tsLow)
+	}
+
+	db.storage.Close()
+	storage, cfHandle, err := OpenRocksDBAndTrimHistory(db.dataDir, int64(version))
+	if err != nil {
+		return fmt.Errorf("failed to trim RocksDB history: %w", err)
+	}
+	db.storage = storage
+	db.cfHandle = cfHandle
+
+	// the default column family is not trimmed, so the range tombstones of the
+	// discarded versions are deleted
+	batch := grocksdb.NewWriteBatch()
+	defer batch.Destroy()
+	batch.DeleteRange(
+		util.RangeTombstoneKey([]byte(rangeTombstonePrefix), version+1, 0),
+		util.CopyIncr([]byte(rangeTombstonePrefix)),
+	)
+	if err := db.storage.Write(defaultWriteOpts, batch); err != nil {
+		return fmt.Errorf("failed to delete range tombstones: %w", err)
+	}
+	db.rangeTombstones.DeleteAfter(version)
+
+	return db.SetLatestVersion(version)
+}
+
+// writeRangeRemovals writes a removal, at the version of the tombstone, of every
+// key a tombstone removes, and deletes the tombstones <= the given version.
+func (db *Database) writeRangeRemovals(tombstones []util.RangeTombstone, version uint64) error {
+	batch := grocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	for _, t := range tombstones {
+		var ts [TimestampSize]byte
+		binary.LittleEndian.PutUint64(ts[:], t.Version)
+
+		start, end := util.IterateWithPrefix(storePrefix(t.StoreKey), t.Start, t.End)
+		itr := db.storage.NewIteratorCF(newTSReadOptions(t.Version), db.cfHandle)
+		for itr.Seek(start); itr.Valid(); itr.Next() {
+			key := copyAndFreeSlice(itr.Key())
+			if bytes.Compare(key, end) >= 0 {
+				break
+			}
+			// the keys written at the version of the tombstone are kept
+			if binary.LittleEndian.Uint64(readOnlySlice(itr.Timestamp())) < t.Version {
+				batch.DeleteCFWithTS(db.cfHandle, key, ts[:])
+			}
+		}
+		err := itr.Err()
+		itr.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	batch.DeleteRange(
+		[]byte(rangeTombstonePrefix),
+		util.RangeTombstoneKey([]byte(rangeTombstonePrefix), version+1, 0),
+	)
+
+	return db.storage.Write(defaultWriteOpts, batch)
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, errors.ErrKeyEmpty
@@ -177,7 +300,7 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
 	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
-	return newRocksDBIterator(itr, prefix, start, end, false), nil
+	return newRocksDBIterator(itr, prefix, start, end, false, version, db.rangeTombstones.Store(storeKey, version)), nil
 }
 
 func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -193,7 +316,33 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
 	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
-	return newRocksDBIterator(itr, prefix, start, end, true), nil
+	return newRocksDBIterator(itr, prefix, start, end, true, version, db.rangeTombstones.Store(storeKey, version)), nil
+}
+
+// getRangeTombstones returns the range tombstones stored in the default column
+// family of the database.
+func getRangeTombstones(storage *grocksdb.DB) (*util.RangeTombstones, error) {
+	itr := storage.NewIterator(defaultReadOpts)
+	defer itr.Close()
+
+	rangeTombstones := &util.RangeTombstones{}
+	for itr.Seek([]byte(rangeTombstonePrefix)); itr.Valid(); itr.Next() {
+		key := copyAndFreeSlice(itr.Key())
+		if !bytes.HasPrefix(key, []byte(rangeTombstonePrefix)) {
+			break
+		}
+		version, err := util.RangeTombstoneKeyVersion([]byte(rangeTombstonePrefix), key)
+		if err != nil {
+			return nil, err
+		}
+		t, err := util.UnmarshalRangeTombstone(copyAndFreeSlice(itr.Value()), version)
+		if err != nil {
+			return nil, err
+		}
+		rangeTombstones.Add(t)
+	}
+
+	return rangeTombstones, itr.Err()
 }
 
 // newTSReadOptions returns ReadOptions used in the RocksDB column family read.
@@ -5,10 +5,12 @@ package rocksdb
 
 import (
 	"bytes"
+	"encoding/binary"
 
 	"github.com/linxGnu/grocksdb"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 var _ corestore.Iterator = (*iterator)(nil)
@@ -18,9 +20,20 @@ type iterator struct {
 	prefix, start, end []byte
 	reverse            bool
 	invalid            bool
+
+	// version is the version the iterator reads at, and rangeTombstones are the
+	// range tombstones of the store <= the version, whose removed keys are skipped.
+	version         uint64
+	rangeTombstones []util.RangeTombstone
 }
 
-func newRocksDBIterator(source *grocksdb.Iterator, prefix, start, end []byte, reverse bool) *iterator {
+func newRocksDBIterator(
+	source *grocksdb.Iterator,
+	prefix, start, end []byte,
+	reverse bool,
+	version uint64,
+	rangeTombstones []util.RangeTombstone,
+) *iterator {
 	if reverse {
 		if end == nil {
 			source.SeekToLast()
@@ -44,14 +57,19 @@ func newRocksDBIterator(source *grocksdb.Iterator, prefix, start, end []byte, re
 		}
 	}
 
-	return &iterator{
-		source:  source,
-		prefix:  prefix,
-		start:   start,
-		end:     end,
-		reverse: reverse,
-		invalid: !source.Valid(),
+	itr := &iterator{
+		source:          source,
+		prefix:          prefix,
+		start:           start,
+		end:             end,
+		reverse:         reverse,
+		invalid:         !source.Valid(),
+		version:         version,
+		rangeTombstones: rangeTombstones,
 	}
+	itr.skipRangeRemoved()
+
+	return itr
 }
 
 // Domain returns the domain of the iterator. The caller must not modify the
@@ -124,11 +142,16 @@ func (itr *iterator) Value() []byte {
 	return copyAndFreeSlice(itr.source.Value())
 }
 
-func (itr iterator) Next() {
+func (itr *iterator) Next() {
 	if itr.invalid {
 		return
 	}
 
+	itr.step()
+	itr.skipRangeRemoved()
+}
+
+func (itr *iterator) step() {
 	if itr.reverse {
 		itr.source.Prev()
 	} else {
@@ -136,6 +159,31 @@ func (itr iterator) Next() {
 	}
 }
 
+// skipRangeRemoved moves the iterator past the keys removed by a range tombstone.
+func (itr *iterator) skipRangeRemoved() {
+	if len(itr.rangeTombstones) == 0 {
+		return
+	}
+
+	for itr.Valid() && itr.cursorRangeRemoved() {
+		itr.step()
+	}
+}
+
+// cursorRangeRemoved returns true if the version of the key the cursor is pointing
+// at is removed by a range tombstone.
+func (itr *iterator) cursorRangeRemoved() bool {
+	key := readOnlySlice(itr.source.Key())[len(itr.prefix):]
+	keyVersion := binary.LittleEndian.Uint64(readOnlySlice(itr.source.Timestamp()))
+	for _, t := range itr.rangeTombstones {
+		if t.Removes(t.StoreKey, key, keyVersion, itr.version) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (itr *iterator) Error() error {
 	return itr.source.Err()
 }
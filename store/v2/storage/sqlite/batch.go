@@ -12,14 +12,16 @@ var _ store.Batch = (*Batch)(nil)
 type batchAction int
 
 const (
-	batchActionSet batchAction = 0
-	batchActionDel batchAction = 1
+	batchActionSet      batchAction = 0
+	batchActionDel      batchAction = 1
+	batchActionDelRange batchAction = 2
 )
 
 type batchOp struct {
 	action     batchAction
 	storeKey   []byte
 	key, value []byte
+	end        []byte
 }
 
 type Batch struct {
@@ -74,6 +76,12 @@ func (b *Batch) Delete(storeKey, key []byte) error {
 	return nil
 }
 
+func (b *Batch) DeleteRange(storeKey, start, end []byte) error {
+	b.size += len(start) + len(end)
+	b.ops = append(b.ops, batchOp{action: batchActionDelRange, storeKey: storeKey, key: start, end: end})
+	return nil
+}
+
 func (b *Batch) Write() error {
 	_, err := b.tx.Exec(reservedUpsertStmt, reservedStoreKey, keyLatestHeight, b.version, 0, b.version)
 	if err != nil {
@@ -93,6 +101,20 @@ func (b *Batch) Write() error {
 			if err != nil {
 				return fmt.Errorf("failed to exec SQL statement: %w", err)
 			}
+
+		case batchActionDelRange:
+			start := op.key
+			if start == nil {
+				start = []byte{}
+			}
+			var end any
+			if len(op.end) > 0 {
+				end = op.end
+			}
+			_, err := b.tx.Exec(delRangeStmt, b.version, op.storeKey, start, end, end, b.version)
+			if err != nil {
+				return fmt.Errorf("failed to exec SQL statement: %w", err)
+			}
 		}
 	}
 
@@ -2,11 +2,13 @@ package sqlite
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	_ "github.com/mattn/go-sqlite3"
 
@@ -41,13 +43,29 @@ const (
 		SELECT id FROM state_storage WHERE store_key = ? AND key = ? AND version <= ? ORDER BY version DESC LIMIT 1
 	) AND tombstone = 0;
 	`
+	delRangeStmt = `
+	UPDATE state_storage SET tombstone = ?
+	WHERE id IN (
+		SELECT id FROM state_storage AS s
+		WHERE s.store_key = ? AND s.key >= ? AND (? IS NULL OR s.key < ?) AND s.version = (
+			SELECT MAX(version) FROM state_storage WHERE store_key = s.store_key AND key = s.key AND version <= ?
+		)
+	) AND tombstone = 0;
+	`
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database = (*Database)(nil)
+	_ store.Compactor  = (*Database)(nil)
+)
 
 type Database struct {
 	storage *sql.DB
 
+	// mtx guards the operations rewriting the history of the keys, i.e. Prune,
+	// Compact and Rollback, which must not run concurrently.
+	mtx sync.Mutex
+
 	// earliestVersion defines the earliest version set in the database, which is
 	// only updated when the database is pruned.
 	earliestVersion uint64
@@ -182,6 +200,9 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 // We perform the prune by deleting all versions of a key, excluding reserved keys,
 // that are <= the given version, except for the latest version of the key.
 func (db *Database) Prune(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
 	tx, err := db.storage.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to create SQL transaction: %w", err)
@@ -216,6 +237,135 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// Compact removes every version <= the given version of a key which holds the same
+// value as the previous version of the key, the previous version not being deleted.
+// The previous version inherits the tombstone of the removed version, if any.
+func (db *Database) Compact(ctx context.Context, version uint64, progress func(store.CompactionStats)) (stats store.CompactionStats, err error) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	rows, err := db.storage.QueryContext(ctx, `
+	SELECT id, store_key, key, value, tombstone FROM state_storage
+	WHERE version <= ? AND store_key != ?
+	ORDER BY store_key, key, version ASC;
+	`, version, reservedStoreKey)
+	if err != nil {
+		return stats, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	type row struct {
+		id         int64
+		storeKey   []byte
+		key, value []byte
+		tombstone  uint64
+	}
+
+	var (
+		prev    row
+		removed []int64
+		// tombstones are the tombstones inherited by the kept versions
+		tombstones = make(map[int64]uint64)
+	)
+	for rows.Next() {
+		var r row
+		if err := rows.Scan(&r.id, &r.storeKey, &r.key, &r.value, &r.tombstone); err != nil {
+			_ = rows.Close()
+			return stats, fmt.Errorf("failed to scan row: %w", err)
+		}
+		stats.EntriesScanned++
+
+		if prev.tombstone == 0 && bytes.Equal(prev.storeKey, r.storeKey) && bytes.Equal(prev.key, r.key) && bytes.Equal(prev.value, r.value) {
+			removed = append(removed, r.id)
+			if r.tombstone != 0 {
+				tombstones[prev.id] = r.tombstone
+				prev.tombstone = r.tombstone
+			}
+			stats.EntriesRemoved++
+			stats.BytesRemoved += uint64(len(r.storeKey) + len(r.key) + len(r.value))
+			continue
+		}
+		prev = r
+	}
+	// a canceled context interrupts the query, the removals found so far are applied
+	if err := errors.Join(rows.Err(), rows.Close()); err != nil && !errors.Is(err, ctx.Err()) {
+		return stats, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	tx, err := db.storage.Begin()
+	if err != nil {
+		return stats, fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, id := range removed {
+		if _, err := tx.Exec("DELETE FROM state_storage WHERE id = ?;", id); err != nil {
+			return stats, fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+	}
+	for id, tombstone := range tombstones {
+		if _, err := tx.Exec("UPDATE state_storage SET tombstone = ? WHERE id = ?;", tombstone, id); err != nil {
+			return stats, fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return stats, fmt.Errorf("failed to write SQL transaction: %w", err)
+	}
+	if progress != nil {
+		progress(stats)
+	}
+
+	return stats, ctx.Err()
+}
+
+// Rollback removes all versions of all keys that are > the given version, and
+// sets the latest version to the given version. Deletions which occurred after
+// the given version are reverted.
+func (db *Database) Rollback(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	pruneHeight, err := getPruneHeight(db.storage)
+	if err != nil {
+		return err
+	}
+	if pruneHeight > 0 && version <= pruneHeight {
+		return fmt.Errorf("cannot rollback to version %d, versions <= %d have been pruned", version, pruneHeight)
+	}
+
+	tx, err := db.storage.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback())
+		}
+	}()
+
+	if _, err = tx.Exec(`DELETE FROM state_storage WHERE version > ? AND store_key != ?;`, version, reservedStoreKey); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	if _, err = tx.Exec(`UPDATE state_storage SET tombstone = 0 WHERE tombstone > ? AND store_key != ?;`, version, reservedStoreKey); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	if _, err = tx.Exec(reservedUpsertStmt, reservedStoreKey, keyLatestHeight, version, 0, version); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to write SQL transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"testing"
@@ -183,6 +184,216 @@ func (s *StorageTestSuite) TestDatabase_ApplyChangeset() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_ApplyChangeset_RemoveRange() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	cs := corestore.NewChangeset()
+	for i := 0; i < 20; i++ {
+		cs.Add(storeKey1Bytes, []byte(fmt.Sprintf("key%03d", i)), []byte("value"), false)
+	}
+	cs.Add([]byte("store2"), []byte("key000"), []byte("value"), false)
+	s.Require().NoError(db.ApplyChangeset(1, cs))
+
+	// the keys written before a range removal are removed, the ones written after
+	// it are kept
+	cs = corestore.NewChangeset()
+	cs.AddRangeRemove(storeKey1Bytes, []byte("key005"), []byte("key010"))
+	cs.Add(storeKey1Bytes, []byte("key007"), []byte("value"), false)
+	cs.Add(storeKey1Bytes, []byte("key015"), []byte("value"), false)
+	cs.AddPrefixRemove(storeKey1Bytes, []byte("key01"))
+	s.Require().NoError(db.ApplyChangeset(2, cs))
+
+	expected := map[uint64][]string{
+		1: {"key000", "key001", "key002", "key003", "key004", "key005", "key006", "key007", "key008", "key009"},
+		2: {"key000", "key001", "key002", "key003", "key004", "key007"},
+	}
+	for i := 10; i < 20; i++ {
+		expected[1] = append(expected[1], fmt.Sprintf("key%03d", i))
+	}
+
+	// a range without bounds removes the whole store
+	cs = corestore.NewChangeset()
+	cs.AddRangeRemove(storeKey1Bytes, nil, nil)
+	s.Require().NoError(db.ApplyChangeset(3, cs))
+	expected[3] = nil
+
+	for version, keys := range expected {
+		iter, err := db.Iterator(storeKey1Bytes, version, nil, nil)
+		s.Require().NoError(err)
+
+		var actual []string
+		for ; iter.Valid(); iter.Next() {
+			actual = append(actual, string(iter.Key()))
+		}
+		s.Require().NoError(iter.Close())
+		s.Require().Equal(keys, actual, "version %d", version)
+
+		for _, key := range keys {
+			ok, err := db.Has(storeKey1Bytes, version, []byte(key))
+			s.Require().NoError(err)
+			s.Require().True(ok, "version %d key %s", version, key)
+		}
+	}
+
+	ok, err := db.Has(storeKey1Bytes, 2, []byte("key015"))
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	// the other stores are left untouched
+	ok, err = db.Has([]byte("store2"), 3, []byte("key000"))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+}
+
+func (s *StorageTestSuite) TestDatabase_RemoveRange_PruneRollback() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	dir := s.T().TempDir()
+	db, err := s.NewDB(dir)
+	s.Require().NoError(err)
+
+	cs := corestore.NewChangeset()
+	for i := 0; i < 10; i++ {
+		cs.Add(storeKey1Bytes, []byte(fmt.Sprintf("key%03d", i)), []byte("v1"), false)
+	}
+	s.Require().NoError(db.ApplyChangeset(1, cs))
+	cs = corestore.NewChangeset()
+	cs.Add(storeKey1Bytes, []byte("key005"), []byte("v2"), false)
+	s.Require().NoError(db.ApplyChangeset(2, cs))
+	cs = corestore.NewChangeset()
+	cs.AddRangeRemove(storeKey1Bytes, []byte("key003"), []byte("key007"))
+	cs.Add(storeKey1Bytes, []byte("key004"), []byte("v3"), false)
+	s.Require().NoError(db.ApplyChangeset(3, cs))
+
+	state := func(version uint64) map[string]string {
+		st := make(map[string]string)
+		iter, err := db.Iterator(storeKey1Bytes, version, nil, nil)
+		s.Require().NoError(err)
+		for ; iter.Valid(); iter.Next() {
+			st[string(iter.Key())] = string(iter.Value())
+		}
+		s.Require().NoError(iter.Close())
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key%03d", i)
+			val, err := db.Get(storeKey1Bytes, version, []byte(key))
+			s.Require().NoError(err)
+			s.Require().Equal(st[key], string(val), "version %d key %s", version, key)
+		}
+		return st
+	}
+	expected := map[string]string{
+		"key000": "v1", "key001": "v1", "key002": "v1", "key004": "v3",
+		"key007": "v1", "key008": "v1", "key009": "v1",
+	}
+	s.Require().Equal(expected, state(3))
+	s.Require().Equal("v2", state(2)["key005"])
+
+	// the range removals are persisted
+	s.Require().NoError(db.Close())
+	db, err = s.NewDB(dir)
+	s.Require().NoError(err)
+	defer db.Close()
+	s.Require().Equal(expected, state(3))
+	s.Require().Equal("v2", state(2)["key005"])
+
+	// the compaction and the pruning keep the removals
+	if _, ok := db.db.(store.Compactor); ok {
+		_, err = db.Compact(context.Background(), 3, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state(3))
+		s.Require().Equal("v2", state(2)["key005"])
+	}
+	s.Require().NoError(db.Prune(2))
+	s.Require().Equal(expected, state(3))
+
+	// a rolled back range removal is discarded
+	cs = corestore.NewChangeset()
+	cs.AddRangeRemove(storeKey1Bytes, nil, nil)
+	s.Require().NoError(db.ApplyChangeset(4, cs))
+	s.Require().Empty(state(4))
+	s.Require().NoError(db.Rollback(3))
+	s.Require().Equal(expected, state(4))
+
+	s.Require().NoError(db.Prune(3))
+	s.Require().Equal(expected, state(4))
+}
+
+func (s *StorageTestSuite) TestDatabase_Compact() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	if _, ok := db.db.(store.Compactor); !ok {
+		s.T().Skip("compaction is not supported by the database")
+	}
+
+	// key "a" is unchanged from 1 to 3, deleted at 5 and unchanged from 6 to 7, key
+	// "b" is unchanged from 1 to 5
+	values := map[string][]string{
+		"a": {"v1", "v1", "v1", "v2", "", "v2", "v2"},
+		"b": {"x", "x", "x", "x", "x"},
+	}
+	for v := uint64(1); v <= 7; v++ {
+		cs := corestore.NewChangeset()
+		for key, vals := range values {
+			if v > uint64(len(vals)) {
+				continue
+			}
+			if val := vals[v-1]; val == "" {
+				cs.Add(storeKey1Bytes, []byte(key), nil, true)
+			} else {
+				cs.Add(storeKey1Bytes, []byte(key), []byte(val), false)
+			}
+		}
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	state := func() map[uint64]map[string]string {
+		st := make(map[uint64]map[string]string)
+		for v := uint64(1); v <= 8; v++ {
+			st[v] = make(map[string]string)
+			iter, err := db.Iterator(storeKey1Bytes, v, nil, nil)
+			s.Require().NoError(err)
+			for ; iter.Valid(); iter.Next() {
+				st[v][string(iter.Key())] = string(iter.Value())
+			}
+			s.Require().NoError(iter.Close())
+			for key := range values {
+				val, err := db.Get(storeKey1Bytes, v, []byte(key))
+				s.Require().NoError(err)
+				s.Require().Equal(st[v][key], string(val), "version %d key %s", v, key)
+			}
+		}
+		return st
+	}
+	expected := state()
+
+	var progress store.CompactionStats
+	stats, err := db.Compact(context.Background(), 5, func(stats store.CompactionStats) { progress = stats })
+	s.Require().NoError(err)
+	s.Require().Equal(stats, progress)
+	s.Require().Equal(uint64(6), stats.EntriesRemoved) // a@2, a@3 and b@2 to b@5
+	s.Require().Equal(expected, state())
+
+	stats, err = db.Compact(context.Background(), 7, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), stats.EntriesRemoved) // a@7
+	s.Require().Equal(expected, state())
+
+	// the compacted versions can be pruned
+	s.Require().NoError(db.Prune(6))
+	val, err := db.Get(storeKey1Bytes, 7, []byte("a"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("v2"), val)
+	val, err = db.Get(storeKey1Bytes, 7, []byte("b"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("x"), val)
+}
+
 func (s *StorageTestSuite) TestDatabase_IteratorEmptyDomain() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -640,6 +851,75 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	s.Require().Equal([]byte("val200"), bz)
 }
 
+func (s *StorageTestSuite) TestDatabase_Rollback() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	// key000 is set at every version, key001 is deleted at version 8 and key002
+	// is only set from version 6.
+	for v := uint64(1); v <= 10; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{storeKey1: {
+			{Key: []byte("key000"), Value: []byte(fmt.Sprintf("val000-%03d", v))},
+		}})
+		switch {
+		case v < 8:
+			cs.AddKVPair(storeKey1Bytes, corestore.KVPair{Key: []byte("key001"), Value: []byte(fmt.Sprintf("val001-%03d", v))})
+		case v == 8:
+			cs.AddKVPair(storeKey1Bytes, corestore.KVPair{Key: []byte("key001"), Remove: true})
+		}
+		if v >= 6 {
+			cs.AddKVPair(storeKey1Bytes, corestore.KVPair{Key: []byte("key002"), Value: []byte(fmt.Sprintf("val002-%03d", v))})
+		}
+
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	s.Require().NoError(db.Rollback(5))
+
+	latestVersion, err := db.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), latestVersion)
+
+	// the values written after version 5 must not be visible anymore at any version
+	for _, v := range []uint64{5, 8, 10} {
+		bz, err := db.Get(storeKey1Bytes, v, []byte("key000"))
+		s.Require().NoError(err)
+		s.Require().Equal([]byte("val000-005"), bz)
+
+		bz, err = db.Get(storeKey1Bytes, v, []byte("key001"))
+		s.Require().NoError(err)
+		s.Require().Equal([]byte("val001-005"), bz)
+
+		has, err := db.Has(storeKey1Bytes, v, []byte("key002"))
+		s.Require().NoError(err)
+		s.Require().False(has)
+	}
+
+	itr, err := db.Iterator(storeKey1Bytes, 10, nil, nil)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	s.Require().Equal([]string{"key000", "key001"}, keys)
+
+	// new versions can be written on top of the rolled back version
+	s.Require().NoError(db.ApplyChangeset(6, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{storeKey1: {
+		{Key: []byte("key000"), Value: []byte("new")},
+	}})))
+
+	bz, err := db.Get(storeKey1Bytes, 6, []byte("key000"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("new"), bz)
+}
+
 func DBApplyChangeset(
 	t *testing.T,
 	db store.VersionedDatabase,
@@ -664,3 +944,45 @@ func DBApplyChangeset(
 
 	require.NoError(t, db.ApplyChangeset(version, cs))
 }
+
+func (s *StorageTestSuite) TestDatabase_IterateAllKVPairs() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	s.Require().NoError(db.ApplyChangeset(1, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		storeKey1: {
+			{Key: []byte("key001"), Value: []byte("val001")},
+			{Key: []byte("key000"), Value: []byte("val000")},
+		},
+		"store2": {{Key: []byte("key000"), Value: []byte("other")}},
+	})))
+	s.Require().NoError(db.ApplyChangeset(2, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		storeKey1: {{Key: []byte("key000"), Remove: true}},
+	})))
+
+	iterate := func(version uint64) []string {
+		var kvs []string
+		err := db.IterateAllKVPairs(version, storeKey1, func(key, value []byte) error {
+			kvs = append(kvs, string(key)+"="+string(value))
+			return nil
+		})
+		s.Require().NoError(err)
+		return kvs
+	}
+	s.Require().Equal([]string{"key000=val000", "key001=val001"}, iterate(1))
+	s.Require().Equal([]string{"key001=val001"}, iterate(2))
+
+	// the errors of the callback stop the iteration
+	calls := 0
+	err = db.IterateAllKVPairs(1, storeKey1, func(_, _ []byte) error {
+		calls++
+		return fmt.Errorf("stop")
+	})
+	s.Require().EqualError(err, "stop")
+	s.Require().Equal(1, calls)
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/core/log"
@@ -18,6 +19,8 @@ var (
 	_ store.VersionedDatabase      = (*StorageStore)(nil)
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
 	_ store.Pruner                 = (*StorageStore)(nil)
+	_ store.Rollbacker             = (*StorageStore)(nil)
+	_ store.Compactor              = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
@@ -52,7 +55,19 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 	}
 
 	for _, pairs := range cs.Changes {
-		for _, kvPair := range pairs.StateChanges {
+		ranges := rangeRemovals(pairs.StateChanges)
+		for i, kvPair := range pairs.StateChanges {
+			if kvPair.RemoveRange {
+				if err := b.DeleteRange(pairs.Actor, kvPair.Key, kvPair.End); err != nil {
+					return err
+				}
+				continue
+			}
+			// the range removals only apply to the keys written by previous batches,
+			// so the writes shadowed by a later range removal are skipped
+			if removedByRange(pairs.StateChanges, ranges, i, kvPair.Key) {
+				continue
+			}
 			if kvPair.Remove {
 				if err := b.Delete(pairs.Actor, kvPair.Key); err != nil {
 					return err
@@ -72,6 +87,30 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 	return nil
 }
 
+// rangeRemovals returns the indexes of the range removals of the given pairs.
+func rangeRemovals(pairs corestore.KVPairs) []int {
+	var ranges []int
+	for i, kvPair := range pairs {
+		if kvPair.RemoveRange {
+			ranges = append(ranges, i)
+		}
+	}
+
+	return ranges
+}
+
+// removedByRange returns true if the key written by the pair at index i is removed
+// by a later range removal, given the indexes of the range removals.
+func removedByRange(pairs corestore.KVPairs, ranges []int, i int, key []byte) bool {
+	for j := len(ranges) - 1; j >= 0 && ranges[j] > i; j-- {
+		if pairs[ranges[j]].Contains(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetLatestVersion returns the latest version of the store.
 func (ss *StorageStore) GetLatestVersion() (uint64, error) {
 	return ss.db.GetLatestVersion()
@@ -92,18 +131,74 @@ func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
+// IterateAllKVPairs calls fn with the key-value pairs of the given store key at the
+// given version, in key order, until fn returns an error. The store keys being the
+// module names, it lets the StorageStore be used as the appdata.SyncSource of the
+// indexers catching up with the state of a running node.
+func (ss *StorageStore) IterateAllKVPairs(version uint64, storeKey string, fn func(key, value []byte) error) (err error) {
+	itr, err := ss.db.Iterator([]byte(storeKey), version, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := itr.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := fn(itr.Key(), itr.Value()); err != nil {
+			return err
+		}
+	}
+	return itr.Error()
+}
+
 // Prune prunes the store up to the given version.
 func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
 }
 
+// Rollback discards all the versions of the store greater than the given version.
+// It returns an error if the underlying database does not support rollbacks.
+func (ss *StorageStore) Rollback(version uint64) error {
+	db, ok := ss.db.(store.Rollbacker)
+	if !ok {
+		return fmt.Errorf("rollback is not supported by the storage database %T", ss.db)
+	}
+
+	return db.Rollback(version)
+}
+
+// Compact compacts the versions of the store up to the given version. It returns
+// an error if the underlying database does not support compaction.
+func (ss *StorageStore) Compact(ctx context.Context, version uint64, progress func(store.CompactionStats)) (store.CompactionStats, error) {
+	db, ok := ss.db.(store.Compactor)
+	if !ok {
+		return store.CompactionStats{}, fmt.Errorf("compaction is not supported by the storage database %T", ss.db)
+	}
+
+	return db.Compact(ctx, version, progress)
+}
+
 // Restore restores the store from the given channel.
 func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	return ss.restore(version, chStorage, false)
+}
+
+// ResumeRestore restores the store from the given channel as Restore does, but
+// also accepts the latest version of the store, i.e. the version of an interrupted
+// restore. The restored keys overwrite the ones already written at the version.
+func (ss *StorageStore) ResumeRestore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	return ss.restore(version, chStorage, true)
+}
+
+func (ss *StorageStore) restore(version uint64, chStorage <-chan *corestore.StateChanges, resume bool) error {
 	latestVersion, err := ss.db.GetLatestVersion()
 	if err != nil {
 		return fmt.Errorf("failed to get latest version: %w", err)
 	}
-	if version <= latestVersion {
+	if version < latestVersion || (version == latestVersion && !resume) {
 		return fmt.Errorf("the snapshot version %d is not greater than latest version %d", version, latestVersion)
 	}
 
@@ -0,0 +1,188 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+)
+
+// RangeTombstone is the removal, at a version, of the keys of a store in the
+// range [Start, End). It removes the versions of the keys written before its
+// version, so the keys written at its version or after it are kept. A nil Start
+// or End means the range is unbounded on that side.
+//
+// The SS backends which can't remove a range of keys of a version natively write
+// a single RangeTombstone instead of a tombstone per key, and account for it
+// on reads.
+type RangeTombstone struct {
+	StoreKey   []byte
+	Start, End []byte
+	Version    uint64
+}
+
+// Contains returns true if the range of the tombstone contains the key.
+func (t RangeTombstone) Contains(key []byte) bool {
+	return bytes.Compare(key, t.Start) >= 0 && (t.End == nil || bytes.Compare(key, t.End) < 0)
+}
+
+// Removes returns true if the tombstone removes the version of the key of the
+// store written at keyVersion, for the reads at the given version.
+func (t RangeTombstone) Removes(storeKey, key []byte, keyVersion, version uint64) bool {
+	return keyVersion < t.Version && t.Version <= version && bytes.Equal(storeKey, t.StoreKey) && t.Contains(key)
+}
+
+// Marshal encodes the store key and the range of the tombstone, the version is
+// expected to be encoded in the key the tombstone is stored at.
+func (t RangeTombstone) Marshal() []byte {
+	bz := binary.AppendUvarint(nil, uint64(len(t.StoreKey)))
+	bz = append(bz, t.StoreKey...)
+	bz = binary.AppendUvarint(bz, uint64(len(t.Start)))
+	bz = append(bz, t.Start...)
+	if t.End == nil {
+		return append(bz, 0)
+	}
+	bz = append(bz, 1)
+	return append(bz, t.End...)
+}
+
+// UnmarshalRangeTombstone decodes a tombstone encoded by Marshal.
+func UnmarshalRangeTombstone(bz []byte, version uint64) (RangeTombstone, error) {
+	t := RangeTombstone{Version: version}
+	var err error
+	if t.StoreKey, bz, err = readBytes(bz); err != nil {
+		return t, err
+	}
+	if t.Start, bz, err = readBytes(bz); err != nil {
+		return t, err
+	}
+	if len(t.Start) == 0 {
+		t.Start = nil
+	}
+	if len(bz) == 0 {
+		return t, errors.New("invalid range tombstone: missing end")
+	}
+	if bz[0] == 1 {
+		t.End = bytes.Clone(bz[1:])
+	}
+
+	return t, nil
+}
+
+// RangeTombstoneKey returns the key the tombstone of the given version is stored
+// at, seq being its position in the batch of the version. The keys are sorted
+// by version.
+func RangeTombstoneKey(prefix []byte, version uint64, seq uint32) []byte {
+	key := binary.BigEndian.AppendUint64(bytes.Clone(prefix), version)
+	return binary.BigEndian.AppendUint32(key, seq)
+}
+
+// RangeTombstoneKeyVersion returns the version of a key built by RangeTombstoneKey.
+func RangeTombstoneKeyVersion(prefix, key []byte) (uint64, error) {
+	if len(key) != len(prefix)+12 || !bytes.HasPrefix(key, prefix) {
+		return 0, errors.New("invalid range tombstone key")
+	}
+
+	return binary.BigEndian.Uint64(key[len(prefix):]), nil
+}
+
+func readBytes(bz []byte) ([]byte, []byte, error) {
+	n, size := binary.Uvarint(bz)
+	if size <= 0 || uint64(len(bz)-size) < n {
+		return nil, nil, errors.New("invalid range tombstone")
+	}
+	bz = bz[size:]
+
+	return bytes.Clone(bz[:n]), bz[n:], nil
+}
+
+// RangeTombstones holds the range tombstones of a database in memory, sorted by
+// version. It is safe for concurrent use.
+type RangeTombstones struct {
+	mtx        sync.RWMutex
+	tombstones []RangeTombstone
+}
+
+// Add adds tombstones whose versions are not lower than the ones already held.
+func (r *RangeTombstones) Add(tombstones ...RangeTombstone) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.tombstones = append(r.tombstones, tombstones...)
+}
+
+// Store returns the tombstones of the store whose versions are <= the given version.
+func (r *RangeTombstones) Store(storeKey []byte, version uint64) []RangeTombstone {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var tombstones []RangeTombstone
+	for _, t := range r.tombstones {
+		if t.Version > version {
+			break
+		}
+		if bytes.Equal(t.StoreKey, storeKey) {
+			tombstones = append(tombstones, t)
+		}
+	}
+
+	return tombstones
+}
+
+// Until returns the tombstones whose versions are <= the given version.
+func (r *RangeTombstones) Until(version uint64) []RangeTombstone {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var tombstones []RangeTombstone
+	for _, t := range r.tombstones {
+		if t.Version > version {
+			break
+		}
+		tombstones = append(tombstones, t)
+	}
+
+	return tombstones
+}
+
+// Removes returns true if a tombstone removes the version of the key of the store
+// written at keyVersion, for the reads at the given version.
+func (r *RangeTombstones) Removes(storeKey, key []byte, keyVersion, version uint64) bool {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	for _, t := range r.tombstones {
+		if t.Version > version {
+			break
+		}
+		if t.Removes(storeKey, key, keyVersion, version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeleteUntil deletes the tombstones whose versions are <= the given version.
+func (r *RangeTombstones) DeleteUntil(version uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	i := 0
+	for i < len(r.tombstones) && r.tombstones[i].Version <= version {
+		i++
+	}
+	r.tombstones = r.tombstones[i:]
+}
+
+// DeleteAfter deletes the tombstones whose versions are > the given version.
+func (r *RangeTombstones) DeleteAfter(version uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	i := len(r.tombstones)
+	for i > 0 && r.tombstones[i-1].Version > version {
+		i--
+	}
+	r.tombstones = r.tombstones[:i]
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"context"
 	"io"
 
 	coreheader "cosmossdk.io/core/header"
@@ -100,6 +101,47 @@ type PausablePruner interface {
 	PausePruning(pause bool)
 }
 
+// Rollbacker defines the interface for rolling back the store or database to a
+// previous version.
+type Rollbacker interface {
+	// Rollback discards all the versions greater than the provided version, which
+	// becomes the latest version.
+	Rollback(version uint64) error
+}
+
+// CommitHook is called with the batch persisting the commit info of a version
+// committed by a Committer, the writes it adds to the batch are committed
+// atomically with the version.
+type CommitHook func(batch corestore.Batch, cInfo *proof.CommitInfo) error
+
+// CommitHooker defines the interface for the committers supporting a CommitHook.
+type CommitHooker interface {
+	// SetCommitHook sets the hook called on every commit, nil removes it.
+	SetCommitHook(hook CommitHook)
+}
+
+// Compactor defines the interface for compacting the versions of a database, i.e.
+// merging the consecutive versions of a key holding identical values into the
+// oldest one, so the unchanged values don't cost space per version.
+type Compactor interface {
+	// Compact merges the consecutive versions <= the provided version of every key
+	// holding identical values. The reads at any version are unaffected. The progress
+	// function, if not nil, is called with the running stats as the compaction
+	// progresses. Compact returns early with the context error when the context
+	// is canceled, the compaction done so far being kept.
+	Compact(ctx context.Context, version uint64, progress func(CompactionStats)) (CompactionStats, error)
+}
+
+// CompactionStats defines the stats of a compaction.
+type CompactionStats struct {
+	// EntriesScanned is the number of versioned entries scanned.
+	EntriesScanned uint64
+	// EntriesRemoved is the number of versioned entries removed.
+	EntriesRemoved uint64
+	// BytesRemoved is the size of the keys and values of the removed entries.
+	BytesRemoved uint64
+}
+
 // QueryResult defines the response type to performing a query on a RootStore.
 type QueryResult struct {
 	Key      []byte
@@ -6,10 +6,17 @@ import (
 	"context"
 	"testing"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/simapp"
+	"cosmossdk.io/x/accounts"
+	paymasterv1 "cosmossdk.io/x/accounts/testing/paymaster/v1"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -17,59 +24,178 @@ import (
 var (
 	privKey     = secp256k1.GenPrivKey()
 	accCreator  = []byte("creator")
-	bundlerAddr = secp256k1.GenPrivKey().PubKey().Address()
-	aliceAddr   = secp256k1.GenPrivKey().PubKey().Address()
+	bundlerAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	aliceAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 )
 
-/*
 func TestAccountAbstraction(t *testing.T) {
 	app := setupApp(t)
 	ak := app.AccountsKeeper
 	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
+	msgServer := accounts.NewMsgServer(ak)
 
 	_, aaAddr, err := ak.Init(ctx, "aa_minimal", accCreator, &rotationv1.MsgInit{
 		PubKeyBytes: privKey.PubKey().Bytes(),
 	}, nil)
 	require.NoError(t, err)
-
-	_, aaFullAddr, err := ak.Init(ctx, "aa_full", accCreator, &rotationv1.MsgInit{
+	_, unsponsoredAddr, err := ak.Init(ctx, "aa_minimal", accCreator, &rotationv1.MsgInit{
 		PubKeyBytes: privKey.PubKey().Bytes(),
 	}, nil)
 	require.NoError(t, err)
-
-	aaAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aaAddr)
-	require.NoError(t, err)
-
-	aaFullAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aaFullAddr)
-	require.NoError(t, err)
-
-	// let's give aa some coins.
-	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, aaAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000000000))))
-	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, aaFullAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000000000))))
-
-	bundlerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(bundlerAddr)
+	_, paymasterAddr, err := ak.Init(ctx, "paymaster", accCreator, &paymasterv1.MsgInit{
+		Sponsored: []string{bechify(t, app, aaAddr)},
+	}, nil)
 	require.NoError(t, err)
 
-	aliceAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aliceAddr)
-	require.NoError(t, err)
+	fundAccount(t, app, ctx, aaAddr, "1000000stake")
+	fundAccount(t, app, ctx, unsponsoredAddr, "1000000stake")
+	fundAccount(t, app, ctx, paymasterAddr, "1000000stake")
+
+	bundlerAddrStr := bechify(t, app, bundlerAddr)
+	send := func(from []byte, amt string) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: bechify(t, app, from),
+			ToAddress:   bechify(t, app, aliceAddr),
+			Amount:      coins(t, amt),
+		}
+	}
+	executeBundle := func(t *testing.T, ctx sdk.Context, bundledTx *tx.TxRaw) *accountsv1.BundledTxResponse {
+		t.Helper()
+		resp, err := msgServer.ExecuteBundle(ctx, &accountsv1.MsgExecuteBundle{
+			Bundler: bundlerAddrStr,
+			Txs:     []*tx.TxRaw{bundledTx},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Responses, 1)
+		return resp.Responses[0]
+	}
 
-	t.Run("ok - pay bundler not implemented", func(t *testing.T) {})
-	t.Run("pay bundle impersonation", func(t *testing.T) {})
-	t.Run("auth failure", func(t *testing.T) {})
-	t.Run("pay bundle failure", func(t *testing.T) {})
-	t.Run("exec message failure", func(t *testing.T) {})
+	t.Run("sender pays the bundler and is refunded the unused gas", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{Amount: coins(t, "10000stake"), GasLimit: 1_000_000}, send(aaAddr, "100stake")))
+		require.Empty(t, resp.Error)
+		require.NotNil(t, resp.ExecResponses)
+		require.NotZero(t, resp.GasUsed)
+		require.True(t, resp.Fee.IsAllPositive())
+		require.True(t, resp.Fee.IsAllLT(coins(t, "10000stake")))
+
+		balanceIs(t, ctx, app, bundlerAddr, resp.Fee.String())
+		balanceIs(t, ctx, app, aliceAddr, "100stake")
+		balanceIs(t, ctx, app, aaAddr, coins(t, "999900stake").Sub(resp.Fee...).String())
+	})
+
+	t.Run("paymaster sponsors the bundled tx", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{
+			Amount:   coins(t, "10000stake"),
+			GasLimit: 1_000_000,
+			Payer:    bechify(t, app, paymasterAddr),
+		}, send(aaAddr, "100stake")))
+		require.Empty(t, resp.Error)
+		require.True(t, resp.Fee.IsAllPositive())
+
+		balanceIs(t, ctx, app, bundlerAddr, resp.Fee.String())
+		balanceIs(t, ctx, app, aaAddr, "999900stake")
+		balanceIs(t, ctx, app, paymasterAddr, coins(t, "1000000stake").Sub(resp.Fee...).String())
+
+		sponsorships, err := ak.Query(ctx, paymasterAddr, &paymasterv1.QuerySponsorships{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), sponsorships.(*paymasterv1.QuerySponsorshipsResponse).Sponsorships)
+	})
+
+	t.Run("paymaster refuses the sponsorship", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{
+			Amount:   coins(t, "10000stake"),
+			GasLimit: 1_000_000,
+			Payer:    bechify(t, app, paymasterAddr),
+		}, send(unsponsoredAddr, "100stake")))
+		require.Contains(t, resp.Error, accounts.ErrBundlerPayment.Error())
+		require.Contains(t, resp.Error, "is not sponsored")
+		require.Nil(t, resp.ExecResponses)
+
+		balanceIs(t, ctx, app, bundlerAddr, "")
+		balanceIs(t, ctx, app, unsponsoredAddr, "1000000stake")
+		balanceIs(t, ctx, app, paymasterAddr, "1000000stake")
+	})
+
+	t.Run("fee payer is not a paymaster", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{
+			Amount:   coins(t, "10000stake"),
+			GasLimit: 1_000_000,
+			Payer:    bechify(t, app, unsponsoredAddr),
+		}, send(aaAddr, "100stake")))
+		require.Contains(t, resp.Error, "is not a paymaster")
+		balanceIs(t, ctx, app, bundlerAddr, "")
+		balanceIs(t, ctx, app, unsponsoredAddr, "1000000stake")
+	})
+
+	t.Run("execution failure still pays the bundler", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{Amount: coins(t, "10000stake"), GasLimit: 1_000_000}, send(aaAddr, "2000000stake")))
+		require.Contains(t, resp.Error, accounts.ErrExecution.Error())
+		require.Nil(t, resp.ExecResponses)
+		require.True(t, resp.Fee.IsAllPositive())
+
+		balanceIs(t, ctx, app, bundlerAddr, resp.Fee.String())
+		balanceIs(t, ctx, app, aliceAddr, "")
+		balanceIs(t, ctx, app, aaAddr, coins(t, "1000000stake").Sub(resp.Fee...).String())
+	})
+
+	t.Run("out of gas during authentication", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{Amount: coins(t, "10000stake"), GasLimit: 1}, send(aaAddr, "100stake")))
+		require.Contains(t, resp.Error, "out of gas")
+		balanceIs(t, ctx, app, bundlerAddr, "")
+		balanceIs(t, ctx, app, aaAddr, "1000000stake")
+	})
+
+	t.Run("timed out bundled tx", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 10})
+		timedOut := bundledTx(t, &tx.Fee{Amount: coins(t, "10000stake"), GasLimit: 1_000_000}, send(aaAddr, "100stake"))
+		body := new(tx.TxBody)
+		require.NoError(t, body.Unmarshal(timedOut.BodyBytes))
+		body.TimeoutHeight = 9
+		timedOut.BodyBytes, err = body.Marshal()
+		require.NoError(t, err)
 
-	t.Run("implements bundler payment - fail ", func(t *testing.T) {})
+		resp := executeBundle(t, ctx, timedOut)
+		require.Contains(t, resp.Error, "bundled tx timed out")
+		balanceIs(t, ctx, app, bundlerAddr, "")
+		balanceIs(t, ctx, app, aaAddr, "1000000stake")
+	})
 
-	t.Run("implements execution - fail", func(t *testing.T) {})
+	t.Run("invalid bundled txs", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		resp := executeBundle(t, ctx, bundledTx(t, &tx.Fee{Amount: coins(t, "10000stake")}, send(aaAddr, "100stake")))
+		require.Contains(t, resp.Error, "gas limit must be set")
 
-	t.Run("implements bundler payment and execution - success", func(t *testing.T) {})
+		resp = executeBundle(t, ctx, bundledTx(t, &tx.Fee{GasLimit: 1_000_000}, send(bundlerAddr, "100stake")))
+		require.Contains(t, resp.Error, "not an abstracted account")
 
-	t.Run("Simulate - OK", func(t *testing.T) {})
+		_, err := msgServer.ExecuteBundle(ctx, &accountsv1.MsgExecuteBundle{Bundler: bundlerAddrStr})
+		require.ErrorContains(t, err, "bundle must contain at least one tx")
+	})
+}
 
-	t.Run("Simulate - Fail empty user operation", func(t *testing.T) {})
+// bundledTx returns a bundled tx with the given fee and messages, signed with a mock signature.
+func bundledTx(t *testing.T, fee *tx.Fee, msgs ...gogoproto.Message) *tx.TxRaw {
+	t.Helper()
+	bodyBytes, err := (&tx.TxBody{Messages: intoAny(t, msgs...)}).Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := (&tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{PublicKey: intoAny(t, privKey.PubKey())[0]}},
+		Fee:         fee,
+	}).Marshal()
+	require.NoError(t, err)
+	return &tx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{mockSignature.Value},
+	}
 }
-*/
 
 func intoAny(t *testing.T, msgs ...gogoproto.Message) (anys []*codectypes.Any) {
 	t.Helper()
@@ -22,7 +22,7 @@ func TestBaseAccount(t *testing.T) {
 	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
 
 	_, baseAccountAddr, err := ak.Init(ctx, "base", accCreator, &baseaccountv1.MsgInit{
-		PubKey: privKey.PubKey().Bytes(),
+		PubKey: intoAny(t, privKey.PubKey())[0],
 	}, nil)
 	require.NoError(t, err)
 
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -22,40 +21,58 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
-	PubKeyPrefix   = collections.NewPrefix(0)
-	SequencePrefix = collections.NewPrefix(1)
+	PubKeyPrefix      = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+	SessionKeysPrefix = collections.NewPrefix(2)
 )
 
-func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+// NewAccount creates a base account supporting the pubkey types configured by the
+// options. If no pubkey type is configured only secp256k1 pubkeys are supported.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
 	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
-		return name, Account{
-			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
-			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
-			addrCodec:       deps.AddressCodec,
-			signingHandlers: handlerMap,
-			hs:              deps.Environment.HeaderService,
-		}, nil
+		acc := Account{
+			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", pubKeyValueCodec{codec.CollValue[codectypes.Any](deps.LegacyStateCodec)}),
+			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:      collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:        deps.AddressCodec,
+			signingHandlers:  handlerMap,
+			hs:               deps.Environment.HeaderService,
+			supportedPubKeys: map[string]pubKeyDecoder{},
+		}
+		if len(options) == 0 {
+			options = []Option{WithSecp256K1PubKey()}
+		}
+		for _, option := range options {
+			option(&acc)
+		}
+		return name, acc, nil
 	}
 }
 
 // Account implements a base account.
 type Account struct {
-	PubKey   collections.Item[secp256k1.PubKey]
-	Sequence collections.Sequence
+	PubKey      collections.Item[codectypes.Any]
+	Sequence    collections.Sequence
+	SessionKeys collections.Map[[]byte, v1.SessionKey] // key: address of the session key pubkey
 
 	addrCodec address.Codec
 	hs        header.Service
 
 	signingHandlers *signing.HandlerMap
+
+	// supportedPubKeys maps the type URLs of the pubkeys the account
+	// accepts to the function decoding them.
+	supportedPubKeys map[string]pubKeyDecoder
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
-	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+	return &v1.MsgInitResponse{}, a.SetPubKey(ctx, msg.PubKey)
 }
 
 func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
@@ -63,24 +80,40 @@ func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.Msg
 		return nil, errors.New("unauthorized")
 	}
 
-	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+	return &v1.MsgSwapPubKeyResponse{}, a.SetPubKey(ctx, msg.NewPubKey)
 }
 
-func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
-	_, err := dcrd_secp256k1.ParsePubKey(key)
+// SetPubKey sets the account pubkey, failing if its type is not supported by the account.
+func (a Account) SetPubKey(ctx context.Context, key *codectypes.Any) error {
+	_, err := a.DecodePubKey(key)
 	if err != nil {
 		return err
 	}
-	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+	return a.PubKey.Set(ctx, codectypes.Any{TypeUrl: key.TypeUrl, Value: key.Value})
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
+// The transaction can be signed by the account pubkey or, if the signer info carries
+// its pubkey, by a session key, in which case the restrictions of the session key apply.
 func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
 	if !accountstd.SenderIsAccountsModule(ctx) {
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	pkAny, sessionKey, err := a.signerPubKey(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var spendLimitLeft sdk.Coins
+	if sessionKey != nil {
+		spendLimitLeft, err = a.checkSessionKey(ctx, msg, *sessionKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pubKey, signerData, err := a.computeSignerData(ctx, pkAny)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +144,14 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("signature verification failed")
 	}
 
+	if sessionKey != nil {
+		sessionKey.SpendLimit = spendLimitLeft
+		err = a.SessionKeys.Set(ctx, pubKey.Address().Bytes(), *sessionKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
@@ -122,32 +163,27 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	return signingv1beta1.SignMode(single.Single.Mode), nil
 }
 
-// computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+// computeSignerData will populate signer data for the given pubkey and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pkAny codectypes.Any) (cryptotypes.PubKey, signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
-	}
-
-	pk, err := a.PubKey.Get(ctx)
-	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	pk, err := a.DecodePubKey(&pkAny)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	return pk, signing.SignerData{
@@ -209,9 +245,12 @@ func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 
 func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
 }
 
 func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
 }
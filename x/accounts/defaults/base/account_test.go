@@ -14,17 +14,21 @@ import (
 	"cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-func setupBaseAccount(t *testing.T, ss store.KVStoreService) Account {
+func setupBaseAccount(t *testing.T, ss store.KVStoreService, options ...Option) Account {
 	t.Helper()
 	deps := makeMockDependencies(ss)
 	handler := directHandler{}
 
-	createAccFn := NewAccount("base", signing.NewHandlerMap(handler))
+	createAccFn := NewAccount("base", signing.NewHandlerMap(handler), options...)
 	_, acc, err := createAccFn(deps)
 	baseAcc := acc.(Account)
 	require.NoError(t, err)
@@ -36,7 +40,7 @@ func TestInit(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	baseAcc := setupBaseAccount(t, ss)
 	_, err := baseAcc.Init(ctx, &v1.MsgInit{
-		PubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
 	})
 	require.NoError(t, err)
 
@@ -48,14 +52,26 @@ func TestInit(t *testing.T) {
 		{
 			"valid init",
 			&v1.MsgInit{
-				PubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+				PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
 			},
 			false,
 		},
 		{
 			"invalid pubkey",
 			&v1.MsgInit{
-				PubKey: []byte("invalid_pk"),
+				PubKey: &codectypes.Any{TypeUrl: "/cosmos.crypto.secp256k1.PubKey", Value: []byte("invalid_pk")},
+			},
+			true,
+		},
+		{
+			"missing pubkey",
+			&v1.MsgInit{},
+			true,
+		},
+		{
+			"unsupported pubkey type",
+			&v1.MsgInit{
+				PubKey: toAnyPb(t, ed25519.GenPrivKey().PubKey()),
 			},
 			true,
 		},
@@ -77,7 +93,7 @@ func TestSwapKey(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	baseAcc := setupBaseAccount(t, ss)
 	_, err := baseAcc.Init(ctx, &v1.MsgInit{
-		PubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
 	})
 	require.NoError(t, err)
 
@@ -94,7 +110,7 @@ func TestSwapKey(t *testing.T) {
 				return accountstd.SetSender(ctx, []byte("mock_base_account"))
 			},
 			&v1.MsgSwapPubKey{
-				NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+				NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
 			},
 			false,
 			nil,
@@ -105,7 +121,7 @@ func TestSwapKey(t *testing.T) {
 				return accountstd.SetSender(ctx, []byte("sender"))
 			},
 			&v1.MsgSwapPubKey{
-				NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+				NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
 			},
 			true,
 			errors.New("unauthorized"),
@@ -116,7 +132,7 @@ func TestSwapKey(t *testing.T) {
 				return accountstd.SetSender(ctx, []byte("mock_base_account"))
 			},
 			&v1.MsgSwapPubKey{
-				NewPubKey: []byte("invalid_pk"),
+				NewPubKey: &codectypes.Any{TypeUrl: "/cosmos.crypto.secp256k1.PubKey", Value: []byte("invalid_pk")},
 			},
 			true,
 			nil,
@@ -149,7 +165,7 @@ func TestAuthenticate(t *testing.T) {
 	pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
 	require.NoError(t, err)
 	_, err = baseAcc.Init(ctx, &v1.MsgInit{
-		PubKey: privKey.PubKey().Bytes(),
+		PubKey: toAnyPb(t, privKey.PubKey()),
 	})
 	require.NoError(t, err)
 
@@ -251,3 +267,117 @@ func TestAuthenticate(t *testing.T) {
 	})
 	require.Equal(t, errors.New("signature verification failed"), err)
 }
+
+func TestSwapKeyAlgorithm(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss, WithSecp256K1PubKey(), WithSecp256R1PubKey())
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+	})
+	require.NoError(t, err)
+
+	// ed25519 keys are not supported by the account
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	_, err = baseAcc.SwapPubKey(selfCtx, &v1.MsgSwapPubKey{
+		NewPubKey: toAnyPb(t, ed25519.GenPrivKey().PubKey()),
+	})
+	require.ErrorContains(t, err, "unsupported pubkey type")
+
+	// the account is swapped to a secp256r1 key
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	pkAny := toAnyPb(t, privKey.PubKey())
+	_, err = baseAcc.SwapPubKey(selfCtx, &v1.MsgSwapPubKey{NewPubKey: pkAny})
+	require.NoError(t, err)
+
+	stored, err := baseAcc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pkAny.TypeUrl, stored.TypeUrl)
+	require.Equal(t, pkAny.Value, stored.Value)
+
+	// and transactions signed with the new key are authenticated
+	transaction := tx.Tx{
+		Body: &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pkAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: 0,
+				},
+			},
+		},
+	}
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	_, err = baseAcc.Authenticate(accountstd.SetSender(ctx, address.Module("accounts")), &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	})
+	require.NoError(t, err)
+}
+
+func TestLegacySecp256k1PubKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+
+	// the pubkeys stored before the Any pubkeys are decoded as secp256k1 pubkeys
+	pk := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	bz, err := pk.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, ss.OpenKVStore(ctx).Set(PubKeyPrefix.Bytes(), bz))
+
+	stored, err := baseAcc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	pkAny := toAnyPb(t, pk)
+	require.Equal(t, pkAny.TypeUrl, stored.TypeUrl)
+	require.Equal(t, pkAny.Value, stored.Value)
+	decoded, err := baseAcc.DecodePubKey(&stored)
+	require.NoError(t, err)
+	require.True(t, decoded.Equals(pk))
+}
+
+func TestBls12381PubKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss, WithBls12381PubKey())
+
+	// the bls12_381 pubkeys are validated, all of them being rejected without the
+	// bls12381 build tag
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{
+		PubKey: toAnyPb(t, &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}),
+	})
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "unsupported pubkey type")
+}
+
+func toAnyPb(t *testing.T, pk cryptotypes.PubKey) *codectypes.Any {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+	return pkAny
+}
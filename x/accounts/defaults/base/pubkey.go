@@ -0,0 +1,121 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	collcodec "cosmossdk.io/collections/codec"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Option configures a base account.
+type Option func(a *Account)
+
+// pubKeyImpl is the constraint satisfied by the pubkey types a base account can support.
+type pubKeyImpl[T any] interface {
+	*T
+	cryptotypes.PubKey
+}
+
+// pubKeyDecoder decodes and validates the bytes of a pubkey of a supported type.
+type pubKeyDecoder func(bz []byte) (cryptotypes.PubKey, error)
+
+// WithPubKey adds the pubkey type PT to the pubkey types supported by the account,
+// validateFn is called to verify every pubkey of that type set on the account.
+func WithPubKey[T any, PT pubKeyImpl[T]](validateFn func(PT) error) Option {
+	typeURL := "/" + gogoproto.MessageName(PT(new(T)))
+	return func(a *Account) {
+		a.supportedPubKeys[typeURL] = func(bz []byte) (cryptotypes.PubKey, error) {
+			pk := PT(new(T))
+			err := gogoproto.Unmarshal(bz, pk)
+			if err != nil {
+				return nil, err
+			}
+			err = validateFn(pk)
+			if err != nil {
+				return nil, err
+			}
+			return pk, nil
+		}
+	}
+}
+
+// WithSecp256K1PubKey adds secp256k1 pubkeys to the pubkey types supported by the account.
+func WithSecp256K1PubKey() Option {
+	return WithPubKey(func(pk *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pk.Key)
+		return err
+	})
+}
+
+// WithSecp256R1PubKey adds secp256r1 pubkeys, as used by passkeys and hardware
+// backed keys, to the pubkey types supported by the account.
+func WithSecp256R1PubKey() Option {
+	return WithPubKey(func(pk *secp256r1.PubKey) error {
+		// the point is already validated when unmarshalling the key
+		if pk.Key == nil {
+			return errors.New("empty secp256r1 pubkey")
+		}
+		return nil
+	})
+}
+
+// WithEd25519PubKey adds ed25519 pubkeys to the pubkey types supported by the account.
+func WithEd25519PubKey() Option {
+	return WithPubKey(func(pk *ed25519.PubKey) error {
+		if len(pk.Key) != ed25519.PubKeySize {
+			return fmt.Errorf("invalid ed25519 pubkey size, wanted: %d, got: %d", ed25519.PubKeySize, len(pk.Key))
+		}
+		return nil
+	})
+}
+
+// WithBls12381PubKey adds bls12_381 pubkeys to the pubkey types supported by the
+// account. The bls12_381 keys require the bls12381 build tag, without which the
+// pubkeys of that type are rejected.
+func WithBls12381PubKey() Option {
+	return WithPubKey(func(pk *bls12_381.PubKey) error {
+		return validateBls12381PubKey(pk.Key)
+	})
+}
+
+// pubKeyValueCodec is the value codec of the account pubkey. It decodes the pubkeys
+// stored before the account supported other pubkey types, as the bytes of a
+// secp256k1.PubKey, into their Any.
+type pubKeyValueCodec struct {
+	collcodec.ValueCodec[codectypes.Any]
+}
+
+func (c pubKeyValueCodec) Decode(bz []byte) (codectypes.Any, error) {
+	// a secp256k1.PubKey holds a compressed point, which starts with 0x02 or 0x03,
+	// in its field 1, unlike an Any, whose type URL in its field 1 starts with '/'
+	if len(bz) == 2+secp256k1.PubKeySize && bz[0] == 0x0a && bz[1] == secp256k1.PubKeySize && (bz[2] == 0x02 || bz[2] == 0x03) {
+		pkAny, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: bz[2:]})
+		if err != nil {
+			return codectypes.Any{}, err
+		}
+		return codectypes.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}, nil
+	}
+	return c.ValueCodec.Decode(bz)
+}
+
+// DecodePubKey decodes the given pubkey, failing if its type is not supported by the account.
+func (a Account) DecodePubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, errors.New("pubkey is required")
+	}
+	decode, ok := a.supportedPubKeys[pkAny.TypeUrl]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pubkey type: %s", pkAny.TypeUrl)
+	}
+	return decode(pkAny.Value)
+}
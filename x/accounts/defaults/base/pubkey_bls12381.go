@@ -0,0 +1,12 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package base
+
+import (
+	bls12381 "github.com/cosmos/crypto/curves/bls12381"
+)
+
+func validateBls12381PubKey(key []byte) error {
+	_, err := bls12381.PublicKeyFromBytes(key)
+	return err
+}
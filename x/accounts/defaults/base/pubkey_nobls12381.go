@@ -0,0 +1,9 @@
+//go:build !(((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381)
+
+package base
+
+import "errors"
+
+func validateBls12381PubKey([]byte) error {
+	return errors.New("bls12_381 pubkeys require the bls12381 build tag")
+}
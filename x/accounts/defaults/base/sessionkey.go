@@ -0,0 +1,291 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	msgSendTypeURL      = "/" + string((&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName())
+	msgMultiSendTypeURL = "/" + string((&bankv1beta1.MsgMultiSend{}).ProtoReflect().Descriptor().FullName())
+	msgExecuteTypeURL   = "/" + gogoproto.MessageName(&accountsv1.MsgExecute{})
+	msgInitTypeURL      = "/" + gogoproto.MessageName(&accountsv1.MsgInit{})
+)
+
+// AddSessionKey registers a session key on the account.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	sk := msg.SessionKey
+	if sk == nil {
+		return nil, errors.New("session key must be specified")
+	}
+	pk, err := a.DecodePubKey(sk.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	accPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sk.PubKey.TypeUrl == accPubKey.TypeUrl && bytes.Equal(sk.PubKey.Value, accPubKey.Value) {
+		return nil, errors.New("session key cannot be the account pubkey")
+	}
+	if len(sk.AllowedMessages) == 0 {
+		return nil, errors.New("allowed messages must be specified")
+	}
+	if err := sk.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+	if sk.ExpiryHeight == 0 && sk.ExpiryTime == nil {
+		return nil, errors.New("session key must expire")
+	}
+	if a.isExpired(ctx, *sk) {
+		return nil, errors.New("session key already expired")
+	}
+
+	id := pk.Address().Bytes()
+	has, err := a.SessionKeys.Has(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, fmt.Errorf("session key %X already registered", id)
+	}
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, id, *sk)
+}
+
+// RemoveSessionKey revokes a session key of the account.
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	pk, err := a.DecodePubKey(msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	id := pk.Address().Bytes()
+	has, err := a.SessionKeys.Has(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("session key %X not registered", id)
+	}
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, id)
+}
+
+// QuerySessionKeys returns the session keys of the account.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	resp := &v1.QuerySessionKeysResponse{}
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sk v1.SessionKey) (bool, error) {
+		resp.SessionKeys = append(resp.SessionKeys, sk)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// signerPubKey returns the pubkey the signer claims to have signed the transaction with.
+// The account pubkey is used when the signer info has no pubkey or has the account pubkey,
+// otherwise the pubkey must be the one of a session key, which is returned too.
+func (a Account) signerPubKey(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (codectypes.Any, *v1.SessionKey, error) {
+	accPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return codectypes.Any{}, nil, err
+	}
+	txPubKey := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].PublicKey
+	if txPubKey == nil || (txPubKey.TypeUrl == accPubKey.TypeUrl && bytes.Equal(txPubKey.Value, accPubKey.Value)) {
+		return accPubKey, nil, nil
+	}
+
+	pk, err := a.DecodePubKey(txPubKey)
+	if err != nil {
+		return codectypes.Any{}, nil, err
+	}
+	sk, err := a.SessionKeys.Get(ctx, pk.Address().Bytes())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return codectypes.Any{}, nil, fmt.Errorf("session key %X not registered", pk.Address().Bytes())
+		}
+		return codectypes.Any{}, nil, err
+	}
+	return *sk.PubKey, &sk, nil
+}
+
+// isExpired reports whether the session key can no longer be used at the current block.
+func (a Account) isExpired(ctx context.Context, sk v1.SessionKey) bool {
+	info := a.hs.HeaderInfo(ctx)
+	if sk.ExpiryHeight != 0 && uint64(info.Height) > sk.ExpiryHeight {
+		return true
+	}
+	return sk.ExpiryTime != nil && !info.Time.Before(*sk.ExpiryTime)
+}
+
+// checkSessionKey checks that the transaction satisfies the restrictions of the session key
+// and returns the spend limit left once the coins spent by the transaction are deducted.
+// Only the coins moved by bank sends, by x/accounts messages funds and by fees are accounted,
+// if the session key has a spend limit the other messages are rejected.
+func (a Account) checkSessionKey(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, sk v1.SessionKey) (sdk.Coins, error) {
+	if a.isExpired(ctx, sk) {
+		return nil, errors.New("session key expired")
+	}
+	self := accountstd.Whoami(ctx)
+
+	limited := !sk.SpendLimit.Empty()
+	spent := sdk.NewCoins()
+	for _, m := range msg.Tx.Body.Messages {
+		if !slices.Contains(sk.AllowedMessages, m.TypeUrl) {
+			return nil, fmt.Errorf("message not allowed by the session key: %s", m.TypeUrl)
+		}
+		coins, err := a.spentCoins(m, self)
+		if errors.Is(err, errSpendNotAccounted) && !limited {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		spent = spent.Add(coins...)
+	}
+	if !limited {
+		return nil, nil
+	}
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && fee.Granter == "" {
+		paysFee := fee.Payer == "" && msg.SignerIndex == 0
+		if fee.Payer != "" {
+			var err error
+			paysFee, err = a.isSelf(fee.Payer, self)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fee payer: %w", err)
+			}
+		}
+		if paysFee {
+			if err := fee.Amount.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid fee: %w", err)
+			}
+			spent = spent.Add(fee.Amount...)
+		}
+	}
+
+	left, hasNeg := sk.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return nil, fmt.Errorf("spend limit exceeded, limit: %s, spent: %s", sk.SpendLimit, spent)
+	}
+	return left, nil
+}
+
+// errSpendNotAccounted is returned by spentCoins for the messages whose spend
+// can't be accounted for.
+var errSpendNotAccounted = errors.New("spend of the message can't be accounted for")
+
+// spentCoins returns the coins of the account the message spends.
+func (a Account) spentCoins(m *codectypes.Any, self []byte) (sdk.Coins, error) {
+	switch m.TypeUrl {
+	case msgSendTypeURL:
+		send := new(bankv1beta1.MsgSend)
+		if err := proto.Unmarshal(m.Value, send); err != nil {
+			return nil, err
+		}
+		if isSelf, err := a.isSelf(send.FromAddress, self); err != nil || !isSelf {
+			return nil, err
+		}
+		return parseCoins(send.Amount)
+	case msgMultiSendTypeURL:
+		multiSend := new(bankv1beta1.MsgMultiSend)
+		if err := proto.Unmarshal(m.Value, multiSend); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range multiSend.Inputs {
+			isSelf, err := a.isSelf(input.Address, self)
+			if err != nil {
+				return nil, err
+			}
+			if !isSelf {
+				continue
+			}
+			coins, err := parseCoins(input.Coins)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(coins...)
+		}
+		return spent, nil
+	case msgExecuteTypeURL:
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(m.Value, execute); err != nil {
+			return nil, err
+		}
+		// the session key must not be able to manage the account itself,
+		// for example to swap its pubkey or to add other session keys.
+		isTarget, err := a.isSelf(execute.Target, self)
+		if err != nil {
+			return nil, err
+		}
+		if isTarget {
+			return nil, errors.New("session key cannot execute messages on the account")
+		}
+		if isSelf, err := a.isSelf(execute.Sender, self); err != nil || !isSelf {
+			return nil, err
+		}
+		return execute.Funds, execute.Funds.Validate()
+	case msgInitTypeURL:
+		msgInit := new(accountsv1.MsgInit)
+		if err := gogoproto.Unmarshal(m.Value, msgInit); err != nil {
+			return nil, err
+		}
+		if isSelf, err := a.isSelf(msgInit.Sender, self); err != nil || !isSelf {
+			return nil, err
+		}
+		return msgInit.Funds, msgInit.Funds.Validate()
+	default:
+		return nil, fmt.Errorf("%w: %s", errSpendNotAccounted, m.TypeUrl)
+	}
+}
+
+// isSelf reports whether the address is the one of the account. Addresses are compared
+// decoded, as different strings, the upper case ones for example, can decode to the same bytes.
+func (a Account) isSelf(addr string, self []byte) (bool, error) {
+	bz, err := a.addrCodec.StringToBytes(addr)
+	if err != nil {
+		return false, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	return bytes.Equal(bz, self), nil
+}
+
+func parseCoins(coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	parsed := sdk.NewCoins()
+	for _, c := range coins {
+		amount, ok := math.NewIntFromString(c.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount: %s", c.Amount)
+		}
+		coin := sdk.Coin{Denom: c.Denom, Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return nil, err
+		}
+		parsed = parsed.Add(coin)
+	}
+	return parsed, nil
+}
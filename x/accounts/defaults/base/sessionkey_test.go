@@ -0,0 +1,307 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestAddSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	ownerKey := secp256k1.GenPrivKey()
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, ownerKey.PubKey())})
+	require.NoError(t, err)
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+
+	expiryTime := time.Unix(2000, 0)
+	pastTime := time.Unix(500, 0)
+	newSessionKey := func(modify func(sk *v1.SessionKey)) *v1.SessionKey {
+		sk := &v1.SessionKey{
+			PubKey:          toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+			AllowedMessages: []string{msgSendTypeURL},
+			SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			ExpiryHeight:    20,
+		}
+		modify(sk)
+		return sk
+	}
+
+	testcases := []struct {
+		name   string
+		ctx    context.Context
+		sk     *v1.SessionKey
+		expErr string
+	}{
+		{
+			"valid session key",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) {}),
+			"",
+		},
+		{
+			"valid session key expiring at a given time",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) {
+				sk.ExpiryHeight = 0
+				sk.ExpiryTime = &expiryTime
+			}),
+			"",
+		},
+		{
+			"unauthorized sender",
+			ctx,
+			newSessionKey(func(sk *v1.SessionKey) {}),
+			"unauthorized",
+		},
+		{
+			"account pubkey",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) { sk.PubKey = toAnyPb(t, ownerKey.PubKey()) }),
+			"session key cannot be the account pubkey",
+		},
+		{
+			"no allowed messages",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) { sk.AllowedMessages = nil }),
+			"allowed messages must be specified",
+		},
+		{
+			"no expiry",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) { sk.ExpiryHeight = 0 }),
+			"session key must expire",
+		},
+		{
+			"expired height",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) { sk.ExpiryHeight = 9 }),
+			"session key already expired",
+		},
+		{
+			"expired time",
+			selfCtx,
+			newSessionKey(func(sk *v1.SessionKey) { sk.ExpiryTime = &pastTime }),
+			"session key already expired",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := baseAcc.AddSessionKey(tc.ctx, &v1.MsgAddSessionKey{SessionKey: tc.sk})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	resp, err := baseAcc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 2)
+}
+
+func TestAuthenticateSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	ownerKey := secp256k1.GenPrivKey()
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, ownerKey.PubKey())})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	_, err = baseAcc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgAddSessionKey{
+		SessionKey: &v1.SessionKey{
+			PubKey:          toAnyPb(t, sessionKey.PubKey()),
+			AllowedMessages: []string{msgSendTypeURL},
+			SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			ExpiryHeight:    20,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	send := bankMsgSend(t, "mock_base_account", 60)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the session key spends 60stake and 10stake of fees
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 0, fee, send))
+	require.NoError(t, err)
+	resp, err := baseAcc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), resp.SessionKeys[0].SpendLimit)
+
+	// sends of other accounts are not accounted
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 1, nil, bankMsgSend(t, "other", 60)))
+	require.NoError(t, err)
+
+	// the spend limit left is exceeded
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 2, fee, send))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	// sends of the account are accounted whatever the case of its address
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 2, nil, bankMsgSend(t, "MOCK_BASE_ACCOUNT", 60)))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	// messages not allowed by the session key are rejected
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 2, nil, &codectypes.Any{TypeUrl: msgMultiSendTypeURL}))
+	require.ErrorContains(t, err, "message not allowed by the session key")
+
+	// the account pubkey is not restricted
+	_, err = baseAcc.Authenticate(ctx, signTx(t, ownerKey, 2, fee, send, &codectypes.Any{TypeUrl: msgMultiSendTypeURL}))
+	require.NoError(t, err)
+
+	// unknown keys are rejected
+	_, err = baseAcc.Authenticate(ctx, signTx(t, secp256k1.GenPrivKey(), 3, nil))
+	require.ErrorContains(t, err, "not registered")
+
+	// revoked session keys can no longer authenticate
+	_, err = baseAcc.RemoveSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgRemoveSessionKey{
+		PubKey: toAnyPb(t, sessionKey.PubKey()),
+	})
+	require.NoError(t, err)
+	_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 3, nil, bankMsgSend(t, "other", 1)))
+	require.ErrorContains(t, err, "not registered")
+}
+
+func TestSessionKeyCannotManageAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	_, err = baseAcc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgAddSessionKey{
+		SessionKey: &v1.SessionKey{
+			PubKey:          toAnyPb(t, sessionKey.PubKey()),
+			AllowedMessages: []string{msgExecuteTypeURL},
+			ExpiryHeight:    20,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	// the account is recognized whatever the case of its address
+	for _, target := range []string{"mock_base_account", "MOCK_BASE_ACCOUNT"} {
+		execute, err := (&accountsv1.MsgExecute{Sender: "mock_base_account", Target: target}).Marshal()
+		require.NoError(t, err)
+		_, err = baseAcc.Authenticate(ctx, signTx(t, sessionKey, 0, nil, &codectypes.Any{TypeUrl: msgExecuteTypeURL, Value: execute}))
+		require.ErrorContains(t, err, "session key cannot execute messages on the account")
+	}
+}
+
+func TestSessionKeySpendNotAccounted(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	const msgDelegateTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+	limitedKey, unlimitedKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	for _, sk := range []v1.SessionKey{
+		{
+			PubKey:          toAnyPb(t, limitedKey.PubKey()),
+			AllowedMessages: []string{msgDelegateTypeURL},
+			SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			ExpiryHeight:    20,
+		},
+		{
+			PubKey:          toAnyPb(t, unlimitedKey.PubKey()),
+			AllowedMessages: []string{msgDelegateTypeURL},
+			ExpiryHeight:    20,
+		},
+	} {
+		_, err = baseAcc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgAddSessionKey{SessionKey: &sk})
+		require.NoError(t, err)
+	}
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	delegate := &codectypes.Any{TypeUrl: msgDelegateTypeURL}
+
+	// the spend of the message can't be checked against the spend limit
+	_, err = baseAcc.Authenticate(ctx, signTx(t, limitedKey, 0, nil, delegate))
+	require.ErrorContains(t, err, "can't be accounted for")
+
+	// session keys without a spend limit are not restricted
+	_, err = baseAcc.Authenticate(ctx, signTx(t, unlimitedKey, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), delegate))
+	require.NoError(t, err)
+}
+
+func bankMsgSend(t *testing.T, from string, amount int64) *codectypes.Any {
+	t.Helper()
+	bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   "recipient",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: math.NewInt(amount).String()}},
+	})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgSendTypeURL, Value: bz}
+}
+
+// signTx returns the authentication request of a transaction signed by the key,
+// whose pubkey is set in the signer info.
+func signTx(t *testing.T, privKey cryptotypes.PrivKey, seq uint64, fee sdk.Coins, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: toAnyPb(t, privKey.PubKey()),
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: seq,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
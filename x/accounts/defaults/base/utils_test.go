@@ -2,7 +2,9 @@ package base
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -49,11 +51,13 @@ func (c mockStateCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
 	return err
 }
 
-// mock address codec
+// mock address codec, like bech32 it decodes the upper case addresses to the same bytes.
 type addressCodec struct{}
 
-func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
-func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+func (a addressCodec) StringToBytes(text string) ([]byte, error) {
+	return []byte(strings.ToLower(text)), nil
+}
+func (a addressCodec) BytesToString(bz []byte) (string, error) { return string(bz), nil }
 
 func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 	t.Helper()
@@ -92,6 +96,8 @@ type headerService struct{}
 func (h headerService) HeaderInfo(context.Context) header.Info {
 	return header.Info{
 		ChainID: "test",
+		Height:  10,
+		Time:    time.Unix(1000, 0),
 	}
 }
 
@@ -0,0 +1,328 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// The prefixes 0 to 9 are reserved to the state of the base account.
+var (
+	GuardiansPrefix  = collections.NewPrefix(10)
+	ConfigPrefix     = collections.NewPrefix(11)
+	RecoveriesPrefix = collections.NewPrefix(12)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// NewAccount returns the creator function of a base account which can be recovered by
+// its guardians. The options configure the pubkey types supported by the account.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:      baseAcc.(base.Account),
+			Guardians:    collections.NewKeySet(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey),
+			Config:       collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recoveries:   collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.BytesKey, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			addrCodec:    deps.AddressCodec,
+			hs:           deps.Environment.HeaderService,
+			eventService: deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account extends the base account with a guardian-based recovery: once a recovery
+// rotating the pubkey of the account is approved by the threshold of guardians, it can
+// be executed after the timelock, unless the current key of the account cancels it.
+type Account struct {
+	base.Account
+
+	Guardians  collections.KeySet[[]byte]
+	Config     collections.Item[v1.Config]
+	Recoveries collections.Map[[]byte, v1.Recovery] // key: address of the new pubkey
+
+	addrCodec    address.Codec
+	hs           header.Service
+	eventService event.Service
+}
+
+// Init initializes the account with its pubkey, its guardians and its recovery config.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	err := a.SetPubKey(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.setGuardians(ctx, msg.Guardians, msg.Config)
+}
+
+// UpdateGuardians replaces the guardians and the config of the account, cancelling its pending recoveries.
+func (a Account) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	err := a.Guardians.Clear(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = a.setGuardians(ctx, msg.Guardians, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateGuardiansResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+func (a Account) setGuardians(ctx context.Context, guardians []string, cfg *v1.Config) error {
+	if cfg == nil {
+		return errors.New("config must be specified")
+	}
+	if len(guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+	if cfg.Threshold == 0 || int(cfg.Threshold) > len(guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians (%d), got: %d", len(guardians), cfg.Threshold)
+	}
+	if cfg.Timelock < 0 {
+		return errors.New("timelock cannot be negative")
+	}
+
+	for _, guardian := range guardians {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return err
+		}
+		has, err := a.Guardians.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("duplicate guardian: %s", guardian)
+		}
+		err = a.Guardians.Set(ctx, addr)
+		if err != nil {
+			return err
+		}
+	}
+	return a.Config.Set(ctx, *cfg)
+}
+
+// InitiateRecovery initiates a recovery rotating the pubkey of the account, it can only be sent by a guardian.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := a.recoveryID(msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	has, err := a.Recoveries.Has(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("recovery already initiated")
+	}
+
+	recovery := v1.Recovery{
+		NewPubKey:   msg.NewPubKey,
+		InitiatedAt: a.hs.HeaderInfo(ctx).Time,
+	}
+	err = a.approve(ctx, &recovery, guardian)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("new_pub_key_address", fmt.Sprintf("%X", id)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitiateRecoveryResponse{}, a.Recoveries.Set(ctx, id, recovery)
+}
+
+// ApproveRecovery approves a pending recovery of the account, it can only be sent by a guardian.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, recovery, err := a.getRecovery(ctx, msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, fmt.Errorf("recovery already approved by %s", guardian)
+	}
+	err = a.approve(ctx, &recovery, guardian)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgApproveRecoveryResponse{}, a.Recoveries.Set(ctx, id, recovery)
+}
+
+// approve adds the approval of the guardian to the recovery, starting the timelock
+// of the recovery once it is approved by the threshold of guardians.
+func (a Account) approve(ctx context.Context, recovery *v1.Recovery, guardian string) error {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAt == nil && len(recovery.Approvals) >= int(cfg.Threshold) {
+		executableAt := a.hs.HeaderInfo(ctx).Time.Add(cfg.Timelock)
+		recovery.ExecutableAt = &executableAt
+	}
+	return nil
+}
+
+// ExecuteRecovery rotates the pubkey of the account once the timelock of the recovery elapsed.
+// All the pending recoveries and the session keys of the account are removed.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	id, recovery, err := a.getRecovery(ctx, msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if recovery.ExecutableAt == nil {
+		return nil, errors.New("recovery not approved by the threshold of guardians")
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(*recovery.ExecutableAt) {
+		return nil, fmt.Errorf("recovery timelock not elapsed, executable at: %s", recovery.ExecutableAt)
+	}
+
+	err = a.SetPubKey(ctx, recovery.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	err = a.Recoveries.Clear(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// session keys were registered by the lost key and can't be trusted anymore.
+	err = a.SessionKeys.Clear(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("new_pub_key_address", fmt.Sprintf("%X", id)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+// CancelRecoveries cancels all the pending recoveries, it can only be sent by the account itself.
+func (a Account) CancelRecoveries(ctx context.Context, _ *v1.MsgCancelRecoveries) (*v1.MsgCancelRecoveriesResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	return &v1.MsgCancelRecoveriesResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+// senderGuardian returns the address of the sender, failing if it is not a guardian.
+func (a Account) senderGuardian(ctx context.Context) (string, error) {
+	sender := accountstd.Sender(ctx)
+	isGuardian, err := a.Guardians.Has(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if !isGuardian {
+		return "", errors.New("unauthorized: sender is not a guardian")
+	}
+	return a.addrCodec.BytesToString(sender)
+}
+
+func (a Account) recoveryID(newPubKey *codectypes.Any) ([]byte, error) {
+	pk, err := a.DecodePubKey(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return pk.Address().Bytes(), nil
+}
+
+func (a Account) getRecovery(ctx context.Context, newPubKey *codectypes.Any) ([]byte, v1.Recovery, error) {
+	id, err := a.recoveryID(newPubKey)
+	if err != nil {
+		return nil, v1.Recovery{}, err
+	}
+	recovery, err := a.Recoveries.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, v1.Recovery{}, errors.New("recovery not initiated")
+		}
+		return nil, v1.Recovery{}, err
+	}
+	return id, recovery, nil
+}
+
+// QueryGuardians returns the guardians and the config of the account.
+func (a Account) QueryGuardians(ctx context.Context, _ *v1.QueryGuardians) (*v1.QueryGuardiansResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.QueryGuardiansResponse{Config: &cfg}
+	err = a.Guardians.Walk(ctx, nil, func(addr []byte) (bool, error) {
+		guardian, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		resp.Guardians = append(resp.Guardians, guardian)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// QueryRecoveries returns the pending recoveries of the account.
+func (a Account) QueryRecoveries(ctx context.Context, _ *v1.QueryRecoveries) (*v1.QueryRecoveriesResponse, error) {
+	resp := &v1.QueryRecoveriesResponse{}
+	err := a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (bool, error) {
+		resp.Recoveries = append(resp.Recoveries, recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecoveries)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryGuardians)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveries)
+}
@@ -0,0 +1,350 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService, hs *headerService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, hs)
+
+	_, acc, err := NewAccount("recovery", signing.NewHandlerMap(directHandler{}))(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func TestInit(t *testing.T) {
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1", "guardian2"},
+				Config:    &v1.Config{Threshold: 2, Timelock: time.Hour},
+			},
+			"",
+		},
+		{
+			"unsupported pubkey",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, ed25519.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 1},
+			},
+			"unsupported pubkey type",
+		},
+		{
+			"no config",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1"},
+			},
+			"config must be specified",
+		},
+		{
+			"no guardians",
+			&v1.MsgInit{
+				PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Config: &v1.Config{Threshold: 1},
+			},
+			"guardians must be specified",
+		},
+		{
+			"zero threshold",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{},
+			},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"threshold greater than the number of guardians",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 2},
+			},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"negative timelock",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 1, Timelock: -time.Hour},
+			},
+			"timelock cannot be negative",
+		},
+		{
+			"duplicate guardian",
+			&v1.MsgInit{
+				PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Guardians: []string{"guardian1", "guardian1"},
+				Config:    &v1.Config{Threshold: 1},
+			},
+			"duplicate guardian",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupRecoveryAccount(t, ss, &headerService{})
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.msg.Guardians, resp.Guardians)
+			require.Equal(t, tc.msg.Config, resp.Config)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	hs := &headerService{time: time.Unix(1000, 0)}
+	acc := setupRecoveryAccount(t, ss, hs)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []string{"guardian1", "guardian2", "guardian3"},
+		Config:    &v1.Config{Threshold: 2, Timelock: time.Hour},
+	})
+	require.NoError(t, err)
+
+	// the lost key registered a session key
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_recovery_account")), &basev1.MsgAddSessionKey{
+		SessionKey: &basev1.SessionKey{
+			PubKey:          toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+			AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			ExpiryHeight:    100,
+		},
+	})
+	require.NoError(t, err)
+
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	guardian1Ctx := accountstd.SetSender(ctx, []byte("guardian1"))
+	guardian2Ctx := accountstd.SetSender(ctx, []byte("guardian2"))
+
+	// only guardians can initiate a recovery
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("stranger")), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "sender is not a guardian")
+
+	_, err = acc.InitiateRecovery(guardian1Ctx, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(guardian2Ctx, &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery already initiated")
+
+	// the recovery is not approved by the threshold of guardians yet
+	_, err = acc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery not approved by the threshold of guardians")
+	_, err = acc.ApproveRecovery(guardian1Ctx, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery already approved by guardian1")
+
+	hs.time = hs.time.Add(time.Minute)
+	_, err = acc.ApproveRecovery(guardian2Ctx, &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, resp.Recoveries, 1)
+	require.Equal(t, []string{"guardian1", "guardian2"}, resp.Recoveries[0].Approvals)
+	require.Equal(t, time.Unix(1000, 0).UTC(), resp.Recoveries[0].InitiatedAt.UTC())
+	require.Equal(t, time.Unix(1000, 0).Add(time.Minute+time.Hour).UTC(), resp.Recoveries[0].ExecutableAt.UTC())
+
+	// the timelock did not elapse
+	hs.time = hs.time.Add(time.Hour - time.Second)
+	_, err = acc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery timelock not elapsed")
+
+	hs.time = hs.time.Add(time.Second)
+	_, err = acc.ExecuteRecovery(guardian1Ctx, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	pubKey, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKey.Value)
+
+	resp, err = acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Recoveries)
+	sessionKeys, err := acc.QuerySessionKeys(ctx, &basev1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, sessionKeys.SessionKeys)
+}
+
+func TestCancelRecoveries(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss, &headerService{})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []string{"guardian1", "guardian2"},
+		Config:    &v1.Config{Threshold: 1},
+	})
+	require.NoError(t, err)
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_recovery_account"))
+	guardian1Ctx := accountstd.SetSender(ctx, []byte("guardian1"))
+
+	initiateRecovery := func() {
+		t.Helper()
+		_, err := acc.InitiateRecovery(guardian1Ctx, &v1.MsgInitiateRecovery{
+			NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		})
+		require.NoError(t, err)
+	}
+	requireRecoveries := func(n int) {
+		t.Helper()
+		resp, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+		require.NoError(t, err)
+		require.Len(t, resp.Recoveries, n)
+	}
+
+	// only the current key can cancel the recoveries
+	initiateRecovery()
+	initiateRecovery()
+	requireRecoveries(2)
+	_, err = acc.CancelRecoveries(guardian1Ctx, &v1.MsgCancelRecoveries{})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.CancelRecoveries(selfCtx, &v1.MsgCancelRecoveries{})
+	require.NoError(t, err)
+	requireRecoveries(0)
+
+	// updating the guardians cancels the recoveries too
+	initiateRecovery()
+	_, err = acc.UpdateGuardians(guardian1Ctx, &v1.MsgUpdateGuardians{
+		Guardians: []string{"guardian3"},
+		Config:    &v1.Config{Threshold: 1},
+	})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.UpdateGuardians(selfCtx, &v1.MsgUpdateGuardians{
+		Guardians: []string{"guardian3"},
+		Config:    &v1.Config{Threshold: 1},
+	})
+	require.NoError(t, err)
+	requireRecoveries(0)
+
+	guardians, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"guardian3"}, guardians.Guardians)
+	_, err = acc.InitiateRecovery(guardian1Ctx, &v1.MsgInitiateRecovery{
+		NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+	})
+	require.ErrorContains(t, err, "sender is not a guardian")
+}
+
+func TestSessionKeyCannotManageAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss, &headerService{})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []string{"guardian1"},
+		Config:    &v1.Config{Threshold: 1},
+	})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_recovery_account")), &basev1.MsgAddSessionKey{
+		SessionKey: &basev1.SessionKey{
+			PubKey:          toAnyPb(t, sessionKey.PubKey()),
+			AllowedMessages: []string{"/" + gogoproto.MessageName(&accountsv1.MsgExecute{})},
+			ExpiryHeight:    100,
+		},
+	})
+	require.NoError(t, err)
+
+	// the session key can't call the handlers of the account, whatever the case of its address
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	for _, target := range []string{"mock_recovery_account", "MOCK_RECOVERY_ACCOUNT"} {
+		for _, msg := range []gogoproto.Message{
+			&v1.MsgCancelRecoveries{},
+			&v1.MsgUpdateGuardians{Guardians: []string{"guardian2"}, Config: &v1.Config{Threshold: 1}},
+			&basev1.MsgSwapPubKey{NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())},
+		} {
+			msgAny, err := codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+			execute, err := codectypes.NewAnyWithValue(&accountsv1.MsgExecute{
+				Sender:  "mock_recovery_account",
+				Target:  target,
+				Message: msgAny,
+			})
+			require.NoError(t, err)
+			_, err = acc.Authenticate(ctx, signTx(t, sessionKey, execute))
+			require.ErrorContains(t, err, "session key cannot execute messages on the account")
+		}
+	}
+}
+
+// signTx returns the authentication request of a transaction signed by the key,
+// whose pubkey is set in the signer info.
+func signTx(t *testing.T, privKey cryptotypes.PrivKey, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{
+				PublicKey: toAnyPb(t, privKey.PubKey()),
+				ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: 1}}},
+			}},
+			Fee: &tx.Fee{},
+		},
+	}
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx: &transaction,
+	}
+}
+
+func toAnyPb(t *testing.T, pk cryptotypes.PubKey) *codectypes.Any {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+	return pkAny
+}
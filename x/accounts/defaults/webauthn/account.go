@@ -0,0 +1,338 @@
+package webauthn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	CredentialsPrefix = collections.NewPrefix(0)
+	ConfigPrefix      = collections.NewPrefix(1)
+	SequencePrefix    = collections.NewPrefix(2)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// NewAccount returns the creator function of an account authenticating its
+// transactions with WebAuthn assertions, such as the ones produced by passkeys.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Credentials:     collections.NewMap(deps.SchemaBuilder, CredentialsPrefix, "credentials", collections.BytesKey, codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Config:          collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account whose transactions are signed by WebAuthn credentials.
+type Account struct {
+	Credentials collections.Map[[]byte, v1.Credential] // key: credential ID
+	Config      collections.Item[v1.Config]
+	Sequence    collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+// Init initializes the account with the relying party configuration and the initial credentials.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+	if msg.Config.RpId == "" {
+		return nil, errors.New("relying party ID must be specified")
+	}
+	if len(msg.Credentials) == 0 {
+		return nil, errors.New("credentials must be specified")
+	}
+
+	err := a.Config.Set(ctx, *msg.Config)
+	if err != nil {
+		return nil, err
+	}
+	for _, cred := range msg.Credentials {
+		err = a.addCredential(ctx, cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &v1.MsgInitResponse{}, nil
+}
+
+// AddCredential registers a new credential on the account.
+func (a Account) AddCredential(ctx context.Context, msg *v1.MsgAddCredential) (*v1.MsgAddCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	return &v1.MsgAddCredentialResponse{}, a.addCredential(ctx, msg.Credential)
+}
+
+func (a Account) addCredential(ctx context.Context, cred *v1.Credential) error {
+	if cred == nil || len(cred.Id) == 0 {
+		return errors.New("credential ID must be specified")
+	}
+	_, err := parsePubKey(cred.PubKey)
+	if err != nil {
+		return err
+	}
+	has, err := a.Credentials.Has(ctx, cred.Id)
+	if err != nil {
+		return err
+	}
+	if has {
+		return fmt.Errorf("credential %X already registered", cred.Id)
+	}
+	return a.Credentials.Set(ctx, cred.Id, *cred)
+}
+
+// RemoveCredential removes a credential from the account, the last credential can't be removed.
+func (a Account) RemoveCredential(ctx context.Context, msg *v1.MsgRemoveCredential) (*v1.MsgRemoveCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	has, err := a.Credentials.Has(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("credential %X not registered", msg.Id)
+	}
+
+	// check that another credential is left to authenticate the account
+	count := 0
+	err = a.Credentials.Walk(ctx, nil, func(_ []byte, _ v1.Credential) (bool, error) {
+		count++
+		return count > 1, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 1 {
+		return nil, errors.New("cannot remove the last credential")
+	}
+
+	return &v1.MsgRemoveCredentialResponse{}, a.Credentials.Remove(ctx, msg.Id)
+}
+
+// Authenticate implements the authentication flow of an abstracted account: the signature
+// of the signer must be a WebAuthn assertion, whose challenge is the tx sign bytes, produced
+// by one of the credentials of the account.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	assertion := new(v1.Assertion)
+	err := gogoproto.Unmarshal(msg.Tx.Signatures[msg.SignerIndex], assertion)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the WebAuthn assertion: %w", err)
+	}
+	cred, err := a.Credentials.Get(ctx, assertion.CredentialId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("credential %X not registered", assertion.CredentialId)
+		}
+		return nil, err
+	}
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.SignCount, err = verifyAssertion(cfg, cred, assertion, signBytes)
+	if err != nil {
+		return nil, err
+	}
+	err = a.Credentials.Set(ctx, cred.Id, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+// The pubkey of the signer data is the secp256r1 pubkey of the credential.
+func (a Account) computeSignerData(ctx context.Context, cred v1.Credential) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	pkAny, err := secp256r1PubKey(cred.PubKey)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pkAny.TypeUrl,
+			Value:   pkAny.Value,
+		},
+	}, nil
+}
+
+// secp256r1PubKey returns the secp256r1 pubkey of the credential packed in an Any.
+func secp256r1PubKey(bz []byte) (*codectypes.Any, error) {
+	if _, err := parsePubKey(bz); err != nil {
+		return nil, err
+	}
+	// the key type of secp256r1.PubKey is not exported, the pubkey is decoded from
+	// its proto encoding: the compressed key in field 1.
+	pk := new(secp256r1.PubKey)
+	err := gogoproto.Unmarshal(protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), bz), pk)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secp256r1 pubkey: %w", err)
+	}
+	return codectypes.NewAnyWithValue(pk)
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+// QueryCredentials returns the relying party configuration and the credentials of the account.
+func (a Account) QueryCredentials(ctx context.Context, _ *v1.QueryCredentials) (*v1.QueryCredentialsResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.QueryCredentialsResponse{Config: &cfg}
+	err = a.Credentials.Walk(ctx, nil, func(_ []byte, cred v1.Credential) (bool, error) {
+		resp.Credentials = append(resp.Credentials, &cred)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveCredential)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryCredentials)
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+}
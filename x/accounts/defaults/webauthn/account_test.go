@@ -0,0 +1,366 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const testRpID = "wallet.example.com"
+
+func setupWebAuthnAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("webauthn", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+// passkey simulates a WebAuthn authenticator holding a single credential.
+type passkey struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+	flags     byte
+	origin    string
+}
+
+func newPasskey(t *testing.T, id string) *passkey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &passkey{id: []byte(id), key: key, flags: flagUserPresent | flagUserVerified, origin: "https://" + testRpID}
+}
+
+func (p *passkey) credential() *v1.Credential {
+	return &v1.Credential{Id: p.id, PubKey: elliptic.MarshalCompressed(elliptic.P256(), p.key.X, p.key.Y)}
+}
+
+// assert produces the assertion of the challenge, encoded as a tx signature.
+func (p *passkey) assert(t *testing.T, challenge []byte) []byte {
+	t.Helper()
+	rpIDHash := sha256.Sum256([]byte(testRpID))
+	authData := append(rpIDHash[:], p.flags)
+	authData = binary.BigEndian.AppendUint32(authData, p.signCount)
+	clientDataJSON, err := json.Marshal(map[string]any{
+		"type":      "webauthn.get",
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    p.origin,
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := sha256.Sum256(append(authData, clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, p.key, signed[:])
+	require.NoError(t, err)
+
+	bz, err := gogoproto.Marshal(&v1.Assertion{
+		CredentialId:      p.id,
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestInit(t *testing.T) {
+	pk1, pk2 := newPasskey(t, "cred1"), newPasskey(t, "cred2")
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{pk1.credential(), pk2.credential()}},
+			"",
+		},
+		{
+			"missing config",
+			&v1.MsgInit{Credentials: []*v1.Credential{pk1.credential()}},
+			"config must be specified",
+		},
+		{
+			"missing relying party ID",
+			&v1.MsgInit{Config: &v1.Config{}, Credentials: []*v1.Credential{pk1.credential()}},
+			"relying party ID must be specified",
+		},
+		{
+			"missing credentials",
+			&v1.MsgInit{Config: &v1.Config{RpId: testRpID}},
+			"credentials must be specified",
+		},
+		{
+			"duplicate credential",
+			&v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{pk1.credential(), pk1.credential()}},
+			"already registered",
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{{Id: []byte("cred"), PubKey: []byte("invalid_pk")}}},
+			"invalid secp256r1 pubkey",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupWebAuthnAccount(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddRemoveCredential(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	pk1, pk2 := newPasskey(t, "cred1"), newPasskey(t, "cred2")
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{pk1.credential()}})
+	require.NoError(t, err)
+
+	// only the account itself can manage its credentials
+	_, err = acc.AddCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgAddCredential{Credential: pk2.credential()})
+	require.EqualError(t, err, "unauthorized")
+	_, err = acc.RemoveCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgRemoveCredential{Id: pk1.id})
+	require.EqualError(t, err, "unauthorized")
+
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_webauthn_account"))
+	_, err = acc.RemoveCredential(selfCtx, &v1.MsgRemoveCredential{Id: pk1.id})
+	require.EqualError(t, err, "cannot remove the last credential")
+
+	_, err = acc.AddCredential(selfCtx, &v1.MsgAddCredential{Credential: pk2.credential()})
+	require.NoError(t, err)
+	_, err = acc.AddCredential(selfCtx, &v1.MsgAddCredential{Credential: pk2.credential()})
+	require.ErrorContains(t, err, "already registered")
+
+	_, err = acc.RemoveCredential(selfCtx, &v1.MsgRemoveCredential{Id: pk1.id})
+	require.NoError(t, err)
+	_, err = acc.RemoveCredential(selfCtx, &v1.MsgRemoveCredential{Id: pk1.id})
+	require.ErrorContains(t, err, "not registered")
+
+	resp, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, testRpID, resp.Config.RpId)
+	require.Equal(t, []*v1.Credential{pk2.credential()}, resp.Credentials)
+}
+
+func TestAuthenticate(t *testing.T) {
+	pk1, pk2 := newPasskey(t, "cred1"), newPasskey(t, "cred2")
+	unregistered := newPasskey(t, "cred3")
+
+	testcases := []struct {
+		name   string
+		cfg    *v1.Config
+		setup  func(ctx context.Context, acc Account)
+		signer *passkey
+		// malleate modifies the sign bytes before they are asserted
+		malleate func(signBytes []byte) []byte
+		expErr   string
+	}{
+		{
+			name:   "valid assertion",
+			signer: pk1,
+		},
+		{
+			name:   "valid assertion of another credential",
+			signer: pk2,
+		},
+		{
+			name:   "unregistered credential",
+			signer: unregistered,
+			expErr: "not registered",
+		},
+		{
+			name:     "challenge is not the sign bytes",
+			signer:   pk1,
+			malleate: func(signBytes []byte) []byte { return append(signBytes, 0x1) },
+			expErr:   "challenge does not match the sign bytes",
+		},
+		{
+			name:   "origin not allowed",
+			cfg:    &v1.Config{RpId: testRpID, Origins: []string{"https://other.example.com"}},
+			signer: pk1,
+			expErr: "origin not allowed",
+		},
+		{
+			name: "user not verified",
+			cfg:  &v1.Config{RpId: testRpID, RequireUserVerification: true},
+			setup: func(context.Context, Account) {
+				pk1.flags = flagUserPresent
+			},
+			signer: pk1,
+			expErr: "user not verified",
+		},
+		{
+			name: "signature counter did not increase",
+			setup: func(ctx context.Context, acc Account) {
+				cred, err := acc.Credentials.Get(ctx, pk1.id)
+				require.NoError(t, err)
+				cred.SignCount = 10
+				require.NoError(t, acc.Credentials.Set(ctx, pk1.id, cred))
+				pk1.signCount = 10
+			},
+			signer: pk1,
+			expErr: "signature counter did not increase",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pk1.flags, pk1.signCount = flagUserPresent|flagUserVerified, 0
+			ctx, ss := newMockContext(t)
+			acc := setupWebAuthnAccount(t, ss)
+			cfg := tc.cfg
+			if cfg == nil {
+				cfg = &v1.Config{RpId: testRpID}
+			}
+			_, err := acc.Init(ctx, &v1.MsgInit{Config: cfg, Credentials: []*v1.Credential{pk1.credential(), pk2.credential()}})
+			require.NoError(t, err)
+			if tc.setup != nil {
+				tc.setup(ctx, acc)
+			}
+
+			msg := newAuthenticateMsg(t, 0)
+			signBytes := signBytes(t, msg)
+			if tc.malleate != nil {
+				signBytes = tc.malleate(signBytes)
+			}
+			msg.Tx.Signatures = [][]byte{tc.signer.assert(t, signBytes)}
+			msg.RawTx.Signatures = msg.Tx.Signatures
+
+			_, err = acc.Authenticate(accountstd.SetSender(ctx, address.Module("accounts")), msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), seq.Sequence)
+		})
+	}
+}
+
+func TestSecp256r1PubKey(t *testing.T) {
+	cred := newPasskey(t, "cred").credential()
+
+	pkAny, err := secp256r1PubKey(cred.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, "/"+gogoproto.MessageName(&secp256r1.PubKey{}), pkAny.TypeUrl)
+
+	pk := new(secp256r1.PubKey)
+	require.NoError(t, gogoproto.Unmarshal(pkAny.Value, pk))
+	require.Equal(t, cred.PubKey, pk.Bytes())
+
+	_, err = secp256r1PubKey([]byte("invalid_pk"))
+	require.Error(t, err)
+}
+
+func TestAuthenticateSignCount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	pk := newPasskey(t, "cred1")
+	_, err := acc.Init(ctx, &v1.MsgInit{Config: &v1.Config{RpId: testRpID}, Credentials: []*v1.Credential{pk.credential()}})
+	require.NoError(t, err)
+	aaCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+
+	authenticate := func(sequence uint64) error {
+		msg := newAuthenticateMsg(t, sequence)
+		msg.Tx.Signatures = [][]byte{pk.assert(t, signBytes(t, msg))}
+		msg.RawTx.Signatures = msg.Tx.Signatures
+		_, err := acc.Authenticate(aaCtx, msg)
+		return err
+	}
+
+	// the counter reported by the authenticator is stored
+	pk.signCount = 5
+	require.NoError(t, authenticate(0))
+	cred, err := acc.Credentials.Get(ctx, pk.id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), cred.SignCount)
+
+	// a cloned authenticator reporting an old counter is rejected
+	require.ErrorContains(t, authenticate(1), "signature counter did not increase")
+	// the mock context does not revert the state changes of the failed authentication
+	pk.signCount = 6
+	require.NoError(t, authenticate(2))
+}
+
+func newAuthenticateMsg(t *testing.T, sequence uint64) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+		},
+	}
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+// signBytes returns the direct sign bytes of the tx, as computed by the directHandler.
+func signBytes(t *testing.T, msg *aa_interface_v1.MsgAuthenticate) []byte {
+	t.Helper()
+	txDoc := tx.SignDoc{
+		BodyBytes:     msg.RawTx.BodyBytes,
+		AuthInfoBytes: msg.RawTx.AuthInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	bz, err := txDoc.Marshal()
+	require.NoError(t, err)
+	return bz
+}
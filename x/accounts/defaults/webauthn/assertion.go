@@ -0,0 +1,114 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+)
+
+const (
+	// authenticatorDataMinLength is the length of the authenticator data
+	// without attested credential data and extensions.
+	authenticatorDataMinLength = 37
+
+	flagUserPresent  byte = 1 << 0
+	flagUserVerified byte = 1 << 2
+
+	clientDataTypeGet = "webauthn.get"
+)
+
+// clientData is the subset of the WebAuthn client data checked by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the parsed WebAuthn authenticator data.
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+}
+
+func parseAuthenticatorData(bz []byte) (authenticatorData, error) {
+	if len(bz) < authenticatorDataMinLength {
+		return authenticatorData{}, fmt.Errorf("invalid authenticator data length, wanted at least: %d, got: %d", authenticatorDataMinLength, len(bz))
+	}
+	return authenticatorData{
+		rpIDHash:  bz[:32],
+		flags:     bz[32],
+		signCount: binary.BigEndian.Uint32(bz[33:37]),
+	}, nil
+}
+
+// parsePubKey parses a compressed secp256r1 public key.
+func parsePubKey(bz []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+	if x == nil {
+		return nil, errors.New("invalid secp256r1 pubkey")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// verifyAssertion verifies that the assertion was produced by the credential, for the relying
+// party of the config, over the given sign bytes. It returns the signature counter of the assertion.
+func verifyAssertion(cfg v1.Config, cred v1.Credential, assertion *v1.Assertion, signBytes []byte) (uint32, error) {
+	var cd clientData
+	err := json.Unmarshal(assertion.ClientDataJson, &cd)
+	if err != nil {
+		return 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != clientDataTypeGet {
+		return 0, fmt.Errorf("unexpected client data type, wanted: %s, got: %s", clientDataTypeGet, cd.Type)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid challenge: %w", err)
+	}
+	if !bytes.Equal(challenge, signBytes) {
+		return 0, errors.New("challenge does not match the sign bytes")
+	}
+	if len(cfg.Origins) != 0 && !slices.Contains(cfg.Origins, cd.Origin) {
+		return 0, fmt.Errorf("origin not allowed: %s", cd.Origin)
+	}
+
+	authData, err := parseAuthenticatorData(assertion.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	rpIDHash := sha256.Sum256([]byte(cfg.RpId))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return 0, errors.New("relying party ID hash mismatch")
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return 0, errors.New("user not present")
+	}
+	if cfg.RequireUserVerification && authData.flags&flagUserVerified == 0 {
+		return 0, errors.New("user not verified")
+	}
+	// a counter that did not increase hints at a cloned authenticator
+	if (authData.signCount != 0 || cred.SignCount != 0) && authData.signCount <= cred.SignCount {
+		return 0, fmt.Errorf("signature counter did not increase, last: %d, got: %d", cred.SignCount, authData.signCount)
+	}
+
+	pk, err := parsePubKey(cred.PubKey)
+	if err != nil {
+		return 0, err
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJson)
+	signed := sha256.Sum256(append(slices.Clip(assertion.AuthenticatorData), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(pk, signed[:], assertion.Signature) {
+		return 0, errors.New("signature verification failed")
+	}
+	return authData.signCount, nil
+}
@@ -1,17 +1,26 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// PostSponsorshipGasLimit is the gas limit of the MsgPostSponsorship hook of paymasters,
+// the gas it uses being paid by the bundler.
+const PostSponsorshipGasLimit = 200_000
+
 var (
 	// ErrAuthentication is returned when the authentication fails.
 	ErrAuthentication = errors.New("authentication failed")
@@ -38,6 +47,18 @@ func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, err
 	return impl.HasExec(&aa_interface_v1.MsgAuthenticate{}), nil
 }
 
+// IsPaymaster returns if the provided address is an account able to sponsor the bundled txs of other accounts.
+func (k Keeper) IsPaymaster(ctx context.Context, addr []byte) (bool, error) {
+	impl, err := k.getImplementation(ctx, addr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return impl.HasExec(&aa_interface_v1.MsgValidateSponsorship{}), nil
+}
+
 func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler string, rawTx *tx.TxRaw, protoTx *tx.Tx, signIndex uint32) error {
 	msg := &aa_interface_v1.MsgAuthenticate{
 		Bundler:     bundler,
@@ -51,3 +72,213 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler
 	}
 	return nil
 }
+
+// ExecuteBundledTx executes a tx sent by an abstracted account and bundled by the bundler.
+// The fee of the tx is paid to the bundler by its fee payer, which is either the sender of
+// the tx or a paymaster agreeing to sponsor it. The whole fee is charged once the tx is
+// authenticated, and the fee of the gas the tx left unused is refunded once it is executed.
+// Txs failing authentication or payment are not paid, and leave no state changes.
+func (k Keeper) ExecuteBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) *v1.BundledTxResponse {
+	resp, err := k.executeBundledTx(ctx, bundler, bundledTx)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return resp
+}
+
+func (k Keeper) executeBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) (*v1.BundledTxResponse, error) {
+	resp := &v1.BundledTxResponse{}
+	bundlerAddr, err := k.addressCodec.StringToBytes(bundler)
+	if err != nil {
+		return resp, err
+	}
+	protoTx, err := k.decodeBundledTx(bundledTx)
+	if err != nil {
+		return resp, err
+	}
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if timeout := protoTx.Body.TimeoutHeight; timeout > 0 && uint64(height) > timeout {
+		return resp, fmt.Errorf("bundled tx timed out, block height: %d, timeout height: %d", height, timeout)
+	}
+	sender, err := k.bundledTxSender(ctx, protoTx)
+	if err != nil {
+		return resp, err
+	}
+	senderStr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return resp, err
+	}
+
+	fee := protoTx.AuthInfo.Fee
+	payer := sender
+	if fee.Payer != "" {
+		payer, err = k.addressCodec.StringToBytes(fee.Payer)
+		if err != nil {
+			return resp, err
+		}
+	}
+	sponsored := !bytes.Equal(payer, sender)
+	if sponsored {
+		isPaymaster, err := k.IsPaymaster(ctx, payer)
+		if err != nil {
+			return resp, err
+		}
+		if !isPaymaster {
+			return resp, fmt.Errorf("%w: fee payer %s is not a paymaster", ErrBundlerPayment, fee.Payer)
+		}
+	}
+
+	// authenticate the tx, and have the payer pay the whole fee to the bundler.
+	gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, fee.GasLimit, func(ctx context.Context) error {
+		err := k.AuthenticateAccount(ctx, sender, bundler, bundledTx, protoTx, 0)
+		if err != nil {
+			return err
+		}
+		if sponsored {
+			_, err = k.Execute(ctx, payer, address.Module("accounts"), &aa_interface_v1.MsgValidateSponsorship{
+				Bundler: bundler,
+				Sender:  senderStr,
+				Tx:      protoTx,
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("%w: sponsorship refused by %s: %w", ErrBundlerPayment, fee.Payer, err)
+			}
+		}
+		err = k.maybeSendFunds(ctx, payer, bundlerAddr, fee.Amount)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrBundlerPayment, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	// execute the messages with the gas left.
+	execGasUsed, execErr := k.BranchService.ExecuteWithGasLimit(ctx, fee.GasLimit-min(gasUsed, fee.GasLimit), func(ctx context.Context) error {
+		msgResponses, err := k.sendAnyMessages(ctx, sender, protoTx.Body.Messages)
+		if err != nil {
+			return err
+		}
+		resp.ExecResponses, err = implementation.PackAny(&sdk.TxMsgData{MsgResponses: msgResponses})
+		return err
+	})
+	if execErr != nil {
+		execErr = fmt.Errorf("%w: %w", ErrExecution, execErr)
+	}
+
+	// refund the fee of the unused gas to the payer.
+	resp.GasUsed = min(gasUsed+execGasUsed, fee.GasLimit)
+	var refund sdk.Coins
+	resp.Fee, refund = splitFee(fee.Amount, resp.GasUsed, fee.GasLimit)
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.maybeSendFunds(ctx, bundlerAddr, payer, refund)
+	})
+	if err != nil {
+		resp.Fee = fee.Amount
+		return resp, errors.Join(execErr, fmt.Errorf("%w: unable to refund unused gas: %w", ErrBundlerPayment, err))
+	}
+
+	if sponsored {
+		k.postSponsorship(ctx, payer, &aa_interface_v1.MsgPostSponsorship{
+			Bundler: bundler,
+			Sender:  senderStr,
+			GasUsed: resp.GasUsed,
+			Fee:     resp.Fee,
+			Error:   errString(execErr),
+		})
+	}
+	return resp, execErr
+}
+
+// decodeBundledTx decodes the bundled tx, which must define the gas limit of its fee.
+func (k Keeper) decodeBundledTx(bundledTx *tx.TxRaw) (*tx.Tx, error) {
+	body := new(tx.TxBody)
+	err := k.codec.Unmarshal(bundledTx.BodyBytes, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode bundled tx body: %w", err)
+	}
+	authInfo := new(tx.AuthInfo)
+	err = k.codec.Unmarshal(bundledTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode bundled tx auth info: %w", err)
+	}
+
+	fee := authInfo.Fee
+	switch {
+	case fee == nil || fee.GasLimit == 0:
+		return nil, errors.New("bundled tx gas limit must be set")
+	case fee.Granter != "":
+		return nil, errors.New("bundled tx fee granter is not supported, use a paymaster as fee payer")
+	}
+	err = fee.Amount.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundled tx fee: %w", err)
+	}
+	return &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: bundledTx.Signatures}, nil
+}
+
+// bundledTxSender returns the sender of the bundled tx, which must be its only signer
+// and an abstracted account.
+func (k Keeper) bundledTxSender(ctx context.Context, protoTx *tx.Tx) ([]byte, error) {
+	if len(protoTx.Body.Messages) == 0 {
+		return nil, errors.New("bundled tx must contain at least one message")
+	}
+	if len(protoTx.AuthInfo.SignerInfos) != 1 || len(protoTx.Signatures) != 1 {
+		return nil, errors.New("bundled tx must have exactly one signer")
+	}
+	msg, err := implementation.UnpackAnyRaw(protoTx.Body.Messages[0])
+	if err != nil {
+		return nil, err
+	}
+	signers, _, err := k.codec.GetMsgSigners(msg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get signers: %w", err)
+	}
+	if len(signers) != 1 {
+		return nil, fmt.Errorf("expected only one signer, got %d", len(signers))
+	}
+	isAbstracted, err := k.IsAbstractedAccount(ctx, signers[0])
+	if err != nil {
+		return nil, err
+	}
+	if !isAbstracted {
+		return nil, errors.New("bundled tx sender is not an abstracted account")
+	}
+	return signers[0], nil
+}
+
+// postSponsorship notifies the paymaster of the fee it paid, if it handles MsgPostSponsorship.
+// The failure of the paymaster hook does not revert the execution of the sponsored tx.
+func (k Keeper) postSponsorship(ctx context.Context, paymaster []byte, msg *aa_interface_v1.MsgPostSponsorship) {
+	impl, err := k.getImplementation(ctx, paymaster)
+	if err != nil || !impl.HasExec(msg) {
+		return
+	}
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, PostSponsorshipGasLimit, func(ctx context.Context) error {
+		_, err := k.Execute(ctx, paymaster, address.Module("accounts"), msg, nil)
+		return err
+	})
+	if err != nil {
+		k.Logger.Error("paymaster post sponsorship hook failed", "sender", msg.Sender, "err", err)
+	}
+}
+
+// splitFee splits the fee of a bundled tx into the fee paid for the gas used
+// and the refund of the fee of the unused gas.
+func splitFee(fee sdk.Coins, gasUsed, gasLimit uint64) (paid, refund sdk.Coins) {
+	unused, limit := math.NewIntFromUint64(gasLimit-gasUsed), math.NewIntFromUint64(gasLimit)
+	refunds := make([]sdk.Coin, 0, len(fee))
+	for _, coin := range fee {
+		refunds = append(refunds, sdk.NewCoin(coin.Denom, coin.Amount.Mul(unused).Quo(limit)))
+	}
+	refund = sdk.NewCoins(refunds...)
+	return fee.Sub(refund...), refund
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,48 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSplitFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 1000))
+
+	testcases := []struct {
+		name      string
+		gasUsed   uint64
+		expPaid   sdk.Coins
+		expRefund sdk.Coins
+	}{
+		{
+			"all gas used",
+			1000,
+			fee,
+			sdk.NewCoins(),
+		},
+		{
+			"no gas used",
+			0,
+			sdk.NewCoins(),
+			fee,
+		},
+		{
+			"part of the gas used",
+			250,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 250)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 7), sdk.NewInt64Coin("stake", 750)),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			paid, refund := splitFee(fee, tc.gasUsed, 1000)
+			require.Equal(t, tc.expPaid.String(), paid.String())
+			require.Equal(t, tc.expRefund.String(), refund.String())
+			require.Equal(t, fee, paid.Add(refund...))
+		})
+	}
+}
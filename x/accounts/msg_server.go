@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/core/event"
@@ -85,5 +86,14 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 }
 
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
-	panic("impl")
+	if len(req.Txs) == 0 {
+		return nil, errors.New("bundle must contain at least one tx")
+	}
+
+	// the failure of a bundled tx does not fail the bundle, it is reported in its response.
+	resp := &v1.MsgExecuteBundleResponse{Responses: make([]*v1.BundledTxResponse, len(req.Txs))}
+	for i, bundledTx := range req.Txs {
+		resp.Responses[i] = m.k.ExecuteBundledTx(ctx, req.Bundler, bundledTx)
+	}
+	return resp, nil
 }
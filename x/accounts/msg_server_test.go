@@ -43,3 +43,11 @@ func TestMsgServer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
 }
+
+func TestMsgServer_ExecuteBundle(t *testing.T) {
+	k, ctx := newKeeper(t)
+	s := NewMsgServer(k)
+
+	_, err := s.ExecuteBundle(ctx, &v1.MsgExecuteBundle{Bundler: "bundler"})
+	require.ErrorContains(t, err, "bundle must contain at least one tx")
+}
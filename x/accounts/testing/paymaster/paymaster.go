@@ -0,0 +1,106 @@
+package paymaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/accounts/accountstd"
+	account_abstractionv1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	paymasterv1 "cosmossdk.io/x/accounts/testing/paymaster/v1"
+)
+
+var (
+	SponsoredPrefix    = collections.NewPrefix(0)
+	SponsorshipsPrefix = collections.NewPrefix(1)
+)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// NewAccount creates a new paymaster account.
+func NewAccount(d accountstd.Dependencies) (Account, error) {
+	return Account{
+		Sponsored:    collections.NewKeySet(d.SchemaBuilder, SponsoredPrefix, "sponsored", collections.BytesKey),
+		Sponsorships: collections.NewSequence(d.SchemaBuilder, SponsorshipsPrefix, "sponsorships"),
+		addressCodec: d.AddressCodec,
+	}, nil
+}
+
+// Account implements a paymaster used for testing, it sponsors the bundled
+// txs of a fixed set of accounts.
+type Account struct {
+	// Sponsored are the addresses of the accounts whose txs are sponsored.
+	Sponsored collections.KeySet[[]byte]
+	// Sponsorships counts the sponsorships settled by the paymaster.
+	Sponsorships collections.Sequence
+
+	addressCodec address.Codec
+}
+
+func (a Account) Init(ctx context.Context, msg *paymasterv1.MsgInit) (*paymasterv1.MsgInitResponse, error) {
+	for _, sponsored := range msg.Sponsored {
+		addr, err := a.addressCodec.StringToBytes(sponsored)
+		if err != nil {
+			return nil, err
+		}
+		err = a.Sponsored.Set(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &paymasterv1.MsgInitResponse{}, nil
+}
+
+// ValidateSponsorship accepts to pay the fee of the txs of the sponsored accounts.
+func (a Account) ValidateSponsorship(ctx context.Context, msg *account_abstractionv1.MsgValidateSponsorship) (*account_abstractionv1.MsgValidateSponsorshipResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+	sender, err := a.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	sponsored, err := a.Sponsored.Has(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if !sponsored {
+		return nil, fmt.Errorf("account %s is not sponsored", msg.Sender)
+	}
+	return &account_abstractionv1.MsgValidateSponsorshipResponse{}, nil
+}
+
+// PostSponsorship counts the settled sponsorships.
+func (a Account) PostSponsorship(ctx context.Context, _ *account_abstractionv1.MsgPostSponsorship) (*account_abstractionv1.MsgPostSponsorshipResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+	_, err := a.Sponsorships.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &account_abstractionv1.MsgPostSponsorshipResponse{}, nil
+}
+
+func (a Account) QuerySponsorships(ctx context.Context, _ *paymasterv1.QuerySponsorships) (*paymasterv1.QuerySponsorshipsResponse, error) {
+	sponsorships, err := a.Sponsorships.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &paymasterv1.QuerySponsorshipsResponse{Sponsorships: sponsorships}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.ValidateSponsorship) // implements paymaster
+	accountstd.RegisterExecuteHandler(builder, a.PostSponsorship)     // implements paymaster
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySponsorships)
+}
@@ -342,6 +342,11 @@ func (m *MsgExecuteBundle) GetTxs() []*tx.TxRaw {
 type BundledTxResponse struct {
 	ExecResponses *any.Any `protobuf:"bytes,1,opt,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
 	Error         string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas used by the bundled tx, limited by the gas limit of its fee.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee is the fee paid to the bundler by the fee payer of the bundled tx, proportional
+	// to the gas used, the fee of the gas left unused being refunded to the fee payer.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
 func (m *BundledTxResponse) Reset()         { *m = BundledTxResponse{} }
@@ -391,6 +396,20 @@ func (m *BundledTxResponse) GetError() string {
 	return ""
 }
 
+func (m *BundledTxResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BundledTxResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 // MsgExecuteBundleResponse defines the ExecuteBundle response type for the Msg/ExecuteBundle RPC method.
 type MsgExecuteBundleResponse struct {
 	// responses is the list of responses returned by the account implementations.
@@ -450,46 +469,47 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
 
 var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xb6, 0xf9, 0x7a, 0xd3, 0x9f, 0x8f, 0x51, 0x55, 0x5c, 0x57, 0x72, 0x4b, 0xf8,
-	0x8b, 0x2a, 0x18, 0x37, 0x85, 0x55, 0x59, 0xb5, 0x15, 0x08, 0x16, 0x5d, 0x60, 0x75, 0xc5, 0xa6,
-	0xf2, 0xcf, 0x64, 0x88, 0xda, 0x78, 0x22, 0xdf, 0x71, 0x71, 0x76, 0x88, 0x07, 0x40, 0x3c, 0x07,
-	0xab, 0x3e, 0x46, 0x97, 0x5d, 0xb2, 0x40, 0x80, 0x1a, 0xa4, 0xbe, 0x06, 0xb2, 0x3d, 0xe3, 0x04,
-	0x4a, 0xa2, 0x2e, 0x59, 0x65, 0xe6, 0x9e, 0x73, 0xef, 0x9c, 0x73, 0xc6, 0x19, 0x58, 0x0b, 0x04,
-	0x76, 0x05, 0x3a, 0x5e, 0x10, 0x88, 0x24, 0x92, 0xe8, 0x9c, 0xb6, 0x1c, 0x99, 0xd2, 0x5e, 0x2c,
-	0xa4, 0x20, 0xa4, 0x00, 0xa9, 0x06, 0xe9, 0x69, 0xcb, 0x5a, 0xe5, 0x42, 0xf0, 0x13, 0xe6, 0xe4,
-	0x0c, 0x3f, 0x69, 0x3b, 0x5e, 0xd4, 0x2f, 0xe8, 0xd6, 0x6d, 0x35, 0xab, 0x8b, 0x3c, 0x1b, 0xd3,
-	0x45, 0xae, 0x00, 0x5b, 0x01, 0xbe, 0x87, 0xcc, 0x39, 0x6d, 0xf9, 0x4c, 0x7a, 0x2d, 0x27, 0x10,
-	0x9d, 0x48, 0xe1, 0x96, 0xc2, 0x65, 0x5a, 0xa2, 0x5a, 0x83, 0xb5, 0xcc, 0x05, 0x17, 0xf9, 0xd2,
-	0xc9, 0x56, 0x45, 0xb5, 0xf1, 0xd3, 0x80, 0xda, 0x01, 0xf2, 0x57, 0x51, 0x47, 0x92, 0x15, 0x98,
-	0x45, 0x16, 0x85, 0x2c, 0x36, 0x8d, 0x0d, 0xa3, 0x39, 0xe7, 0xaa, 0x1d, 0xb9, 0x03, 0xf3, 0x4a,
-	0xf8, 0x91, 0xec, 0xf7, 0x98, 0x39, 0x95, 0xa3, 0x75, 0x55, 0x3b, 0xec, 0xf7, 0x18, 0xa1, 0x50,
-	0xeb, 0x32, 0x44, 0x8f, 0x33, 0xb3, 0xba, 0x61, 0x34, 0xeb, 0xdb, 0xcb, 0xb4, 0xb0, 0x47, 0xb5,
-	0x3d, 0xba, 0x1b, 0xf5, 0x5d, 0x4d, 0x22, 0x1e, 0xcc, 0xb4, 0x93, 0x28, 0x44, 0x73, 0x7a, 0xa3,
-	0xda, 0xac, 0x6f, 0xaf, 0x52, 0x15, 0x50, 0x66, 0x8c, 0x2a, 0xe9, 0x74, 0x5f, 0x74, 0xa2, 0xbd,
-	0xad, 0xf3, 0x6f, 0xeb, 0x95, 0xcf, 0xdf, 0xd7, 0x9b, 0xbc, 0x23, 0xdf, 0x26, 0x3e, 0x0d, 0x44,
-	0xd7, 0x51, 0x2e, 0x8b, 0x9f, 0xc7, 0x18, 0x1e, 0x3b, 0x99, 0x2e, 0xcc, 0x1b, 0xd0, 0x2d, 0x26,
-	0xef, 0xd4, 0x3f, 0x5c, 0x9d, 0x6d, 0x2a, 0x0b, 0x8d, 0x13, 0x58, 0x52, 0x2e, 0x5d, 0x86, 0x3d,
-	0x11, 0x21, 0x23, 0x0f, 0x61, 0x49, 0xbb, 0xf2, 0xc2, 0x30, 0x66, 0x88, 0xca, 0xf6, 0xa2, 0x2a,
-	0xef, 0x16, 0x55, 0xb2, 0x05, 0xff, 0xc5, 0xaa, 0x29, 0xb7, 0x3e, 0xce, 0x5c, 0xc9, 0x6a, 0x7c,
-	0x35, 0x00, 0x0e, 0x90, 0x3f, 0x4f, 0x59, 0x90, 0x48, 0x36, 0x36, 0xd7, 0x15, 0x98, 0x95, 0x5e,
-	0xcc, 0x99, 0x54, 0x89, 0xaa, 0xdd, 0x3f, 0x1f, 0xe6, 0x0b, 0x20, 0x43, 0x77, 0x65, 0x9e, 0xa3,
-	0x31, 0x19, 0x37, 0x8a, 0xa9, 0x0d, 0xff, 0x0f, 0xe7, 0xec, 0x25, 0x51, 0x78, 0xc2, 0x88, 0x09,
-	0x35, 0x3f, 0x5f, 0xe9, 0xb0, 0xf4, 0x96, 0x6c, 0x42, 0x55, 0xa6, 0x68, 0x4e, 0xe5, 0x1e, 0x4d,
-	0xed, 0x51, 0xa6, 0xa5, 0xc3, 0xc3, 0xd4, 0xf5, 0xde, 0xb9, 0x19, 0x69, 0x67, 0x3e, 0x93, 0xab,
-	0x3b, 0x1b, 0x6d, 0xb8, 0x55, 0x4c, 0x0f, 0x0f, 0xd3, 0x52, 0xee, 0x33, 0x58, 0x64, 0x29, 0x0b,
-	0x8e, 0xb4, 0x1a, 0x9c, 0x28, 0x7a, 0x21, 0xe3, 0xea, 0x5e, 0x24, 0xcb, 0x30, 0xc3, 0xe2, 0x58,
-	0xc4, 0xea, 0xe2, 0x8a, 0x4d, 0xe3, 0x08, 0xcc, 0x3f, 0xfd, 0x94, 0xc7, 0xed, 0xc3, 0xdc, 0xe8,
-	0x49, 0x99, 0x87, 0xfb, 0xf4, 0xfa, 0xab, 0x40, 0xaf, 0x09, 0x75, 0x87, 0x7d, 0xdb, 0x1f, 0xa7,
-	0xa0, 0x7a, 0x80, 0x9c, 0xbc, 0x84, 0xe9, 0xfc, 0x0f, 0xbb, 0xf6, 0xb7, 0x09, 0xea, 0x3b, 0xb7,
-	0xee, 0x4e, 0x00, 0x4b, 0x59, 0xaf, 0xa1, 0xa6, 0xbf, 0x52, 0x7b, 0x0c, 0x5f, 0xe1, 0xd6, 0x83,
-	0xc9, 0x78, 0x39, 0x32, 0x80, 0x85, 0xdf, 0xaf, 0xf4, 0xde, 0xe4, 0xc6, 0x82, 0x65, 0x3d, 0xba,
-	0x09, 0x4b, 0x1f, 0x62, 0xcd, 0xbc, 0xbf, 0x3a, 0xdb, 0x34, 0xf6, 0x9e, 0x9e, 0x5f, 0xda, 0xc6,
-	0xc5, 0xa5, 0x6d, 0xfc, 0xb8, 0xb4, 0x8d, 0x4f, 0x03, 0xbb, 0x72, 0x31, 0xb0, 0x2b, 0x5f, 0x06,
-	0x76, 0xe5, 0x8d, 0x7a, 0x09, 0x31, 0x3c, 0xa6, 0x1d, 0xe1, 0xa4, 0xa3, 0xcf, 0xb2, 0x3f, 0x9b,
-	0x5f, 0xed, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x6a, 0x98, 0xb0, 0xb3, 0x05, 0x00,
-	0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0xee, 0x02, 0x0b, 0xef, 0xf2, 0xa1, 0x13, 0x82, 0xa5, 0x24, 0x05, 0xd7, 0xaf, 0x0d,
+	0xd1, 0x29, 0x8b, 0x9e, 0xf0, 0x04, 0x44, 0xa3, 0x07, 0x0e, 0x36, 0x78, 0x31, 0x31, 0x9b, 0x6e,
+	0x3b, 0x3b, 0x6e, 0x60, 0x3b, 0x9b, 0xbe, 0x53, 0xec, 0xde, 0x8c, 0x3f, 0xc0, 0xf8, 0x3b, 0x3c,
+	0xf1, 0x33, 0x38, 0x72, 0xf4, 0x60, 0x94, 0x80, 0x09, 0x7f, 0xc3, 0xb4, 0x9d, 0x29, 0xab, 0xc8,
+	0x86, 0x8b, 0x89, 0xa7, 0x9d, 0x79, 0x9f, 0xe7, 0x7d, 0xf7, 0x79, 0x9e, 0x69, 0xa7, 0xb0, 0xe4,
+	0x0b, 0xec, 0x09, 0x74, 0x3c, 0xdf, 0x17, 0x71, 0x28, 0xd1, 0x39, 0x68, 0x3a, 0x32, 0xa1, 0xfd,
+	0x48, 0x48, 0x41, 0x48, 0x0e, 0x52, 0x0d, 0xd2, 0x83, 0xa6, 0xb5, 0xc8, 0x85, 0xe0, 0xfb, 0xcc,
+	0xc9, 0x18, 0xed, 0xb8, 0xe3, 0x78, 0xe1, 0x20, 0xa7, 0x5b, 0xb7, 0xd4, 0xac, 0x1e, 0xf2, 0x74,
+	0x4c, 0x0f, 0xb9, 0x02, 0x6c, 0x05, 0xb4, 0x3d, 0x64, 0xce, 0x41, 0xb3, 0xcd, 0xa4, 0xd7, 0x74,
+	0x7c, 0xd1, 0x0d, 0x15, 0x6e, 0x29, 0x5c, 0x26, 0x05, 0xaa, 0x35, 0x58, 0xf3, 0x5c, 0x70, 0x91,
+	0x2d, 0x9d, 0x74, 0x95, 0x57, 0xeb, 0x3f, 0x0d, 0xa8, 0xee, 0x20, 0x7f, 0x19, 0x76, 0x25, 0x59,
+	0x80, 0x09, 0x64, 0x61, 0xc0, 0x22, 0xd3, 0x58, 0x31, 0x1a, 0x53, 0xae, 0xda, 0x91, 0xdb, 0x30,
+	0xad, 0x84, 0xb7, 0xe4, 0xa0, 0xcf, 0xcc, 0x72, 0x86, 0xd6, 0x54, 0x6d, 0x77, 0xd0, 0x67, 0x84,
+	0x42, 0xb5, 0xc7, 0x10, 0x3d, 0xce, 0xcc, 0xca, 0x8a, 0xd1, 0xa8, 0xad, 0xcf, 0xd3, 0xdc, 0x1e,
+	0xd5, 0xf6, 0xe8, 0x66, 0x38, 0x70, 0x35, 0x89, 0x78, 0x30, 0xde, 0x89, 0xc3, 0x00, 0xcd, 0xb1,
+	0x95, 0x4a, 0xa3, 0xb6, 0xbe, 0x48, 0x55, 0x40, 0xa9, 0x31, 0xaa, 0xa4, 0xd3, 0x6d, 0xd1, 0x0d,
+	0xb7, 0xd6, 0x8e, 0xbe, 0x2f, 0x97, 0xbe, 0xfc, 0x58, 0x6e, 0xf0, 0xae, 0x7c, 0x17, 0xb7, 0xa9,
+	0x2f, 0x7a, 0x8e, 0x72, 0x99, 0xff, 0x3c, 0xc2, 0x60, 0xcf, 0x49, 0x75, 0x61, 0xd6, 0x80, 0x6e,
+	0x3e, 0x79, 0xa3, 0xf6, 0xf1, 0xfc, 0x70, 0x55, 0x59, 0xa8, 0xef, 0xc3, 0x9c, 0x72, 0xe9, 0x32,
+	0xec, 0x8b, 0x10, 0x19, 0x79, 0x00, 0x73, 0xda, 0x95, 0x17, 0x04, 0x11, 0x43, 0x54, 0xb6, 0x67,
+	0x55, 0x79, 0x33, 0xaf, 0x92, 0x35, 0x98, 0x8c, 0x54, 0x93, 0x59, 0x1e, 0x61, 0xae, 0x60, 0xd5,
+	0xbf, 0x19, 0x00, 0x3b, 0xc8, 0x9f, 0x25, 0xcc, 0x8f, 0x25, 0xbb, 0x32, 0xd7, 0x05, 0x98, 0x90,
+	0x5e, 0xc4, 0x99, 0x54, 0x89, 0xaa, 0xdd, 0x7f, 0x1f, 0xe6, 0x73, 0x20, 0x17, 0xee, 0x8a, 0x3c,
+	0x87, 0x63, 0x32, 0xae, 0x15, 0x53, 0x07, 0x6e, 0x5c, 0xcc, 0xd9, 0x8a, 0xc3, 0x60, 0x9f, 0x11,
+	0x13, 0xaa, 0xed, 0x6c, 0xa5, 0xc3, 0xd2, 0x5b, 0xb2, 0x0a, 0x15, 0x99, 0xa0, 0x59, 0xce, 0x3c,
+	0x9a, 0xda, 0xa3, 0x4c, 0x0a, 0x87, 0xbb, 0x89, 0xeb, 0xbd, 0x77, 0x53, 0xd2, 0xc6, 0x74, 0x2a,
+	0x57, 0x77, 0xd6, 0x4f, 0x0c, 0xb8, 0x99, 0x8f, 0x0f, 0x76, 0x93, 0x42, 0xef, 0x53, 0x98, 0x65,
+	0x09, 0xf3, 0x5b, 0x5a, 0x0e, 0x8e, 0x54, 0x3d, 0x93, 0x72, 0x75, 0x2f, 0x92, 0x79, 0x18, 0x67,
+	0x51, 0x24, 0x22, 0x75, 0x72, 0xf9, 0x86, 0x2c, 0xc2, 0x24, 0xf7, 0xb0, 0x15, 0x23, 0x0b, 0xb2,
+	0x93, 0x1b, 0x73, 0xab, 0xdc, 0xc3, 0xd7, 0xc8, 0x02, 0xf2, 0x16, 0x2a, 0x1d, 0xc6, 0xfe, 0xc5,
+	0x09, 0xa5, 0x73, 0xeb, 0x2d, 0x30, 0xff, 0x8c, 0xb2, 0x30, 0xba, 0x0d, 0x53, 0xc3, 0x1e, 0x53,
+	0x01, 0xf7, 0xe8, 0xe5, 0x0b, 0x89, 0x5e, 0x8a, 0xc8, 0xbd, 0xe8, 0x5b, 0xff, 0x54, 0x86, 0xca,
+	0x0e, 0x72, 0xf2, 0x02, 0xc6, 0xb2, 0xbb, 0x62, 0xe9, 0x6f, 0x13, 0xd4, 0x2b, 0x66, 0xdd, 0x19,
+	0x01, 0x16, 0xb2, 0x5e, 0x41, 0x55, 0xbf, 0x20, 0xf6, 0x15, 0x7c, 0x85, 0x5b, 0xf7, 0x47, 0xe3,
+	0xc5, 0x48, 0x1f, 0x66, 0x7e, 0x7f, 0x9a, 0xee, 0x8e, 0x6e, 0xcc, 0x59, 0xd6, 0xc3, 0xeb, 0xb0,
+	0xf4, 0x9f, 0x58, 0xe3, 0x1f, 0xce, 0x0f, 0x57, 0x8d, 0xad, 0x27, 0x47, 0xa7, 0xb6, 0x71, 0x7c,
+	0x6a, 0x1b, 0x27, 0xa7, 0xb6, 0xf1, 0xf9, 0xcc, 0x2e, 0x1d, 0x9f, 0xd9, 0xa5, 0xaf, 0x67, 0x76,
+	0xe9, 0x8d, 0xba, 0x84, 0x31, 0xd8, 0xa3, 0x5d, 0xe1, 0x24, 0xc3, 0x5f, 0x84, 0xf6, 0x44, 0xf6,
+	0x50, 0x3d, 0xfe, 0x35, 0x00, 0x6c, 0x2e, 0x5d, 0x6d, 0x2e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -919,6 +939,25 @@ func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -1106,6 +1145,15 @@ func (m *BundledTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1915,6 +1963,59 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])